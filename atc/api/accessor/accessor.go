@@ -122,12 +122,15 @@ var requiredRoles = map[string]string{
 	atc.GetBuildPlan:                  "viewer",
 	atc.CreateBuild:                   "member",
 	atc.ListBuilds:                    "viewer",
+	atc.ListPendingBuilds:             "viewer",
 	atc.BuildEvents:                   "viewer",
 	atc.BuildResources:                "viewer",
 	atc.AbortBuild:                    "member",
 	atc.GetBuildPreparation:           "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "member",
+	atc.RerunJobBuild:                 "member",
+	atc.GetJobSchedulingStatus:        "viewer",
 	atc.ListAllJobs:                   "viewer",
 	atc.ListJobs:                      "viewer",
 	atc.ListJobBuilds:                 "viewer",
@@ -152,6 +155,8 @@ var requiredRoles = map[string]string{
 	atc.GetResourceVersion:            "viewer",
 	atc.EnableResourceVersion:         "member",
 	atc.DisableResourceVersion:        "member",
+	atc.PinResourceVersion:            "member",
+	atc.UnpinResource:                 "member",
 	atc.ListBuildsWithVersionAsInput:  "viewer",
 	atc.ListBuildsWithVersionAsOutput: "viewer",
 	atc.GetResourceCausality:          "viewer",
@@ -162,6 +167,7 @@ var requiredRoles = map[string]string{
 	atc.OrderPipelines:                "member",
 	atc.PausePipeline:                 "member",
 	atc.UnpausePipeline:               "member",
+	atc.ArchivePipeline:               "member",
 	atc.ExposePipeline:                "member",
 	atc.HidePipeline:                  "member",
 	atc.RenamePipeline:                "member",
@@ -172,11 +178,16 @@ var requiredRoles = map[string]string{
 	atc.LandWorker:                    "member",
 	atc.RetireWorker:                  "member",
 	atc.PruneWorker:                   "member",
+	atc.UnquarantineWorker:            "member",
+	atc.GetWorkerMaintenance:          "viewer",
+	atc.ScheduleWorkerMaintenance:     "member",
+	atc.CancelWorkerMaintenance:       "member",
 	atc.HeartbeatWorker:               "member",
 	atc.ListWorkers:                   "viewer",
 	atc.DeleteWorker:                  "member",
 	atc.SetLogLevel:                   "member",
 	atc.GetLogLevel:                   "viewer",
+	atc.DrainBuilds:                   "member",
 	atc.DownloadCLI:                   "viewer",
 	atc.GetInfo:                       "viewer",
 	atc.GetInfoCreds:                  "viewer",
@@ -188,11 +199,13 @@ var requiredRoles = map[string]string{
 	atc.ListVolumes:                   "viewer",
 	atc.ListDestroyingVolumes:         "viewer",
 	atc.ReportWorkerVolumes:           "member",
+	atc.ReportWorkerVolumeSizes:       "member",
 	atc.ListTeams:                     "viewer",
 	atc.SetTeam:                       "owner",
 	atc.RenameTeam:                    "owner",
 	atc.DestroyTeam:                   "owner",
 	atc.ListTeamBuilds:                "viewer",
+	atc.GetTeamUsage:                  "viewer",
 	atc.SendInputToBuildPlan:          "member",
 	atc.ReadOutputFromBuildPlan:       "member",
 }
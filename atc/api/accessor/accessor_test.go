@@ -359,6 +359,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ListBuilds, atc.ListBuilds, "member", true),
 		Entry("viewer :: "+atc.ListBuilds, atc.ListBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.ListPendingBuilds, atc.ListPendingBuilds, "owner", true),
+		Entry("member :: "+atc.ListPendingBuilds, atc.ListPendingBuilds, "member", true),
+		Entry("viewer :: "+atc.ListPendingBuilds, atc.ListPendingBuilds, "viewer", true),
+
 		Entry("owner :: "+atc.BuildEvents, atc.BuildEvents, "owner", true),
 		Entry("member :: "+atc.BuildEvents, atc.BuildEvents, "member", true),
 		Entry("viewer :: "+atc.BuildEvents, atc.BuildEvents, "viewer", true),
@@ -379,10 +383,18 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.GetJob, atc.GetJob, "member", true),
 		Entry("viewer :: "+atc.GetJob, atc.GetJob, "viewer", true),
 
+		Entry("owner :: "+atc.GetJobSchedulingStatus, atc.GetJobSchedulingStatus, "owner", true),
+		Entry("member :: "+atc.GetJobSchedulingStatus, atc.GetJobSchedulingStatus, "member", true),
+		Entry("viewer :: "+atc.GetJobSchedulingStatus, atc.GetJobSchedulingStatus, "viewer", true),
+
 		Entry("owner :: "+atc.CreateJobBuild, atc.CreateJobBuild, "owner", true),
 		Entry("member :: "+atc.CreateJobBuild, atc.CreateJobBuild, "member", true),
 		Entry("viewer :: "+atc.CreateJobBuild, atc.CreateJobBuild, "viewer", false),
 
+		Entry("owner :: "+atc.RerunJobBuild, atc.RerunJobBuild, "owner", true),
+		Entry("member :: "+atc.RerunJobBuild, atc.RerunJobBuild, "member", true),
+		Entry("viewer :: "+atc.RerunJobBuild, atc.RerunJobBuild, "viewer", false),
+
 		Entry("owner :: "+atc.ListAllJobs, atc.ListAllJobs, "owner", true),
 		Entry("member :: "+atc.ListAllJobs, atc.ListAllJobs, "member", true),
 		Entry("viewer :: "+atc.ListAllJobs, atc.ListAllJobs, "viewer", true),
@@ -479,6 +491,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "member", true),
 		Entry("viewer :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "viewer", false),
 
+		Entry("owner :: "+atc.PinResourceVersion, atc.PinResourceVersion, "owner", true),
+		Entry("member :: "+atc.PinResourceVersion, atc.PinResourceVersion, "member", true),
+		Entry("viewer :: "+atc.PinResourceVersion, atc.PinResourceVersion, "viewer", false),
+
+		Entry("owner :: "+atc.UnpinResource, atc.UnpinResource, "owner", true),
+		Entry("member :: "+atc.UnpinResource, atc.UnpinResource, "member", true),
+		Entry("viewer :: "+atc.UnpinResource, atc.UnpinResource, "viewer", false),
+
 		Entry("owner :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "owner", true),
 		Entry("member :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "member", true),
 		Entry("viewer :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "viewer", true),
@@ -518,6 +538,9 @@ var _ = Describe("Accessor", func() {
 		Entry("owner :: "+atc.UnpausePipeline, atc.UnpausePipeline, "owner", true),
 		Entry("member :: "+atc.UnpausePipeline, atc.UnpausePipeline, "member", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
+		Entry("owner :: "+atc.ArchivePipeline, atc.ArchivePipeline, "owner", true),
+		Entry("member :: "+atc.ArchivePipeline, atc.ArchivePipeline, "member", true),
+		Entry("viewer :: "+atc.ArchivePipeline, atc.ArchivePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
@@ -559,6 +582,22 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.PruneWorker, atc.PruneWorker, "member", true),
 		Entry("viewer :: "+atc.PruneWorker, atc.PruneWorker, "viewer", false),
 
+		Entry("owner :: "+atc.UnquarantineWorker, atc.UnquarantineWorker, "owner", true),
+		Entry("member :: "+atc.UnquarantineWorker, atc.UnquarantineWorker, "member", true),
+		Entry("viewer :: "+atc.UnquarantineWorker, atc.UnquarantineWorker, "viewer", false),
+
+		Entry("owner :: "+atc.GetWorkerMaintenance, atc.GetWorkerMaintenance, "owner", true),
+		Entry("member :: "+atc.GetWorkerMaintenance, atc.GetWorkerMaintenance, "member", true),
+		Entry("viewer :: "+atc.GetWorkerMaintenance, atc.GetWorkerMaintenance, "viewer", true),
+
+		Entry("owner :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "owner", true),
+		Entry("member :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "member", true),
+		Entry("viewer :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "viewer", false),
+
+		Entry("owner :: "+atc.CancelWorkerMaintenance, atc.CancelWorkerMaintenance, "owner", true),
+		Entry("member :: "+atc.CancelWorkerMaintenance, atc.CancelWorkerMaintenance, "member", true),
+		Entry("viewer :: "+atc.CancelWorkerMaintenance, atc.CancelWorkerMaintenance, "viewer", false),
+
 		Entry("owner :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "owner", true),
 		Entry("member :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "member", true),
 		Entry("viewer :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "viewer", false),
@@ -579,6 +618,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.GetLogLevel, atc.GetLogLevel, "member", true),
 		Entry("viewer :: "+atc.GetLogLevel, atc.GetLogLevel, "viewer", true),
 
+		Entry("owner :: "+atc.DrainBuilds, atc.DrainBuilds, "owner", true),
+		Entry("member :: "+atc.DrainBuilds, atc.DrainBuilds, "member", true),
+		Entry("viewer :: "+atc.DrainBuilds, atc.DrainBuilds, "viewer", false),
+
 		Entry("owner :: "+atc.DownloadCLI, atc.DownloadCLI, "owner", true),
 		Entry("member :: "+atc.DownloadCLI, atc.DownloadCLI, "member", true),
 		Entry("viewer :: "+atc.DownloadCLI, atc.DownloadCLI, "viewer", true),
@@ -623,6 +666,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ReportWorkerVolumes, atc.ReportWorkerVolumes, "member", true),
 		Entry("viewer :: "+atc.ReportWorkerVolumes, atc.ReportWorkerVolumes, "viewer", false),
 
+		Entry("owner :: "+atc.ReportWorkerVolumeSizes, atc.ReportWorkerVolumeSizes, "owner", true),
+		Entry("member :: "+atc.ReportWorkerVolumeSizes, atc.ReportWorkerVolumeSizes, "member", true),
+		Entry("viewer :: "+atc.ReportWorkerVolumeSizes, atc.ReportWorkerVolumeSizes, "viewer", false),
+
 		Entry("owner :: "+atc.ListTeams, atc.ListTeams, "owner", true),
 		Entry("member :: "+atc.ListTeams, atc.ListTeams, "member", true),
 		Entry("viewer :: "+atc.ListTeams, atc.ListTeams, "viewer", true),
@@ -643,6 +690,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "member", true),
 		Entry("viewer :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.GetTeamUsage, atc.GetTeamUsage, "owner", true),
+		Entry("member :: "+atc.GetTeamUsage, atc.GetTeamUsage, "member", true),
+		Entry("viewer :: "+atc.GetTeamUsage, atc.GetTeamUsage, "viewer", true),
+
 		Entry("owner :: "+atc.SendInputToBuildPlan, atc.SendInputToBuildPlan, "owner", true),
 		Entry("member :: "+atc.SendInputToBuildPlan, atc.SendInputToBuildPlan, "member", true),
 		Entry("viewer :: "+atc.SendInputToBuildPlan, atc.SendInputToBuildPlan, "viewer", false),
@@ -26,7 +26,9 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
 	"github.com/concourse/concourse/atc/api/jobserver/jobserverfakes"
 	"github.com/concourse/concourse/atc/api/resourceserver/resourceserverfakes"
+	"github.com/concourse/concourse/atc/builds/buildsfakes"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
 )
@@ -53,6 +55,7 @@ var (
 	dbWorkerLifecycle       *dbfakes.FakeWorkerLifecycle
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
+	dbBuildQueue            *dbfakes.FakeBuildQueue
 	dbTeam                  *dbfakes.FakeTeam
 	fakeSchedulerFactory    *jobserverfakes.FakeSchedulerFactory
 	fakeScannerFactory      *resourceserverfakes.FakeScannerFactory
@@ -62,6 +65,8 @@ var (
 	interceptTimeout        *containerserverfakes.FakeInterceptTimeout
 	peerURL                 string
 	drain                   chan struct{}
+	fakeHandoff             *buildsfakes.FakeHandoff
+	fakeArchiver            *logarchivefakes.FakeArchiver
 	expire                  time.Duration
 	isTLSEnabled            bool
 	cliDownloadsDir         string
@@ -99,6 +104,7 @@ var _ = BeforeEach(func() {
 	dbJobFactory = new(dbfakes.FakeJobFactory)
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
+	dbBuildQueue = new(dbfakes.FakeBuildQueue)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -119,6 +125,8 @@ var _ = BeforeEach(func() {
 	peerURL = "http://127.0.0.1:1234"
 
 	drain = make(chan struct{})
+	fakeHandoff = new(buildsfakes.FakeHandoff)
+	fakeArchiver = new(logarchivefakes.FakeArchiver)
 
 	fakeEngine = new(enginefakes.FakeEngine)
 	fakeWorkerClient = new(workerfakes.FakeClient)
@@ -179,10 +187,13 @@ var _ = BeforeEach(func() {
 		fakeContainerRepository,
 		fakeDestroyer,
 		dbBuildFactory,
+		dbBuildQueue,
 
 		peerURL,
 		constructedEventHandler.Construct,
 		drain,
+		fakeHandoff,
+		fakeArchiver,
 
 		fakeEngine,
 		fakeWorkerClient,
@@ -17,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/logarchive"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
@@ -460,6 +461,98 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/pending", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			fakeaccess.TeamNamesReturns([]string{"some-team"})
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/builds/pending")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when getting the pending builds succeeds", func() {
+			BeforeEach(func() {
+				build1 := new(dbfakes.FakeBuild)
+				build1.IDReturns(4)
+				build1.NameReturns("2")
+				build1.JobNameReturns("release")
+				build1.PipelineNameReturns("pipeline1")
+				build1.TeamNameReturns("some-team")
+				build1.StatusReturns(db.BuildStatusPending)
+				build1.PriorityReturns(10)
+
+				build2 := new(dbfakes.FakeBuild)
+				build2.IDReturns(3)
+				build2.NameReturns("1")
+				build2.JobNameReturns("pr")
+				build2.PipelineNameReturns("pipeline2")
+				build2.TeamNameReturns("some-team")
+				build2.StatusReturns(db.BuildStatusPending)
+
+				dbBuildQueue.PendingBuildsReturns([]db.QueuedBuild{
+					{Build: build1, Position: 1},
+					{Build: build2},
+				}, nil)
+			})
+
+			It("returns 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+			})
+
+			It("looks up the builds visible to the user's teams", func() {
+				Expect(dbBuildQueue.PendingBuildsCallCount()).To(Equal(1))
+				Expect(dbBuildQueue.PendingBuildsArgsForCall(0)).To(ConsistOf("some-team"))
+			})
+
+			It("returns the builds with their priorities and queue positions", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 4,
+						"name": "2",
+						"job_name": "release",
+						"pipeline_name": "pipeline1",
+						"team_name": "some-team",
+						"status": "pending",
+						"api_url": "/api/v1/builds/4",
+						"priority": 10,
+						"queue_position": 1
+					},
+					{
+						"id": 3,
+						"name": "1",
+						"job_name": "pr",
+						"pipeline_name": "pipeline2",
+						"team_name": "some-team",
+						"status": "pending",
+						"api_url": "/api/v1/builds/3"
+					}
+				]`))
+			})
+		})
+
+		Context("when getting the pending builds fails", func() {
+			BeforeEach(func() {
+				dbBuildQueue.PendingBuildsReturns(nil, errors.New("oh no!"))
+			})
+
+			It("returns 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id", func() {
 		var response *http.Response
 
@@ -608,6 +701,18 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when the reaped logs have been archived", func() {
+							BeforeEach(func() {
+								build.LogsArchivedReturns(true)
+							})
+
+							It("says so", func() {
+								var build atc.Build
+								Expect(json.NewDecoder(response.Body).Decode(&build)).To(Succeed())
+								Expect(build.LogsArchived).To(BeTrue())
+							})
+						})
 					})
 				})
 			})
@@ -909,6 +1014,47 @@ var _ = Describe("Builds API", func() {
 					buildID := dbBuildFactory.BuildArgsForCall(0)
 					Expect(buildID).To(Equal(128))
 				})
+
+				Context("when the build's events have been reaped", func() {
+					var archivedEvents *dbfakes.FakeEventSource
+
+					BeforeEach(func() {
+						build.ReapTimeReturns(time.Now())
+
+						archivedEvents = new(dbfakes.FakeEventSource)
+						fakeArchiver.EventsReturns(archivedEvents, nil)
+					})
+
+					It("serves the events from the archive", func() {
+						_, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						events, err := constructedEventHandler.build.Events(3)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(events).To(Equal(archivedEvents))
+
+						Expect(fakeArchiver.EventsCallCount()).To(Equal(1))
+						archivedBuild, from := fakeArchiver.EventsArgsForCall(0)
+						Expect(archivedBuild).To(Equal(build))
+						Expect(from).To(Equal(uint(3)))
+						Expect(build.EventsCallCount()).To(BeZero())
+					})
+
+					Context("when the build was never archived", func() {
+						BeforeEach(func() {
+							fakeArchiver.EventsReturns(nil, logarchive.ErrNotFound)
+						})
+
+						It("falls back to the build's own events", func() {
+							_, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							_, err = constructedEventHandler.build.Events(0)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(build.EventsCallCount()).To(Equal(1))
+						})
+					})
+				})
 			})
 
 			Context("when not authenticated", func() {
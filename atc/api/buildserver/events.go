@@ -4,10 +4,20 @@ import (
 	"net/http"
 
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/logarchive"
 )
 
 func (s *Server) BuildEvents(build db.Build) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// once a build's events have been reaped they can only be served from
+		// the archive, if there is one
+		if s.archiver != nil && !build.ReapTime().IsZero() {
+			build = archivedBuild{
+				Build:    build,
+				archiver: s.archiver,
+			}
+		}
+
 		streamDone := make(chan struct{})
 
 		go func() {
@@ -22,3 +32,19 @@ func (s *Server) BuildEvents(build db.Build) http.Handler {
 		}
 	})
 }
+
+type archivedBuild struct {
+	db.Build
+
+	archiver logarchive.Archiver
+}
+
+func (build archivedBuild) Events(from uint) (db.EventSource, error) {
+	events, err := build.archiver.Events(build.Build, from)
+	if err == logarchive.ErrNotFound {
+		// reaped before archiving was configured
+		return build.Build.Events(from)
+	}
+
+	return events, err
+}
@@ -0,0 +1,39 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+func (s *Server) ListPendingBuilds(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-pending-builds")
+
+	acc := accessor.GetAccessor(r)
+
+	queuedBuilds, err := s.buildQueue.PendingBuilds(acc.TeamNames())
+	if err != nil {
+		logger.Error("failed-to-get-pending-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presentedBuilds := []atc.Build{}
+	for _, queuedBuild := range queuedBuilds {
+		build := present.Build(queuedBuild.Build)
+		build.QueuePosition = queuedBuild.Position
+		presentedBuilds = append(presentedBuilds, build)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(presentedBuilds)
+	if err != nil {
+		logger.Error("failed-to-encode-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
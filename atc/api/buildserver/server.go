@@ -7,6 +7,7 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/logarchive"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -22,8 +23,10 @@ type Server struct {
 	workerClient        worker.Client
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
+	buildQueue          db.BuildQueue
 	eventHandlerFactory EventHandlerFactory
 	drain               <-chan struct{}
+	archiver            logarchive.Archiver
 	rejector            auth.Rejector
 }
 
@@ -35,8 +38,10 @@ func NewServer(
 	workerClient worker.Client,
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
+	buildQueue db.BuildQueue,
 	eventHandlerFactory EventHandlerFactory,
 	drain <-chan struct{},
+	archiver logarchive.Archiver,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -48,8 +53,10 @@ func NewServer(
 		workerClient:        workerClient,
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
+		buildQueue:          buildQueue,
 		eventHandlerFactory: eventHandlerFactory,
 		drain:               drain,
+		archiver:            archiver,
 
 		rejector: auth.UnauthorizedRejector{},
 	}
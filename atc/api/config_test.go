@@ -8,6 +8,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
@@ -288,6 +289,35 @@ var _ = Describe("Config API", func() {
 										RawConfig: atc.RawConfig(rawConfig),
 									}))
 								})
+
+								Context("when the pipeline has var sources", func() {
+									BeforeEach(func() {
+										fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+											{
+												Name: "some-vault",
+												Type: "vault",
+												Config: map[string]interface{}{
+													"url":          "https://vault.example.com",
+													"client_token": "some-vault-token",
+												},
+											},
+										})
+									})
+
+									It("returns only their names and types", func() {
+										body, err := ioutil.ReadAll(response.Body)
+										Expect(err).NotTo(HaveOccurred())
+										Expect(string(body)).ToNot(ContainSubstring("some-vault-token"))
+
+										var actualConfigResponse atc.ConfigResponse
+										err = json.Unmarshal(body, &actualConfigResponse)
+										Expect(err).NotTo(HaveOccurred())
+
+										Expect(actualConfigResponse.Config.VarSources).To(Equal(atc.VarSourceConfigs{
+											{Name: "some-vault", Type: "vault"},
+										}))
+									})
+								})
 							})
 
 							Context("when finding the resource types fails", func() {
@@ -488,13 +518,45 @@ var _ = Describe("Config API", func() {
 						It("saves it", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(pipelineState).To(Equal(db.PipelineNoChange))
 						})
 
+						Context("when instance vars are given", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = url.Values{
+									atc.InstanceVarsQueryParam: []string{`{"branch":"1.2"}`},
+								}.Encode()
+							})
+
+							It("saves the pipeline instance", func() {
+								pipelineRef, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{
+									Name:         "a-pipeline",
+									InstanceVars: atc.InstanceVars{"branch": "1.2"},
+								}))
+							})
+						})
+
+						Context("when the instance vars are malformed", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = url.Values{
+									atc.InstanceVarsQueryParam: []string{"bogus"},
+								}.Encode()
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("does not save anything", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							})
+						})
+
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
 								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
@@ -549,6 +611,29 @@ var _ = Describe("Config API", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
 							})
 						})
+
+						Context("when a var source has an unknown type", func() {
+							BeforeEach(func() {
+								pipelineConfig.VarSources = atc.VarSourceConfigs{
+									{Name: "some-source", Type: "bogus"},
+								}
+								payload, err := json.Marshal(pipelineConfig)
+								Expect(err).NotTo(HaveOccurred())
+								request.Body = gbytes.BufferWithBytes(payload)
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("returns error JSON", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("var source 'some-source': unknown credential manager type: bogus"))
+							})
+
+							It("does not save it", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							})
+						})
 					})
 
 					Context("YAML", func() {
@@ -572,8 +657,8 @@ var _ = Describe("Config API", func() {
 						It("saves it", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(pipelineState).To(Equal(db.PipelineNoChange))
@@ -626,8 +711,8 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								pipelineRef, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -671,6 +756,68 @@ jobs:
 							})
 						})
 
+						Context("when the payload contains in_parallel steps", func() {
+							BeforeEach(func() {
+								payload := `---
+resources:
+- name: some-resource
+  type: some-type
+jobs:
+- name: some-job
+  plan:
+  - in_parallel:
+    - get: some-resource
+    - task: some-task
+      file: some-resource/task.yml
+  - in_parallel:
+      limit: 2
+      fail_fast: true
+      steps:
+      - task: some-other-task
+        file: some-resource/task.yml`
+
+								request.Header.Set("Content-Type", "application/x-yaml")
+								request.Body = ioutil.NopCloser(bytes.NewBufferString(payload))
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("saves both the shorthand and the full form", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+
+								_, savedConfig, _, _ := dbTeam.SavePipelineArgsForCall(0)
+								Expect(savedConfig.Jobs[0].Plan).To(Equal(atc.PlanSequence{
+									{
+										InParallel: &atc.InParallelConfig{
+											Steps: atc.PlanSequence{
+												{
+													Get: "some-resource",
+												},
+												{
+													Task:           "some-task",
+													TaskConfigPath: "some-resource/task.yml",
+												},
+											},
+										},
+									},
+									{
+										InParallel: &atc.InParallelConfig{
+											Steps: atc.PlanSequence{
+												{
+													Task:           "some-other-task",
+													TaskConfigPath: "some-resource/task.yml",
+												},
+											},
+											Limit:    2,
+											FailFast: true,
+										},
+									},
+								}))
+							})
+						})
+
 						Context("when it contains credentials to be interpolated", func() {
 							var (
 								payloadAsConfig atc.Config
@@ -749,8 +896,8 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										pipelineRef, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+										Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 
 										Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -899,8 +1046,8 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								pipelineRef, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(pipelineConfig))
 								Expect(id).To(Equal(db.ConfigVersion(42)))
 								Expect(pipelineState).To(Equal(expectedDBValue))
@@ -1193,8 +1340,8 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						name, savedConfig, id, _ := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						pipelineRef, savedConfig, id, _ := dbTeam.SavePipelineArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
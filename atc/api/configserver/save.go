@@ -71,6 +71,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, []string{err.Error()}, session)
+		return
+	}
+
 	config, pausedState, err := saveConfigRequestUnmarshaler(r)
 	switch err {
 	case ErrStatusUnsupportedMediaType:
@@ -115,11 +122,17 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	varSourceErrs := validateVarSources(config)
+	if varSourceErrs != nil {
+		s.handleBadRequest(w, []string{varSourceErrs.Error()}, session)
+		return
+	}
+
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
 	if checkCredentials {
-		variables := s.variablesFactory.NewVariables(teamName, pipelineName)
+		variables := creds.NewPipelineVariables(session, s.variablesFactory, teamName, pipelineName, config.VarSources)
 
 		errs := validateCredParams(variables, config, session)
 		if errs != nil {
@@ -143,7 +156,10 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState)
+	_, created, err := team.SavePipeline(atc.PipelineRef{
+		Name:         pipelineName,
+		InstanceVars: instanceVars,
+	}, config, version, pausedState)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -164,6 +180,22 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	s.writeSaveConfigResponse(w, SaveConfigResponse{Warnings: warnings}, session)
 }
 
+// validateVarSources checks that each var source configures a known type of
+// credential manager correctly. The managers are not initialized, so nothing
+// is fetched from them.
+func validateVarSources(config atc.Config) error {
+	var errs error
+
+	for _, source := range config.VarSources {
+		_, err := creds.NewVarSourceManager(source)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("var source '%s': %s", source.Name, err))
+		}
+	}
+
+	return errs
+}
+
 // Simply validate that the credentials exist; don't do anything with the actual secrets
 func validateCredParams(vars creds.Variables, config atc.Config, session lager.Logger) error {
 	var errs error
@@ -325,6 +357,8 @@ func saveConfigRequestUnmarshaler(r *http.Request) (atc.Config, db.PipelinePause
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			atc.SanitizeDecodeHook,
 			atc.VersionConfigDecodeHook,
+			atc.InParallelConfigDecodeHook,
+			atc.BuildLogRetentionDecodeHook,
 			atc.ContainerLimitsDecodeHook,
 		),
 	}
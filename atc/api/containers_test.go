@@ -362,11 +362,52 @@ var _ = Describe("Containers API", func() {
 					_, err := client.Do(req)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, pipelineName, resourceName, variablesFactory := dbTeam.FindCheckContainersArgsForCall(0)
-					Expect(pipelineName).To(Equal("some-pipeline"))
+					_, pipelineRef, resourceName, variablesFactory := dbTeam.FindCheckContainersArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(resourceName).To(Equal("some-resource"))
 					Expect(variablesFactory).To(Equal(fakeVariablesFactory))
 				})
+
+				Context("with instance vars", func() {
+					BeforeEach(func() {
+						req.URL.RawQuery = url.Values{
+							"type":          []string{"check"},
+							"resource_name": []string{"some-resource"},
+							"pipeline_name": []string{"some-pipeline"},
+							"instance_vars": []string{`{"branch":"feature"}`},
+						}.Encode()
+					})
+
+					It("finds the check containers of that instance", func() {
+						_, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						_, pipelineRef, _, _ := dbTeam.FindCheckContainersArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "feature"},
+						}))
+					})
+				})
+
+				Context("with malformed instance vars", func() {
+					BeforeEach(func() {
+						req.URL.RawQuery = url.Values{
+							"type":          []string{"check"},
+							"resource_name": []string{"some-resource"},
+							"pipeline_name": []string{"some-pipeline"},
+							"instance_vars": []string{"{"},
+						}.Encode()
+					})
+
+					It("returns 400 Bad Request", func() {
+						response, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(dbTeam.FindCheckContainersCallCount()).To(BeZero())
+					})
+				})
 			})
 		})
 	})
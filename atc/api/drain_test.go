@@ -0,0 +1,72 @@
+package api_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drain API", func() {
+	Describe("PUT /api/v1/drain", func() {
+		var (
+			fakeaccess *accessorfakes.FakeAccess
+
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			fakeaccess = new(accessorfakes.FakeAccess)
+		})
+
+		JustBeforeEach(func() {
+			fakeAccessor.CreateReturns(fakeaccess)
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/drain", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("is admin", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAdminReturns(true)
+				})
+
+				It("returns 202 Accepted", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+				})
+
+				It("requests a handoff of the ATC's builds", func() {
+					Expect(fakeHandoff.RequestCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("is not admin", func() {
+				It("return 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not request a handoff", func() {
+					Expect(fakeHandoff.RequestCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+})
@@ -0,0 +1,14 @@
+package drainserver
+
+import "net/http"
+
+// Drain asks the ATC handling the request to hand its in-flight builds off to
+// the other ATCs. The handoff happens in the background, so this returns as
+// soon as it has been requested.
+func (s *Server) Drain(w http.ResponseWriter, r *http.Request) {
+	s.logger.Session("drain").Info("requesting-handoff")
+
+	s.handoff.Request()
+
+	w.WriteHeader(http.StatusAccepted)
+}
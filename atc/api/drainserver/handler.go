@@ -0,0 +1,20 @@
+package drainserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/builds"
+)
+
+type Server struct {
+	logger lager.Logger
+
+	handoff builds.Handoff
+}
+
+func NewServer(logger lager.Logger, handoff builds.Handoff) *Server {
+	return &Server{
+		logger: logger,
+
+		handoff: handoff,
+	}
+}
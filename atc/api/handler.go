@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/api/cliserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/drainserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
@@ -21,10 +22,12 @@ import (
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
+	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/logarchive"
 	"github.com/concourse/concourse/atc/mainredirect"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -46,10 +49,13 @@ func NewHandler(
 	containerRepository db.ContainerRepository,
 	destroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
+	dbBuildQueue db.BuildQueue,
 
 	peerURL string,
 	eventHandlerFactory buildserver.EventHandlerFactory,
 	drain <-chan struct{},
+	handoff builds.Handoff,
+	buildLogArchiver logarchive.Archiver,
 
 	engine engine.Engine,
 	workerClient worker.Client,
@@ -79,14 +85,15 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, peerURL, engine, workerClient, dbTeamFactory, dbBuildFactory, eventHandlerFactory, drain)
+	buildServer := buildserver.NewServer(logger, externalURL, peerURL, engine, workerClient, dbTeamFactory, dbBuildFactory, dbBuildQueue, eventHandlerFactory, drain, buildLogArchiver)
 	jobServer := jobserver.NewServer(logger, schedulerFactory, externalURL, variablesFactory, dbJobFactory)
 	resourceServer := resourceserver.NewServer(logger, scannerFactory, variablesFactory, dbResourceFactory)
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL, engine)
 	configServer := configserver.NewServer(logger, dbTeamFactory, variablesFactory)
-	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory, workerProvider)
+	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory, dbBuildFactory, workerProvider)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
+	drainServer := drainserver.NewServer(logger, handoff)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerClient, variablesFactory, interceptTimeoutFactory, containerRepository, destroyer)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
@@ -98,6 +105,7 @@ func NewHandler(
 		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
 
 		atc.ListBuilds:              http.HandlerFunc(buildServer.ListBuilds),
+		atc.ListPendingBuilds:       http.HandlerFunc(buildServer.ListPendingBuilds),
 		atc.CreateBuild:             teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
 		atc.GetBuild:                buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:          buildHandlerFactory.HandlerFor(buildServer.BuildResources),
@@ -108,16 +116,18 @@ func NewHandler(
 		atc.SendInputToBuildPlan:    buildHandlerFactory.HandlerFor(buildServer.SendInputToBuildPlan),
 		atc.ReadOutputFromBuildPlan: buildHandlerFactory.HandlerFor(buildServer.ReadOutputFromBuildPlan),
 
-		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
-		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
-		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
-		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
-		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
-		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
-		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
-		atc.PauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
-		atc.UnpauseJob:     pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
-		atc.JobBadge:       pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
+		atc.ListAllJobs:            http.HandlerFunc(jobServer.ListAllJobs),
+		atc.ListJobs:               pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
+		atc.GetJob:                 pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
+		atc.ListJobBuilds:          pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
+		atc.ListJobInputs:          pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.GetJobBuild:            pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
+		atc.CreateJobBuild:         pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
+		atc.RerunJobBuild:          pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
+		atc.GetJobSchedulingStatus: pipelineHandlerFactory.HandlerFor(jobServer.GetJobSchedulingStatus),
+		atc.PauseJob:               pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
+		atc.UnpauseJob:             pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
+		atc.JobBadge:               pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
 		atc.MainJobBadge: mainredirect.Handler{
 			Routes: atc.Routes,
 			Route:  atc.JobBadge,
@@ -132,6 +142,7 @@ func NewHandler(
 		atc.OrderPipelines:      http.HandlerFunc(pipelineServer.OrderPipelines),
 		atc.PausePipeline:       pipelineHandlerFactory.HandlerFor(pipelineServer.PausePipeline),
 		atc.UnpausePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.UnpausePipeline),
+		atc.ArchivePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.ArchivePipeline),
 		atc.ExposePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.ExposePipeline),
 		atc.HidePipeline:        pipelineHandlerFactory.HandlerFor(pipelineServer.HidePipeline),
 		atc.GetVersionsDB:       pipelineHandlerFactory.HandlerFor(pipelineServer.GetVersionsDB),
@@ -154,21 +165,30 @@ func NewHandler(
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
 		atc.DisableResourceVersion:        pipelineHandlerFactory.HandlerFor(versionServer.DisableResourceVersion),
+		atc.PinResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.PinResourceVersion),
+		atc.UnpinResource:                 pipelineHandlerFactory.HandlerFor(versionServer.UnpinResource),
 		atc.ListBuildsWithVersionAsInput:  pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsInput),
 		atc.ListBuildsWithVersionAsOutput: pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsOutput),
 		atc.GetResourceCausality:          pipelineHandlerFactory.HandlerFor(versionServer.GetCausality),
 
-		atc.ListWorkers:     http.HandlerFunc(workerServer.ListWorkers),
-		atc.RegisterWorker:  http.HandlerFunc(workerServer.RegisterWorker),
-		atc.LandWorker:      http.HandlerFunc(workerServer.LandWorker),
-		atc.RetireWorker:    http.HandlerFunc(workerServer.RetireWorker),
-		atc.PruneWorker:     http.HandlerFunc(workerServer.PruneWorker),
-		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
-		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
+		atc.ListWorkers:        http.HandlerFunc(workerServer.ListWorkers),
+		atc.RegisterWorker:     http.HandlerFunc(workerServer.RegisterWorker),
+		atc.LandWorker:         http.HandlerFunc(workerServer.LandWorker),
+		atc.RetireWorker:       http.HandlerFunc(workerServer.RetireWorker),
+		atc.PruneWorker:        http.HandlerFunc(workerServer.PruneWorker),
+		atc.UnquarantineWorker: http.HandlerFunc(workerServer.UnquarantineWorker),
+		atc.HeartbeatWorker:    http.HandlerFunc(workerServer.HeartbeatWorker),
+		atc.DeleteWorker:       http.HandlerFunc(workerServer.DeleteWorker),
+
+		atc.GetWorkerMaintenance:      http.HandlerFunc(workerServer.GetWorkerMaintenance),
+		atc.ScheduleWorkerMaintenance: http.HandlerFunc(workerServer.ScheduleWorkerMaintenance),
+		atc.CancelWorkerMaintenance:   http.HandlerFunc(workerServer.CancelWorkerMaintenance),
 
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
 
+		atc.DrainBuilds: http.HandlerFunc(drainServer.Drain),
+
 		atc.DownloadCLI:  http.HandlerFunc(cliServer.Download),
 		atc.GetInfo:      http.HandlerFunc(infoServer.Info),
 		atc.GetInfoCreds: http.HandlerFunc(infoServer.Creds),
@@ -179,15 +199,17 @@ func NewHandler(
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
 
-		atc.ListVolumes:           teamHandlerFactory.HandlerFor(volumesServer.ListVolumes),
-		atc.ListDestroyingVolumes: http.HandlerFunc(volumesServer.ListDestroyingVolumes),
-		atc.ReportWorkerVolumes:   http.HandlerFunc(volumesServer.ReportWorkerVolumes),
+		atc.ListVolumes:             teamHandlerFactory.HandlerFor(volumesServer.ListVolumes),
+		atc.ListDestroyingVolumes:   http.HandlerFunc(volumesServer.ListDestroyingVolumes),
+		atc.ReportWorkerVolumes:     http.HandlerFunc(volumesServer.ReportWorkerVolumes),
+		atc.ReportWorkerVolumeSizes: http.HandlerFunc(volumesServer.ReportWorkerVolumeSizes),
 
 		atc.ListTeams:      http.HandlerFunc(teamServer.ListTeams),
 		atc.SetTeam:        http.HandlerFunc(teamServer.SetTeam),
 		atc.RenameTeam:     http.HandlerFunc(teamServer.RenameTeam),
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
+		atc.GetTeamUsage:   teamHandlerFactory.HandlerFor(teamServer.GetTeamUsage),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
 )
@@ -1477,10 +1479,11 @@ var _ = Describe("Jobs API", func() {
 						It("triggers using the current config", func() {
 							Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(1))
 
-							_, job, resources, resourceTypes := fakeScheduler.TriggerImmediatelyArgsForCall(0)
+							_, job, resources, resourceTypes, overrides := fakeScheduler.TriggerImmediatelyArgsForCall(0)
 							Expect(job).To(Equal(fakeJob))
 							Expect(resources).To(Equal(db.Resources{fakeResource, fakeResource2}))
 							Expect(resourceTypes).To(Equal(versionedResourceTypes))
+							Expect(overrides).To(BeZero())
 						})
 
 						It("returns 200 OK", func() {
@@ -1509,6 +1512,194 @@ var _ = Describe("Jobs API", func() {
 						})
 					})
 
+					Context("when input versions are overridden", func() {
+						BeforeEach(func() {
+							fakeJob.ConfigReturns(atc.JobConfig{
+								Name: "some-job",
+								Plan: atc.PlanSequence{
+									{Get: "some-input", Resource: "some-resource"},
+								},
+							})
+
+							var err error
+							request, err = http.NewRequest(
+								"POST",
+								server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds",
+								bytes.NewBufferString(`{"inputs":{"some-input":{"ref":"v1"}},"ignore_passed":true}`),
+							)
+							Expect(err).NotTo(HaveOccurred())
+							request.Header.Set("Content-Type", "application/json")
+
+							fakeScheduler.TriggerImmediatelyReturns(new(dbfakes.FakeBuild), nil, nil)
+						})
+
+						Context("when the version exists", func() {
+							BeforeEach(func() {
+								fakePipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{ID: 1}, true, nil)
+							})
+
+							It("looks up the version of the input's resource", func() {
+								version, resourceName := fakePipeline.GetVersionedResourceByVersionArgsForCall(0)
+								Expect(version).To(Equal(atc.Version{"ref": "v1"}))
+								Expect(resourceName).To(Equal("some-resource"))
+							})
+
+							It("triggers with the overrides", func() {
+								Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(1))
+
+								_, _, _, _, overrides := fakeScheduler.TriggerImmediatelyArgsForCall(0)
+								Expect(overrides).To(Equal(atc.InputOverrides{
+									Inputs:       map[string]atc.Version{"some-input": {"ref": "v1"}},
+									IgnorePassed: true,
+								}))
+							})
+
+							It("returns 200 OK", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+						})
+
+						Context("when the version does not exist", func() {
+							BeforeEach(func() {
+								fakePipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{}, false, nil)
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("does not trigger the build", func() {
+								Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when looking up the version fails", func() {
+							BeforeEach(func() {
+								fakePipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{}, false, errors.New("oh no!"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when the input has passed constraints", func() {
+							BeforeEach(func() {
+								fakeJob.ConfigReturns(atc.JobConfig{
+									Name: "some-job",
+									Plan: atc.PlanSequence{
+										{Get: "some-input", Resource: "some-resource", Passed: []string{"upstream-job"}},
+									},
+								})
+
+								var err error
+								request, err = http.NewRequest(
+									"POST",
+									server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds",
+									bytes.NewBufferString(`{"inputs":{"some-input":{"ref":"v1"}}}`),
+								)
+								Expect(err).NotTo(HaveOccurred())
+								request.Header.Set("Content-Type", "application/json")
+
+								fakePipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{ID: 1}, true, nil)
+							})
+
+							Context("when the version has passed the constraints", func() {
+								BeforeEach(func() {
+									fakePipeline.LoadVersionsDBReturns(&algorithm.VersionsDB{
+										ResourceVersions: []algorithm.ResourceVersion{
+											{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+										},
+										BuildOutputs: []algorithm.BuildOutput{
+											{ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 11, CheckOrder: 1}, BuildID: 21, JobID: 31},
+										},
+										JobIDs:      map[string]int{"upstream-job": 31},
+										ResourceIDs: map[string]int{"some-resource": 11},
+									}, nil)
+								})
+
+								It("triggers the build", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+									Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(1))
+								})
+							})
+
+							Context("when the version has not passed the constraints", func() {
+								BeforeEach(func() {
+									fakePipeline.LoadVersionsDBReturns(&algorithm.VersionsDB{
+										ResourceVersions: []algorithm.ResourceVersion{
+											{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+										},
+										JobIDs:      map[string]int{"upstream-job": 31},
+										ResourceIDs: map[string]int{"some-resource": 11},
+									}, nil)
+								})
+
+								It("returns 400", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								})
+
+								It("explains the problem", func() {
+									body, err := ioutil.ReadAll(response.Body)
+									Expect(err).NotTo(HaveOccurred())
+									Expect(string(body)).To(Equal("version map[ref:v1] of resource 'some-resource' has not passed upstream-job"))
+								})
+
+								It("does not trigger the build", func() {
+									Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when loading the versions fails", func() {
+								BeforeEach(func() {
+									fakePipeline.LoadVersionsDBReturns(nil, errors.New("oh no!"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
+						Context("when the job has no such input", func() {
+							BeforeEach(func() {
+								fakeJob.ConfigReturns(atc.JobConfig{
+									Name: "some-job",
+									Plan: atc.PlanSequence{
+										{Get: "some-other-input", Resource: "some-resource"},
+									},
+								})
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("explains the problem", func() {
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(Equal("job 'some-job' has no input named 'some-input'"))
+							})
+						})
+					})
+
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							var err error
+							request, err = http.NewRequest(
+								"POST",
+								server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds",
+								bytes.NewBufferString(`{`),
+							)
+							Expect(err).NotTo(HaveOccurred())
+							request.Header.Set("Content-Type", "application/json")
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+
 					Context("when getting the config fails", func() {
 						BeforeEach(func() {
 							fakePipeline.ResourcesReturns(db.Resources{}, errors.New("oh no!"))
@@ -1553,6 +1744,207 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
+		var response *http.Response
+
+		var fakeScheduler *schedulerfakes.FakeBuildScheduler
+		var fakeBuildToRerun *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
+			fakeSchedulerFactory.BuildSchedulerReturns(fakeScheduler)
+
+			fakeBuildToRerun = new(dbfakes.FakeBuild)
+			fakeBuildToRerun.IDReturns(42)
+			fakeBuildToRerun.NameReturns("3")
+			fakeBuildToRerun.StatusReturns(db.BuildStatusFailed)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the job and build are found", func() {
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.BuildReturns(fakeBuildToRerun, true, nil)
+				})
+
+				Context("when rerunning the build succeeds", func() {
+					BeforeEach(func() {
+						build := new(dbfakes.FakeBuild)
+						build.IDReturns(43)
+						build.NameReturns("3.1")
+						build.JobNameReturns("some-job")
+						build.PipelineNameReturns("a-pipeline")
+						build.TeamNameReturns("some-team")
+						build.StatusReturns(db.BuildStatusPending)
+						build.RerunOfReturns(42)
+						build.RerunNumberReturns(1)
+						fakeScheduler.RerunImmediatelyReturns(build, nil, nil)
+					})
+
+					It("looks up the build by name", func() {
+						Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+						Expect(fakeJob.BuildArgsForCall(0)).To(Equal("3"))
+					})
+
+					It("reruns the build using the current config", func() {
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(1))
+
+						_, job, buildToRerun, _, resourceTypes := fakeScheduler.RerunImmediatelyArgsForCall(0)
+						Expect(job).To(Equal(fakeJob))
+						Expect(buildToRerun).To(Equal(fakeBuildToRerun))
+						Expect(resourceTypes).To(Equal(versionedResourceTypes))
+					})
+
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("returns the rerun build", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"id": 43,
+							"name": "3.1",
+							"job_name": "some-job",
+							"status": "pending",
+							"api_url": "/api/v1/builds/43",
+							"pipeline_name": "a-pipeline",
+							"team_name": "some-team",
+							"rerun_of": 42,
+							"rerun_number": 1
+						}`))
+					})
+				})
+
+				Context("when manual triggering is disabled", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name:                 "some-job",
+							DisableManualTrigger: true,
+						})
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not rerun the build", func() {
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the build has not finished", func() {
+					BeforeEach(func() {
+						fakeBuildToRerun.IsRunningReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not rerun the build", func() {
+						Expect(fakeScheduler.RerunImmediatelyCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when getting the resources fails", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourcesReturns(nil, errors.New("oh no!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the build has no recorded inputs", func() {
+					BeforeEach(func() {
+						fakeScheduler.RerunImmediatelyReturns(nil, nil, db.ErrBuildHasNoRecordedInputs)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("build 3 has no recorded inputs"))
+					})
+				})
+
+				Context("when rerunning the build fails", func() {
+					BeforeEach(func() {
+						fakeScheduler.RerunImmediatelyReturns(nil, nil, errors.New("oh no!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the build is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the build fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.BuildReturns(nil, false, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
 		var response *http.Response
 
@@ -1571,8 +1963,8 @@ var _ = Describe("Jobs API", func() {
 
 			It("looked up the proper pipeline", func() {
 				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("some-pipeline"))
+				pipelineRef := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 			})
 
 			Context("when getting the job succeeds", func() {
@@ -1915,6 +2307,166 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/scheduling-status", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/scheduling-status")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeJob.SchedulingStatusReturns(db.JobSchedulingStatus{
+				JobName:          "some-job",
+				PendingBuildID:   42,
+				PendingBuildName: "7",
+				Blockers: []db.SchedulingBlocker{
+					{
+						Reason:    db.SchedulingBlockerSerialGroupBlocked,
+						BuildID:   41,
+						BuildName: "6",
+						JobName:   "some-job",
+					},
+					{
+						Reason:      db.SchedulingBlockerInputUnsatisfied,
+						Input:       "some-input",
+						Resource:    "some-resource",
+						InputReason: algorithm.UnsatisfiedPassedConstraints,
+						PassedJobs:  []string{"job-1", "job-2"},
+					},
+					{
+						Reason:     db.SchedulingBlockerCheckErrored,
+						Input:      "some-input",
+						Resource:   "some-resource",
+						CheckError: "some-check-error",
+					},
+				},
+			}, nil)
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("does not reveal the check error", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).NotTo(ContainSubstring("some-check-error"))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("looked up the right job", func() {
+					Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the scheduling status", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"job_name": "some-job",
+						"pending_build_id": 42,
+						"pending_build_name": "7",
+						"blockers": [
+							{
+								"reason": "serial_group_blocked",
+								"build_id": 41,
+								"build_name": "6",
+								"job_name": "some-job"
+							},
+							{
+								"reason": "input_unsatisfied",
+								"input": "some-input",
+								"resource": "some-resource",
+								"input_reason": "passed_constraints",
+								"passed_jobs": ["job-1", "job-2"]
+							},
+							{
+								"reason": "check_errored",
+								"input": "some-input",
+								"resource": "some-resource",
+								"check_error": "some-check-error"
+							}
+						]
+					}`))
+				})
+
+				Context("when getting the scheduling status fails", func() {
+					BeforeEach(func() {
+						fakeJob.SchedulingStatusReturns(db.JobSchedulingStatus{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the job fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", func() {
 		var response *http.Response
 
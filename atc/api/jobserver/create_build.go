@@ -3,10 +3,16 @@ package jobserver
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
 )
 
 func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
@@ -33,7 +39,31 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		scheduler := s.schedulerFactory.BuildScheduler(pipeline, s.externalURL, s.variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name()))
+		var overrides atc.InputOverrides
+		err = json.NewDecoder(r.Body).Decode(&overrides)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed request: %s", err)
+			return
+		}
+
+		problem, err := validateInputOverrides(pipeline, job, overrides)
+		if err != nil {
+			logger.Error("failed-to-validate-input-overrides", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if problem != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", problem)
+			return
+		}
+
+		variables := creds.NewPipelineVariables(logger, s.variablesFactory, pipeline.TeamName(), pipeline.Name(), pipeline.VarSources())
+
+		scheduler := s.schedulerFactory.BuildScheduler(pipeline, s.externalURL, variables)
 
 		resourceTypes, err := pipeline.ResourceTypes()
 		if err != nil {
@@ -51,7 +81,7 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, _, err := scheduler.TriggerImmediately(logger, job, resources, versionedResourceTypes)
+		build, _, err := scheduler.TriggerImmediately(logger, job, resources, versionedResourceTypes, overrides)
 		if err != nil {
 			logger.Error("failed-to-trigger", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -66,3 +96,54 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+// validateInputOverrides returns a description of the first override which
+// does not refer to an input of the job or to a known version, or whose
+// version has not passed the input's passed constraints.
+func validateInputOverrides(pipeline db.Pipeline, job db.Job, overrides atc.InputOverrides) (string, error) {
+	inputs := map[string]atc.JobInput{}
+	for _, input := range job.Config().Inputs() {
+		inputs[input.Name] = input
+	}
+
+	var versions *algorithm.VersionsDB
+
+	for name, version := range overrides.Inputs {
+		input, found := inputs[name]
+		if !found {
+			return fmt.Sprintf("job '%s' has no input named '%s'", job.Name(), name), nil
+		}
+
+		savedVersion, found, err := pipeline.GetVersionedResourceByVersion(version, input.Resource)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			return fmt.Sprintf("version %v of resource '%s' not found", version, input.Resource), nil
+		}
+
+		if overrides.IgnorePassed || len(input.Passed) == 0 {
+			continue
+		}
+
+		if versions == nil {
+			versions, err = pipeline.LoadVersionsDB()
+			if err != nil {
+				return "", err
+			}
+		}
+
+		passed := algorithm.JobSet{}
+		for _, jobName := range input.Passed {
+			passed[versions.JobIDs[jobName]] = struct{}{}
+		}
+
+		passedVersions := versions.VersionsOfResourcePassedJobs(versions.ResourceIDs[input.Resource], passed)
+		if passedVersions.ForVersion(savedVersion.ID).IsEmpty() {
+			return fmt.Sprintf("version %v of resource '%s' has not passed %s", version, input.Resource, strings.Join(input.Passed, ", ")), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,48 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetJobSchedulingStatus(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-scheduling-status")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+		teamName := r.FormValue(":team_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		status, err := job.SchedulingStatus()
+		if err != nil {
+			logger.Error("failed-to-get-scheduling-status", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(present.JobSchedulingStatus(status, acc.IsAuthorized(teamName)))
+		if err != nil {
+			logger.Error("failed-to-encode-scheduling-status", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -14,7 +15,7 @@ func (s *Server) ListJobInputs(pipeline db.Pipeline) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		jobName := r.FormValue(":job_name")
 
-		variables := s.variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name())
+		variables := creds.NewPipelineVariables(logger, s.variablesFactory, pipeline.TeamName(), pipeline.Name(), pipeline.VarSources())
 
 		job, found, err := pipeline.Job(jobName)
 		if err != nil {
@@ -26,6 +26,9 @@ func Build(build db.Build) atc.Build {
 		TeamName:     build.TeamName(),
 		Status:       string(build.Status()),
 		APIURL:       apiURL,
+		RerunOf:      build.RerunOf(),
+		RerunNumber:  build.RerunNumber(),
+		Priority:     build.Priority(),
 	}
 
 	if !build.StartTime().IsZero() {
@@ -38,6 +41,7 @@ func Build(build db.Build) atc.Build {
 
 	if !build.ReapTime().IsZero() {
 		atcBuild.ReapTime = build.ReapTime().Unix()
+		atcBuild.LogsArchived = build.LogsArchived()
 	}
 
 	return atcBuild
@@ -1,6 +1,8 @@
 package present
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -47,6 +49,22 @@ func Job(
 		})
 	}
 
+	var nextScheduled int64
+	if job.Config().Schedule != nil {
+		schedule, err := job.Config().Schedule.CronSchedule()
+		if err == nil {
+			from := job.LastScheduled()
+			if from.IsZero() {
+				from = time.Now()
+			}
+
+			next := schedule.Next(from)
+			if !next.IsZero() {
+				nextScheduled = next.Unix()
+			}
+		}
+	}
+
 	return atc.Job{
 		ID: job.ID(),
 
@@ -59,6 +77,7 @@ func Job(
 		FinishedBuild:        presentedFinishedBuild,
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
+		NextScheduled:        nextScheduled,
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
@@ -0,0 +1,39 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func JobSchedulingStatus(status db.JobSchedulingStatus, showCheckError bool) atc.JobSchedulingStatus {
+	blockers := []atc.SchedulingBlocker{}
+
+	for _, blocker := range status.Blockers {
+		presented := atc.SchedulingBlocker{
+			Reason:      atc.SchedulingBlockerReason(blocker.Reason),
+			Input:       blocker.Input,
+			Resource:    blocker.Resource,
+			InputReason: string(blocker.InputReason),
+			PassedJobs:  blocker.PassedJobs,
+			BuildID:     blocker.BuildID,
+			BuildName:   blocker.BuildName,
+			JobName:     blocker.JobName,
+
+			ActiveBuilds:        blocker.ActiveBuilds,
+			MaxConcurrentBuilds: blocker.MaxConcurrentBuilds,
+		}
+
+		if showCheckError {
+			presented.CheckError = blocker.CheckError
+		}
+
+		blockers = append(blockers, presented)
+	}
+
+	return atc.JobSchedulingStatus{
+		JobName:          status.JobName,
+		PendingBuildID:   status.PendingBuildID,
+		PendingBuildName: status.PendingBuildName,
+		Blockers:         blockers,
+	}
+}
@@ -23,6 +23,14 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 		CheckError:     checkErrString,
 	}
 
+	if resource.PinnedVersion() != nil {
+		atcResource.PinnedVersion = resource.PinnedVersion()
+		atcResource.PinnedInConfig = true
+	} else if resource.APIPinnedVersion() != nil {
+		atcResource.PinnedVersion = resource.APIPinnedVersion()
+		atcResource.PinComment = resource.PinComment()
+	}
+
 	if !resource.LastChecked().IsZero() {
 		atcResource.LastChecked = resource.LastChecked().Unix()
 	}
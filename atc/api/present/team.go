@@ -6,9 +6,19 @@ import (
 )
 
 func Team(team db.Team) atc.Team {
-	return atc.Team{
+	atcTeam := atc.Team{
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
 	}
+
+	if maxConcurrentBuilds := team.MaxConcurrentBuilds(); maxConcurrentBuilds != 0 {
+		atcTeam.MaxConcurrentBuilds = &maxConcurrentBuilds
+	}
+
+	if buildWeight := team.BuildWeight(); buildWeight != 0 {
+		atcTeam.BuildWeight = &buildWeight
+	}
+
+	return atcTeam
 }
@@ -0,0 +1,21 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Usage(usage db.Usage) atc.Usage {
+	return atc.Usage{
+		PipelineName:      usage.PipelineName,
+		JobName:           usage.JobName,
+		Containers:        usage.Containers,
+		ContainerSeconds:  usage.ContainerSeconds,
+		CPUShareSeconds:   usage.CPUShareSeconds,
+		MemoryByteSeconds: usage.MemoryByteSeconds,
+		Volumes:           usage.Volumes,
+		VolumeSeconds:     usage.VolumeSeconds,
+		VolumeBytes:       usage.VolumeBytes,
+		VolumeByteSeconds: usage.VolumeByteSeconds,
+	}
+}
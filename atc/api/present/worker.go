@@ -19,6 +19,12 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		version = *workerInfo.Version()
 	}
 
+	var maintenance *atc.MaintenanceWindow
+	if window, scheduled := workerInfo.MaintenanceWindow(); scheduled {
+		presented := MaintenanceWindow(window)
+		maintenance = &presented
+	}
+
 	return atc.Worker{
 		GardenAddr:       gardenAddr,
 		BaggageclaimURL:  baggageclaimURL,
@@ -29,11 +35,22 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
+		Labels:           workerInfo.Labels(),
 		Name:             workerInfo.Name(),
 		Team:             workerInfo.TeamName(),
 		State:            string(workerInfo.State()),
 		StartTime:        workerInfo.StartTime(),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+		QuarantineReason: workerInfo.QuarantineReason(),
+		Maintenance:      maintenance,
+	}
+}
+
+func MaintenanceWindow(window db.MaintenanceWindow) atc.MaintenanceWindow {
+	return atc.MaintenanceWindow{
+		Start:     window.Start.Unix(),
+		Duration:  int64(window.Duration.Seconds()),
+		Behaviour: string(window.Behaviour),
 	}
 }
@@ -851,8 +851,8 @@ var _ = Describe("Resources API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := dbTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := dbTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the resource succeeds", func() {
@@ -932,8 +932,8 @@ var _ = Describe("Resources API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := dbTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := dbTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the resource succeeds", func() {
@@ -1011,8 +1011,8 @@ var _ = Describe("Resources API", func() {
 
 			It("injects the proper pipelineDB", func() {
 				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("a-pipeline"))
+				pipelineRef := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 			})
 
 			It("tries to scan with no version specified", func() {
@@ -38,7 +38,7 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		variables := s.variablesFactory.NewVariables(dbPipeline.TeamName(), dbPipeline.Name())
+		variables := creds.NewPipelineVariables(logger, s.variablesFactory, dbPipeline.TeamName(), dbPipeline.Name(), dbPipeline.VarSources())
 		token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
 		if token != webhookToken {
 			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
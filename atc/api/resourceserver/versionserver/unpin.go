@@ -0,0 +1,43 @@
+package versionserver
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) UnpinResource(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("unpin-resource")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := rata.Param(r, "resource_name")
+
+		resource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// a version pinned in the pipeline's config can only be unpinned by
+		// changing the config
+		if resource.PinnedVersion() != nil {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		err = resource.UnpinVersion()
+		if err != nil {
+			logger.Error("failed-to-unpin-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -232,6 +232,76 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team exists and only its auth is given", func() {
+				BeforeEach(func() {
+					atcTeam = atc.Team{
+						Auth: atc.TeamAuth{
+							"owner": map[string][]string{
+								"users": []string{"local:username"},
+							},
+						},
+					}
+					fakeTeam.MaxConcurrentBuildsReturns(5)
+					fakeTeam.BuildWeightReturns(2)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the auth without touching the build quota", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateBuildQuotaCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team exists and part of a build quota is given", func() {
+				BeforeEach(func() {
+					buildWeight := 3
+					atcTeam = atc.Team{BuildWeight: &buildWeight}
+					fakeTeam.MaxConcurrentBuildsReturns(5)
+					fakeTeam.BuildWeightReturns(2)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("keeps the rest of the current quota", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateBuildQuotaCallCount()).To(Equal(1))
+
+					maxConcurrentBuilds, buildWeight := fakeTeam.UpdateBuildQuotaArgsForCall(0)
+					Expect(maxConcurrentBuilds).To(Equal(5))
+					Expect(buildWeight).To(Equal(3))
+				})
+			})
+
+			Context("when the team exists and a build quota is given", func() {
+				BeforeEach(func() {
+					maxConcurrentBuilds, buildWeight := 5, 2
+					atcTeam = atc.Team{
+						MaxConcurrentBuilds: &maxConcurrentBuilds,
+						BuildWeight:         &buildWeight,
+					}
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the build quota", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateBuildQuotaCallCount()).To(Equal(1))
+
+					maxConcurrentBuilds, buildWeight := fakeTeam.UpdateBuildQuotaArgsForCall(0)
+					Expect(maxConcurrentBuilds).To(Equal(5))
+					Expect(buildWeight).To(Equal(2))
+				})
+
+				Context("when updating the build quota fails", func() {
+					BeforeEach(func() {
+						fakeTeam.UpdateBuildQuotaReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -268,6 +338,30 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("leaves the build quota alone", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateBuildQuotaCallCount()).To(Equal(0))
+				})
+
+				Context("when a build quota is given", func() {
+					BeforeEach(func() {
+						maxConcurrentBuilds := 100
+						atcTeam = atc.Team{MaxConcurrentBuilds: &maxConcurrentBuilds}
+					})
+
+					It("returns 403 Forbidden", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+						Expect(fakeTeam.UpdateBuildQuotaCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -659,4 +753,150 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/usage", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/usage" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbTeam.UsageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbTeam.UsageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				dbTeam.UsageStub = func(from time.Time, to time.Time, grouping db.UsageGrouping) ([]db.Usage, error) {
+					switch grouping {
+					case db.UsageGroupingJob:
+						return []db.Usage{
+							{PipelineName: "some-pipeline", JobName: "some-job", Containers: 2, ContainerSeconds: 60, Volumes: 1, VolumeSeconds: 30, VolumeBytes: 1024, VolumeByteSeconds: 30720},
+							{PipelineName: "some-pipeline", JobName: "other-job", Containers: 1, ContainerSeconds: 30, CPUShareSeconds: 3072},
+						}, nil
+					default:
+						return []db.Usage{
+							{Containers: 3, ContainerSeconds: 90, CPUShareSeconds: 3072, Volumes: 1, VolumeSeconds: 30, VolumeBytes: 1024, VolumeByteSeconds: 30720},
+						}, nil
+					}
+				}
+			})
+
+			It("returns the usage of the last day", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				from, to, grouping := dbTeam.UsageArgsForCall(0)
+				Expect(to.Sub(from)).To(Equal(24 * time.Hour))
+				Expect(grouping).To(Equal(db.UsageGroupingNone))
+
+				var usage atc.TeamUsage
+				err := json.NewDecoder(response.Body).Decode(&usage)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage.From).To(Equal(from.Unix()))
+				Expect(usage.To).To(Equal(to.Unix()))
+				Expect(usage.Total).To(Equal(atc.Usage{
+					Containers:        3,
+					ContainerSeconds:  90,
+					CPUShareSeconds:   3072,
+					Volumes:           1,
+					VolumeSeconds:     30,
+					VolumeBytes:       1024,
+					VolumeByteSeconds: 30720,
+				}))
+				Expect(usage.Breakdown).To(BeEmpty())
+			})
+
+			Context("when a time range and grouping are given", func() {
+				BeforeEach(func() {
+					queryParams = "?from=1000&to=2000&group_by=job"
+				})
+
+				It("breaks down the usage in that range", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbTeam.UsageCallCount()).To(Equal(2))
+
+					from, to, grouping := dbTeam.UsageArgsForCall(1)
+					Expect(from.Unix()).To(Equal(int64(1000)))
+					Expect(to.Unix()).To(Equal(int64(2000)))
+					Expect(grouping).To(Equal(db.UsageGroupingJob))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"from": 1000,
+						"to": 2000,
+						"total": {"containers": 3, "container_seconds": 90, "cpu_share_seconds": 3072, "memory_byte_seconds": 0, "volumes": 1, "volume_seconds": 30, "volume_bytes": 1024, "volume_byte_seconds": 30720},
+						"breakdown": [
+							{"pipeline_name": "some-pipeline", "job_name": "some-job", "containers": 2, "container_seconds": 60, "cpu_share_seconds": 0, "memory_byte_seconds": 0, "volumes": 1, "volume_seconds": 30, "volume_bytes": 1024, "volume_byte_seconds": 30720},
+							{"pipeline_name": "some-pipeline", "job_name": "other-job", "containers": 1, "container_seconds": 30, "cpu_share_seconds": 3072, "memory_byte_seconds": 0, "volumes": 0, "volume_seconds": 0, "volume_bytes": 0, "volume_byte_seconds": 0}
+						]
+					}`))
+				})
+			})
+
+			Context("when the time range is empty", func() {
+				BeforeEach(func() {
+					queryParams = "?from=2000&to=1000"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.UsageCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the grouping is unknown", func() {
+				BeforeEach(func() {
+					queryParams = "?group_by=build"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.UsageCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the usage fails", func() {
+				BeforeEach(func() {
+					dbTeam.UsageStub = nil
+					dbTeam.UsageReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,94 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultUsagePeriod is how far back usage is reported when no start time is
+// given.
+const defaultUsagePeriod = 24 * time.Hour
+
+func (s *Server) GetTeamUsage(team db.Team) http.Handler {
+	logger := s.logger.Session("get-team-usage")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		to := time.Now()
+		if urlTo := r.FormValue(atc.UsageQueryTo); urlTo != "" {
+			unix, err := strconv.ParseInt(urlTo, 10, 64)
+			if err != nil {
+				http.Error(w, "malformed 'to' timestamp", http.StatusBadRequest)
+				return
+			}
+
+			to = time.Unix(unix, 0)
+		}
+
+		from := to.Add(-defaultUsagePeriod)
+		if urlFrom := r.FormValue(atc.UsageQueryFrom); urlFrom != "" {
+			unix, err := strconv.ParseInt(urlFrom, 10, 64)
+			if err != nil {
+				http.Error(w, "malformed 'from' timestamp", http.StatusBadRequest)
+				return
+			}
+
+			from = time.Unix(unix, 0)
+		}
+
+		if !from.Before(to) {
+			http.Error(w, "'from' must be before 'to'", http.StatusBadRequest)
+			return
+		}
+
+		grouping, err := db.UsageGroupingFromString(r.FormValue(atc.UsageQueryGroupBy))
+		if err != nil {
+			http.Error(w, "'group_by' must be 'pipeline' or 'job'", http.StatusBadRequest)
+			return
+		}
+
+		totals, err := team.Usage(from, to, db.UsageGroupingNone)
+		if err != nil {
+			logger.Error("failed-to-get-usage", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		usage := atc.TeamUsage{
+			From: from.Unix(),
+			To:   to.Unix(),
+		}
+
+		if len(totals) > 0 {
+			usage.Total = present.Usage(totals[0])
+		}
+
+		if grouping != db.UsageGroupingNone {
+			breakdown, err := team.Usage(from, to, grouping)
+			if err != nil {
+				logger.Error("failed-to-get-usage-breakdown", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			usage.Breakdown = []atc.Usage{}
+			for _, u := range breakdown {
+				usage.Breakdown = append(usage.Breakdown, present.Usage(u))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(usage)
+		if err != nil {
+			logger.Error("failed-to-encode-usage", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
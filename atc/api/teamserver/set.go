@@ -34,6 +34,13 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// a team must not be able to lift its own build quota
+	if !acc.IsAdmin() && (atcTeam.MaxConcurrentBuilds != nil || atcTeam.BuildWeight != nil) {
+		hLog.Debug("not-allowed-to-set-build-quota")
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
@@ -50,6 +57,27 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// only touch the build quota when it is given, so that updating a
+		// team's auth does not lift its quota
+		if acc.IsAdmin() && (atcTeam.MaxConcurrentBuilds != nil || atcTeam.BuildWeight != nil) {
+			maxConcurrentBuilds := team.MaxConcurrentBuilds()
+			if atcTeam.MaxConcurrentBuilds != nil {
+				maxConcurrentBuilds = *atcTeam.MaxConcurrentBuilds
+			}
+
+			buildWeight := team.BuildWeight()
+			if atcTeam.BuildWeight != nil {
+				buildWeight = *atcTeam.BuildWeight
+			}
+
+			err = team.UpdateBuildQuota(maxConcurrentBuilds, buildWeight)
+			if err != nil {
+				hLog.Error("failed-to-update-team-build-quota", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -0,0 +1,55 @@
+package volumeserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// ReportWorkerVolumeSizes provides an API endpoint for workers to report how
+// many bytes each of their volumes takes up, so that it can be accounted for
+// in the usage of the team which owns the volume
+func (s *Server) ReportWorkerVolumeSizes(w http.ResponseWriter, r *http.Request) {
+	workerName := r.URL.Query().Get("worker_name")
+	w.Header().Set("Content-Type", "application/json")
+
+	logger := s.logger.Session("report-volume-sizes-for-worker", lager.Data{"name": workerName})
+
+	if workerName == "" {
+		logger.Info("missing-worker-name")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	defer r.Body.Close()
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		logger.Error("failed-to-read-body", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var sizes map[string]int64
+	err = json.Unmarshal(data, &sizes)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-body", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	logger.Debug("sizes-info", lager.Data{
+		"handles-count": len(sizes),
+	})
+
+	err = s.repository.RecordVolumeSizes(workerName, sizes)
+	if err != nil {
+		logger.Error("failed-to-record-volume-sizes", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
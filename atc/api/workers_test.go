@@ -74,6 +74,8 @@ var _ = Describe("Workers API", func() {
 					teamWorker2.GardenAddrReturns(&gardenAddr2)
 					bcURL2 := "5.6.7.8:8888"
 					teamWorker2.BaggageclaimURLReturns(&bcURL2)
+					teamWorker2.StateReturns(db.WorkerStateQuarantined)
+					teamWorker2.QuarantineReasonReturns("failed to create 3 containers in a row")
 					dbWorkerFactory.VisibleWorkersReturns([]db.Worker{
 						teamWorker1,
 						teamWorker2,
@@ -99,8 +101,10 @@ var _ = Describe("Workers API", func() {
 							BaggageclaimURL: "1.2.3.4:8888",
 						},
 						{
-							GardenAddr:      "5.6.7.8:7777",
-							BaggageclaimURL: "5.6.7.8:8888",
+							GardenAddr:       "5.6.7.8:7777",
+							BaggageclaimURL:  "5.6.7.8:8888",
+							State:            "quarantined",
+							QuarantineReason: "failed to create 3 containers in a row",
 						},
 					}))
 
@@ -746,6 +750,284 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/workers/:worker_name/unquarantine", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/unquarantine", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+			fakeWorker.UnquarantineReturns(nil)
+		})
+
+		It("returns 200", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("sees if the worker exists and attempts to unquarantine it", func() {
+			Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+			Expect(fakeWorker.UnquarantineCallCount()).To(Equal(1))
+		})
+
+		Context("when unquarantining the worker fails", func() {
+			BeforeEach(func() {
+				fakeWorker.UnquarantineReturns(errors.New("some-error"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the worker is not quarantined", func() {
+			BeforeEach(func() {
+				fakeWorker.UnquarantineReturns(db.ErrWorkerNotQuarantined)
+			})
+
+			It("returns 409", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusConflict))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not attempt to find the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("GET /api/v1/workers/:worker_name/maintenance", func() {
+		var (
+			response   *http.Response
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/workers/some-worker/maintenance", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			fakeWorker.NameReturns("some-worker")
+			fakeWorker.TeamNameReturns("some-team")
+			fakeWorker.MaintenanceWindowReturns(db.MaintenanceWindow{
+				Start:     time.Unix(1542200000, 0),
+				Duration:  time.Hour,
+				Behaviour: db.MaintenanceBehaviourLand,
+			}, true)
+
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+
+			fakeBuild := new(dbfakes.FakeBuild)
+			fakeBuild.IDReturns(42)
+			fakeBuild.NameReturns("1")
+			fakeBuild.TeamNameReturns("some-team")
+			fakeBuild.StatusReturns(db.BuildStatusStarted)
+			dbBuildFactory.BuildsBlockingWorkerReturns([]db.Build{fakeBuild}, nil)
+		})
+
+		It("returns the maintenance window and the builds blocking it", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(dbBuildFactory.BuildsBlockingWorkerArgsForCall(0)).To(Equal("some-worker"))
+
+			var maintenance atc.WorkerMaintenance
+			err := json.NewDecoder(response.Body).Decode(&maintenance)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(maintenance.Window).To(Equal(atc.MaintenanceWindow{
+				Start:     1542200000,
+				Duration:  3600,
+				Behaviour: "land",
+			}))
+			Expect(maintenance.BlockingBuilds).To(HaveLen(1))
+			Expect(maintenance.BlockingBuilds[0].ID).To(Equal(42))
+		})
+
+		Context("when the worker has no maintenance window", func() {
+			BeforeEach(func() {
+				fakeWorker.MaintenanceWindowReturns(db.MaintenanceWindow{}, false)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when finding the blocking builds fails", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildsBlockingWorkerReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/workers/:worker_name/maintenance", func() {
+		var (
+			response   *http.Response
+			window     atc.MaintenanceWindow
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(window)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/some-worker/maintenance", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			window = atc.MaintenanceWindow{
+				Start:     1542200000,
+				Duration:  3600,
+				Behaviour: "retire",
+			}
+
+			fakeWorker = new(dbfakes.FakeWorker)
+			fakeWorker.TeamNameReturns("some-team")
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+		})
+
+		It("schedules the maintenance window", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(fakeWorker.ScheduleMaintenanceCallCount()).To(Equal(1))
+			Expect(fakeWorker.ScheduleMaintenanceArgsForCall(0)).To(Equal(db.MaintenanceWindow{
+				Start:     time.Unix(1542200000, 0),
+				Duration:  time.Hour,
+				Behaviour: db.MaintenanceBehaviourRetire,
+			}))
+		})
+
+		Context("when the window is invalid", func() {
+			BeforeEach(func() {
+				window.Behaviour = "reboot"
+			})
+
+			It("returns 400 with the error", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("maintenance behaviour must be 'land' or 'retire'"))
+				Expect(fakeWorker.ScheduleMaintenanceCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/workers/:worker_name/maintenance", func() {
+		var (
+			response   *http.Response
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/workers/some-worker/maintenance", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			fakeWorker.TeamNameReturns("some-team")
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+		})
+
+		It("cancels the maintenance window", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(fakeWorker.CancelMaintenanceCallCount()).To(Equal(1))
+		})
+
+		Context("when cancelling fails", func() {
+			BeforeEach(func() {
+				fakeWorker.CancelMaintenanceReturns(errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/workers/:worker_name/heartbeat", func() {
 		var (
 			response   *http.Response
@@ -0,0 +1,140 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetWorkerMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-worker-maintenance")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-get-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	window, scheduled := worker.MaintenanceWindow()
+	if !scheduled {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	builds, err := s.dbBuildFactory.BuildsBlockingWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-get-blocking-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	maintenance := atc.WorkerMaintenance{
+		Window:         present.MaintenanceWindow(window),
+		BlockingBuilds: []atc.Build{},
+	}
+
+	for _, build := range builds {
+		maintenance.BlockingBuilds = append(maintenance.BlockingBuilds, present.Build(build))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(maintenance)
+	if err != nil {
+		logger.Error("failed-to-encode-worker-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) ScheduleWorkerMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("schedule-worker-maintenance")
+	workerName := r.FormValue(":worker_name")
+
+	var window atc.MaintenanceWindow
+	err := json.NewDecoder(r.Body).Decode(&window)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = window.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, err.Error())
+		return
+	}
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-get-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.ScheduleMaintenance(db.MaintenanceWindow{
+		Start:     time.Unix(window.Start, 0),
+		Duration:  time.Duration(window.Duration) * time.Second,
+		Behaviour: db.MaintenanceBehaviour(window.Behaviour),
+	})
+	if err == db.ErrWorkerNotPresent {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-schedule-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) CancelWorkerMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("cancel-worker-maintenance")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-get-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.CancelMaintenance()
+	if err == db.ErrWorkerNotPresent {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-cancel-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -11,6 +11,7 @@ type Server struct {
 
 	teamFactory     db.TeamFactory
 	dbWorkerFactory db.WorkerFactory
+	dbBuildFactory  db.BuildFactory
 	workerProvider  worker.WorkerProvider
 }
 
@@ -18,6 +19,7 @@ func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	dbWorkerFactory db.WorkerFactory,
+	dbBuildFactory db.BuildFactory,
 	workerProvider worker.WorkerProvider,
 
 ) *Server {
@@ -25,6 +27,7 @@ func NewServer(
 		logger:          logger,
 		teamFactory:     teamFactory,
 		dbWorkerFactory: dbWorkerFactory,
+		dbBuildFactory:  dbBuildFactory,
 		workerProvider:  workerProvider,
 	}
 }
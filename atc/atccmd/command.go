@@ -34,12 +34,15 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lockrunner"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/maintenance"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/pipelines"
 	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -63,6 +66,7 @@ import (
 	// dynamically registered credential managers
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
+	_ "github.com/concourse/concourse/atc/creds/localfile"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
 	_ "github.com/concourse/concourse/atc/creds/vault"
@@ -106,7 +110,10 @@ type RunCommand struct {
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" description:"Method by which a worker is selected during container placement."`
+	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" description:"Method by which a worker is selected during container placement. One of volume-locality, fewest-build-containers, limit-active-tasks, or random, or several of them separated by commas, each breaking the ties of the one before."`
+	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum number of tasks a worker may run at once. Tasks wait for a worker to become available beyond this. The limit-active-tasks placement strategy places tasks on the workers with the most room for them. 0 means no limit."`
+	WorkerQuarantineThreshold         int           `long:"worker-quarantine-threshold" default:"0" description:"Number of consecutive container creation failures after which a worker is quarantined and no longer used until it is unquarantined. 0 means workers are never quarantined."`
+	WorkerMaintenanceLeadTime         time.Duration `long:"worker-maintenance-lead-time" default:"1h" description:"How long before a worker's maintenance window to start landing or retiring it, giving its builds time to finish."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
@@ -124,8 +131,13 @@ type RunCommand struct {
 	Metrics struct {
 		HostName   string            `long:"metrics-host-name"   description:"Host string to attach to emitted metrics."`
 		Attributes map[string]string `long:"metrics-attribute"   description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
+
+		Dimensions []string `long:"metrics-dimension" default:"team" default:"pipeline" default:"worker" choice:"team" choice:"pipeline" choice:"job" choice:"step" choice:"resource" choice:"worker" description:"Dimension emitters may break aggregated metrics, e.g. build and step duration histograms, down by. Can be specified multiple times."`
+		Pipelines  []string `long:"metrics-pipeline"    description:"Only break aggregated metrics down by pipeline, job, step or resource for this pipeline. Can be specified multiple times; all pipelines are broken down if omitted." value-name:"TEAM/PIPELINE"`
 	} `group:"Metrics & Diagnostics"`
 
+	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" description:"The value to set for X-Frame-Options. If omitted, the header is not set."`
 	} `group:"Web Server"`
@@ -138,12 +150,18 @@ type RunCommand struct {
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
+	BuildHandoffTimeout  time.Duration `long:"build-handoff-timeout" default:"5m" description:"When draining the ATC (on SIGUSR2 or via the API), how long to wait for running steps to finish. If they have not finished by then, the ATC exits and their builds are resumed elsewhere."`
+
+	MaxActiveBuilds int `long:"max-active-builds" description:"Maximum number of job builds to run at once across all pipelines. Pending builds wait in a queue ordered by job priority. 0 means no limit."`
 
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
 	MaxBuildLogsToRetain     uint64 `long:"max-build-logs-to-retain" description:"Maximum build logs to retain, 0 means not specified. Will override values configured in jobs"`
 
+	DefaultDaysToRetainBuildLogs uint64 `long:"default-days-to-retain-build-logs" description:"Default days to retain build logs. 0 means unlimited"`
+	MaxDaysToRetainBuildLogs     uint64 `long:"max-days-to-retain-build-logs" description:"Maximum days to retain build logs, 0 means not specified. Will override values configured in jobs"`
+
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
 
@@ -155,6 +173,8 @@ type RunCommand struct {
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	BuildLogArchive logarchive.Config `group:"Build Log Archive" namespace:"build-log-archive"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -342,6 +362,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if err := cmd.Tracing.Prepare(); err != nil {
+		return nil, err
+	}
+
 	lockConn, err := cmd.constructLockConn(retryingDriverName)
 	if err != nil {
 		return nil, err
@@ -363,7 +387,9 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
-	members, err := cmd.constructMembers(positionalArguments, logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory)
+	buildHandoff := builds.NewHandoff()
+
+	members, err := cmd.constructMembers(positionalArguments, logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory, buildHandoff)
 	if err != nil {
 		return nil, err
 	}
@@ -406,6 +432,7 @@ func (cmd *RunCommand) constructMembers(
 	backendConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	buildHandoff builds.Handoff,
 ) ([]grouper.Member, error) {
 
 	if len(positionalArguments) != 0 {
@@ -427,12 +454,12 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, buildHandoff)
 	if err != nil {
 		return nil, err
 	}
 
-	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory)
+	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, buildHandoff)
 	if err != nil {
 		return nil, err
 	}
@@ -446,6 +473,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	buildHandoff builds.Handoff,
 ) ([]grouper.Member, error) {
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 
@@ -506,12 +534,16 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbWorkerFactory,
 		workerVersion,
 		cmd.BaggageclaimResponseHeaderTimeout,
+		worker.NewQuarantiner(cmd.WorkerQuarantineThreshold),
 	)
 
-	workerClient := cmd.constructWorkerPool(
+	workerClient, err := cmd.constructWorkerPool(
 		logger,
 		workerProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resource.NewResourceFactory(workerClient)
@@ -524,18 +556,21 @@ func (cmd *RunCommand) constructAPIMembers(
 	if err != nil {
 		return nil, err
 	}
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, variablesFactory, defaultLimits)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, teamFactory, variablesFactory, defaultLimits)
 
 	dbResourceConfigCheckSessionFactory := db.NewResourceConfigCheckSessionFactory(dbConn, lockFactory)
+	dbBuildQueue := db.NewBuildQueue(dbConn, lockFactory, cmd.MaxActiveBuilds)
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
 		resourceFactory,
 		dbResourceConfigCheckSessionFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
 		engine,
+		dbBuildQueue,
 	)
 
 	radarScannerFactory := radar.NewScannerFactory(
+		logger.Session("radar-scanner-factory"),
 		resourceFactory,
 		dbResourceConfigCheckSessionFactory,
 		cmd.ResourceTypeCheckingInterval,
@@ -554,6 +589,11 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
 
+	buildLogArchiver, err := cmd.BuildLogArchive.Archiver()
+	if err != nil {
+		return nil, err
+	}
+
 	apiHandler, err := cmd.constructAPIHandler(
 		logger,
 		reconfigurableSink,
@@ -566,10 +606,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
+		dbBuildQueue,
 		engine,
 		workerClient,
 		workerProvider,
 		drain,
+		buildHandoff,
+		buildLogArchiver,
 		radarSchedulerFactory,
 		radarScannerFactory,
 		variablesFactory,
@@ -629,6 +672,17 @@ func (cmd *RunCommand) constructAPIMembers(
 	}
 
 	members := []grouper.Member{
+		{Name: "handoff", Runner: handoff{
+			logger:  logger.Session("api-handoff"),
+			handoff: buildHandoff,
+			tracker: builds.NewTracker(
+				logger.Session("api-build-tracker"),
+				dbBuildFactory,
+				engine,
+			),
+			timeout: cmd.BuildHandoffTimeout,
+			bus:     dbConn.Bus(),
+		}},
 		{Name: "debug", Runner: http_server.New(
 			cmd.debugBindAddr(),
 			http.DefaultServeMux,
@@ -658,6 +712,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	logger lager.Logger,
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
+	buildHandoff builds.Handoff,
 ) ([]grouper.Member, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -669,6 +724,11 @@ func (cmd *RunCommand) constructBackendMembers(
 		syslogDrainConfigured = false
 	}
 
+	buildLogArchiver, err := cmd.BuildLogArchive.Archiver()
+	if err != nil {
+		return nil, err
+	}
+
 	drain := make(chan struct{})
 
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
@@ -703,11 +763,15 @@ func (cmd *RunCommand) constructBackendMembers(
 		dbWorkerFactory,
 		workerVersion,
 		cmd.BaggageclaimResponseHeaderTimeout,
+		worker.NewQuarantiner(cmd.WorkerQuarantineThreshold),
 	)
-	workerClient := cmd.constructWorkerPool(
+	workerClient, err := cmd.constructWorkerPool(
 		logger,
 		workerProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resource.NewResourceFactory(workerClient)
@@ -720,15 +784,17 @@ func (cmd *RunCommand) constructBackendMembers(
 	if err != nil {
 		return nil, err
 	}
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, variablesFactory, defaultLimits)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, teamFactory, variablesFactory, defaultLimits)
 
 	dbResourceConfigCheckSessionFactory := db.NewResourceConfigCheckSessionFactory(dbConn, lockFactory)
+	dbBuildQueue := db.NewBuildQueue(dbConn, lockFactory, cmd.MaxActiveBuilds)
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
 		resourceFactory,
 		dbResourceConfigCheckSessionFactory,
 		cmd.ResourceTypeCheckingInterval,
 		cmd.ResourceCheckingInterval,
 		engine,
+		dbBuildQueue,
 	)
 	dbWorkerLifecycle := db.NewWorkerLifecycle(dbConn)
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(dbConn)
@@ -738,6 +804,17 @@ func (cmd *RunCommand) constructBackendMembers(
 	bus := dbConn.Bus()
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	members := []grouper.Member{
+		{Name: "handoff", Runner: handoff{
+			logger:  logger.Session("handoff"),
+			handoff: buildHandoff,
+			tracker: builds.NewTracker(
+				logger.Session("build-tracker"),
+				dbBuildFactory,
+				engine,
+			),
+			timeout: cmd.BuildHandoffTimeout,
+			bus:     bus,
+		}},
 		{Name: "drainer", Runner: drainer{
 			logger: logger.Session("drain"),
 			drain:  drain,
@@ -800,6 +877,27 @@ func (cmd *RunCommand) constructBackendMembers(
 			clock.NewClock(),
 			cmd.GC.Interval,
 		)},
+		{Name: "maintenance-scheduler", Runner: lockrunner.NewRunner(
+			logger.Session("maintenance-scheduler"),
+			maintenance.NewScheduler(
+				dbWorkerFactory,
+				dbBuildFactory,
+				clock.NewClock(),
+				cmd.WorkerMaintenanceLeadTime,
+			),
+			"maintenance-scheduler",
+			lockFactory,
+			clock.NewClock(),
+			30*time.Second,
+		)},
+		{Name: "usage-collector", Runner: lockrunner.NewRunner(
+			logger.Session("usage-collector"),
+			gc.NewUsageCollector(db.NewUsageRepository(dbConn)),
+			"usage-collector",
+			lockFactory,
+			clock.NewClock(),
+			cmd.GC.Interval,
+		)},
 		// run separately so as to not preempt critical GC
 		{Name: "build-log-collector", Runner: lockrunner.NewRunner(
 			logger.Session("build-log-collector"),
@@ -809,8 +907,12 @@ func (cmd *RunCommand) constructBackendMembers(
 				gc.NewBuildLogRetentionCalculator(
 					cmd.DefaultBuildLogsToRetain,
 					cmd.MaxBuildLogsToRetain,
+					cmd.DefaultDaysToRetainBuildLogs,
+					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				clock.NewClock(),
+				buildLogArchiver,
 			),
 			"build-reaper",
 			lockFactory,
@@ -1086,7 +1188,15 @@ func (cmd *RunCommand) configureMetrics(logger lager.Logger) error {
 		host, _ = os.Hostname()
 	}
 
-	return metric.Initialize(logger.Session("metrics"), host, cmd.Metrics.Attributes)
+	return metric.Initialize(
+		logger.Session("metrics"),
+		host,
+		cmd.Metrics.Attributes,
+		metric.Dimensions{
+			Allowed:   cmd.Metrics.Dimensions,
+			Pipelines: cmd.Metrics.Pipelines,
+		},
+	)
 }
 
 func (cmd *RunCommand) constructDBConn(
@@ -1136,20 +1246,21 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 func (cmd *RunCommand) constructWorkerPool(
 	logger lager.Logger,
 	workerProvider worker.WorkerProvider,
-) worker.Client {
-
-	var strategy worker.ContainerPlacementStrategy
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+) (worker.Client, error) {
+	strategy, err := worker.NewContainerPlacementStrategy(
+		cmd.ContainerPlacementStrategy,
+		cmd.MaxActiveTasksPerWorker,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	return worker.NewPool(
+		clock.NewClock(),
 		workerProvider,
 		strategy,
-	)
+		cmd.MaxActiveTasksPerWorker,
+	), nil
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1180,6 +1291,7 @@ func (cmd *RunCommand) constructEngine(
 	resourceFetcher resource.Fetcher,
 	resourceFactory resource.ResourceFactory,
 	dbResourceCacheFactory db.ResourceCacheFactory,
+	dbTeamFactory db.TeamFactory,
 	variablesFactory creds.VariablesFactory,
 	defaultLimits atc.ContainerLimits,
 ) engine.Engine {
@@ -1188,8 +1300,10 @@ func (cmd *RunCommand) constructEngine(
 		resourceFetcher,
 		resourceFactory,
 		dbResourceCacheFactory,
+		dbTeamFactory,
 		variablesFactory,
 		defaultLimits,
+		clock.NewClock(),
 	)
 
 	execV2Engine := engine.NewExecEngine(
@@ -1246,10 +1360,13 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbContainerRepository db.ContainerRepository,
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
+	dbBuildQueue db.BuildQueue,
 	engine engine.Engine,
 	workerClient worker.Client,
 	workerProvider worker.WorkerProvider,
 	drain <-chan struct{},
+	buildHandoff builds.Handoff,
+	buildLogArchiver logarchive.Archiver,
 	radarSchedulerFactory pipelines.RadarSchedulerFactory,
 	radarScannerFactory radar.ScannerFactory,
 	variablesFactory creds.VariablesFactory,
@@ -1288,10 +1405,13 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
+		dbBuildQueue,
 
 		cmd.PeerURLOrDefault().String(),
 		buildserver.NewEventHandler,
 		drain,
+		buildHandoff,
+		buildLogArchiver,
 
 		engine,
 		workerClient,
@@ -1343,7 +1463,13 @@ func (cmd *RunCommand) constructPipelineSyncer(
 		logger,
 		pipelineFactory,
 		func(pipeline db.Pipeline) ifrit.Runner {
-			variables := variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name())
+			variables := creds.NewPipelineVariables(
+				logger.Session(pipeline.ScopedName("var-sources")),
+				variablesFactory,
+				pipeline.TeamName(),
+				pipeline.Name(),
+				pipeline.VarSources(),
+			)
 			return grouper.NewParallel(os.Interrupt, grouper.Members{
 				{
 					pipeline.ScopedName("radar"),
@@ -0,0 +1,62 @@
+package atccmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// handoff drains the builds tracked by an engine once a handoff is requested,
+// either through the API or by sending the ATC SIGUSR2. The ATC keeps running
+// afterwards so that it can be shut down as usual, unless the builds did not
+// drain in time, in which case it exits.
+type handoff struct {
+	logger  lager.Logger
+	handoff builds.Handoff
+	tracker builds.BuildTracker
+	timeout time.Duration
+	bus     db.NotificationsBus
+}
+
+func (h handoff) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	usr2 := make(chan os.Signal, 1)
+	signal.Notify(usr2, syscall.SIGUSR2)
+	defer signal.Stop(usr2)
+
+	close(ready)
+
+	select {
+	case <-usr2:
+		h.logger.Info("received-handoff-signal")
+		h.handoff.Request()
+	case <-h.handoff.Requested():
+	case <-signals:
+		return nil
+	}
+
+	h.logger.Info("draining-tracker")
+	if !h.tracker.Drain(h.timeout) {
+		// steps are still running, and releasing their builds would let another
+		// ATC run them at the same time. exiting stops them, and releases the
+		// builds' tracking locks along with the database connections, after
+		// which the builds are picked up again as on any ATC restart.
+		h.logger.Info("timed-out-draining-tracker")
+		os.Exit(1)
+	}
+	h.logger.Info("drained-tracker")
+
+	// let the other ATCs know that there are builds to pick up
+	err := h.bus.Notify("atc_shutdown")
+	if err != nil {
+		h.logger.Error("failed-to-send-atc-shutdown-message", err)
+	}
+
+	<-signals
+
+	return nil
+}
@@ -22,6 +22,12 @@ type Build struct {
 	StartTime    int64  `json:"start_time,omitempty"`
 	EndTime      int64  `json:"end_time,omitempty"`
 	ReapTime     int64  `json:"reap_time,omitempty"`
+	LogsArchived bool   `json:"logs_archived,omitempty"`
+	RerunOf      int    `json:"rerun_of,omitempty"`
+	RerunNumber  int    `json:"rerun_number,omitempty"`
+
+	Priority      int `json:"priority,omitempty"`
+	QueuePosition int `json:"queue_position,omitempty"`
 }
 
 func (b Build) IsRunning() bool {
@@ -3,11 +3,23 @@ package buildsfakes
 
 import (
 	sync "sync"
+	time "time"
 
 	builds "github.com/concourse/concourse/atc/builds"
 )
 
 type FakeBuildTracker struct {
+	DrainStub        func(time.Duration) bool
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+		arg1 time.Duration
+	}
+	drainReturns struct {
+		result1 bool
+	}
+	drainReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	ReleaseStub        func()
 	releaseMutex       sync.RWMutex
 	releaseArgsForCall []struct {
@@ -20,6 +32,56 @@ type FakeBuildTracker struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildTracker) Drain(arg1 time.Duration) bool {
+	fake.drainMutex.Lock()
+	ret, specificReturn := fake.drainReturnsOnCall[len(fake.drainArgsForCall)]
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("Drain", []interface{}{arg1})
+	fake.drainMutex.Unlock()
+	if fake.DrainStub != nil {
+		return fake.DrainStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.drainReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildTracker) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *FakeBuildTracker) DrainArgsForCall(i int) time.Duration {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	argsForCall := fake.drainArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildTracker) DrainReturns(result1 bool) {
+	fake.DrainStub = nil
+	fake.drainReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuildTracker) DrainReturnsOnCall(i int, result1 bool) {
+	fake.DrainStub = nil
+	if fake.drainReturnsOnCall == nil {
+		fake.drainReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.drainReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuildTracker) Release() {
 	fake.releaseMutex.Lock()
 	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
@@ -57,6 +119,8 @@ func (fake *FakeBuildTracker) TrackCallCount() int {
 func (fake *FakeBuildTracker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
 	fake.releaseMutex.RLock()
 	defer fake.releaseMutex.RUnlock()
 	fake.trackMutex.RLock()
@@ -0,0 +1,34 @@
+package builds
+
+import "sync"
+
+//go:generate counterfeiter . Handoff
+
+// Handoff is used to ask the ATC to hand its in-flight builds off to the other
+// ATCs in the cluster, e.g. ahead of it being shut down.
+type Handoff interface {
+	Request()
+	Requested() <-chan struct{}
+}
+
+type handoff struct {
+	requested chan struct{}
+	once      *sync.Once
+}
+
+func NewHandoff() Handoff {
+	return &handoff{
+		requested: make(chan struct{}),
+		once:      new(sync.Once),
+	}
+}
+
+func (h *handoff) Request() {
+	h.once.Do(func() {
+		close(h.requested)
+	})
+}
+
+func (h *handoff) Requested() <-chan struct{} {
+	return h.requested
+}
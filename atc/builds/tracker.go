@@ -1,6 +1,8 @@
 package builds
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
@@ -66,3 +68,26 @@ func (bt *Tracker) Release() {
 
 	bt.engine.ReleaseAll(rLog)
 }
+
+// Drain hands the builds tracked by the engine off to the other ATCs once
+// their running steps have finished. It returns false if the steps did not
+// finish within the timeout, in which case they are still running.
+func (bt *Tracker) Drain(timeout time.Duration) bool {
+	dLog := bt.logger.Session("drain")
+	dLog.Debug("start")
+	defer dLog.Debug("done")
+
+	drained := make(chan struct{})
+	go func() {
+		bt.engine.Drain(dLog)
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return true
+	case <-time.After(timeout):
+		dLog.Info("timed-out-waiting-for-steps")
+		return false
+	}
+}
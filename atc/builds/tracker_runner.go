@@ -13,6 +13,7 @@ import (
 type BuildTracker interface {
 	Track()
 	Release()
+	Drain(time.Duration) bool
 }
 
 //go:generate counterfeiter . ATCListener
@@ -2,6 +2,7 @@ package builds_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
@@ -101,4 +102,35 @@ var _ = Describe("Tracker", func() {
 			Expect(fakeEngine.ReleaseAllCallCount()).To(Equal(1))
 		})
 	})
+
+	Describe("Drain", func() {
+		It("drains the engine", func() {
+			Expect(tracker.Drain(time.Minute)).To(BeTrue())
+
+			Expect(fakeEngine.DrainCallCount()).To(Equal(1))
+			Expect(fakeEngine.ReleaseAllCallCount()).To(BeZero())
+		})
+
+		Context("when the engine takes longer than the timeout to drain", func() {
+			var drained chan struct{}
+
+			BeforeEach(func() {
+				drained = make(chan struct{})
+
+				fakeEngine.DrainStub = func(lager.Logger) {
+					<-drained
+				}
+			})
+
+			AfterEach(func() {
+				close(drained)
+			})
+
+			It("returns false without releasing the builds, as their steps are still running", func() {
+				Expect(tracker.Drain(10 * time.Millisecond)).To(BeFalse())
+
+				Expect(fakeEngine.ReleaseAllCallCount()).To(BeZero())
+			})
+		})
+	})
 })
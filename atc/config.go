@@ -20,10 +20,54 @@ type ConfigResponse struct {
 }
 
 type Config struct {
-	Groups        GroupConfigs    `yaml:"groups" json:"groups" mapstructure:"groups"`
-	Resources     ResourceConfigs `yaml:"resources" json:"resources" mapstructure:"resources"`
-	ResourceTypes ResourceTypes   `yaml:"resource_types" json:"resource_types" mapstructure:"resource_types"`
-	Jobs          JobConfigs      `yaml:"jobs" json:"jobs" mapstructure:"jobs"`
+	Groups        GroupConfigs     `yaml:"groups" json:"groups" mapstructure:"groups"`
+	Resources     ResourceConfigs  `yaml:"resources" json:"resources" mapstructure:"resources"`
+	ResourceTypes ResourceTypes    `yaml:"resource_types" json:"resource_types" mapstructure:"resource_types"`
+	Jobs          JobConfigs       `yaml:"jobs" json:"jobs" mapstructure:"jobs"`
+	VarSources    VarSourceConfigs `yaml:"var_sources,omitempty" json:"var_sources,omitempty" mapstructure:"var_sources"`
+}
+
+// VarSourceConfig configures a credential manager for a single pipeline.
+// Vars are fetched from it with the ((name:path.field)) syntax.
+type VarSourceConfig struct {
+	Name   string                 `yaml:"name" json:"name" mapstructure:"name"`
+	Type   string                 `yaml:"type" json:"type" mapstructure:"type"`
+	Config map[string]interface{} `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
+}
+
+// Redacted returns the var source with only its name and type.
+func (source VarSourceConfig) Redacted() VarSourceConfig {
+	return VarSourceConfig{
+		Name: source.Name,
+		Type: source.Type,
+	}
+}
+
+type VarSourceConfigs []VarSourceConfig
+
+func (sources VarSourceConfigs) Lookup(name string) (VarSourceConfig, bool) {
+	for _, source := range sources {
+		if source.Name == name {
+			return source, true
+		}
+	}
+
+	return VarSourceConfig{}, false
+}
+
+// Redacted returns the var sources with their configs removed, as they hold
+// the credentials used to reach the credential managers.
+func (sources VarSourceConfigs) Redacted() VarSourceConfigs {
+	if sources == nil {
+		return nil
+	}
+
+	redacted := make(VarSourceConfigs, len(sources))
+	for i, source := range sources {
+		redacted[i] = source.Redacted()
+	}
+
+	return redacted
 }
 
 type RawConfig string
@@ -105,6 +149,57 @@ type Hooks struct {
 // `on: [success]` after every Task plan.
 type PlanSequence []PlanConfig
 
+// An InParallelConfig runs a sequence of plans in parallel. It may be
+// configured as either a list of steps or with the steps alongside a limit on
+// how many run at once and whether to fail fast.
+type InParallelConfig struct {
+	Steps    PlanSequence `yaml:"steps,omitempty" json:"steps" mapstructure:"steps"`
+	Limit    int          `yaml:"limit,omitempty" json:"limit,omitempty" mapstructure:"limit"`
+	FailFast bool         `yaml:"fail_fast,omitempty" json:"fail_fast,omitempty" mapstructure:"fail_fast"`
+}
+
+func (c *InParallelConfig) UnmarshalJSON(payload []byte) error {
+	var steps PlanSequence
+	if json.Unmarshal(payload, &steps) == nil {
+		c.Steps = steps
+		return nil
+	}
+
+	// avoid recursing into UnmarshalJSON
+	type target InParallelConfig
+
+	var config target
+	err := json.Unmarshal(payload, &config)
+	if err != nil {
+		return err
+	}
+
+	*c = InParallelConfig(config)
+
+	return nil
+}
+
+func (c *InParallelConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var steps PlanSequence
+	if unmarshal(&steps) == nil {
+		c.Steps = steps
+		return nil
+	}
+
+	// avoid recursing into UnmarshalYAML
+	type target InParallelConfig
+
+	var config target
+	err := unmarshal(&config)
+	if err != nil {
+		return err
+	}
+
+	*c = InParallelConfig(config)
+
+	return nil
+}
+
 // A VersionConfig represents the choice to include every version of a
 // resource, the latest version of a resource, or a pinned (specific) one.
 type VersionConfig struct {
@@ -221,6 +316,9 @@ type PlanConfig struct {
 	// corresponds to an Aggregate plan, keyed by the name of each sub-plan
 	Aggregate *PlanSequence `yaml:"aggregate,omitempty" json:"aggregate,omitempty" mapstructure:"aggregate"`
 
+	// corresponds to an InParallel plan, which runs its steps in parallel
+	InParallel *InParallelConfig `yaml:"in_parallel,omitempty" json:"in_parallel,omitempty" mapstructure:"in_parallel"`
+
 	// corresponds to Get and Put resource plans, respectively
 	// name of 'input', e.g. bosh-stemcell
 	Get string `yaml:"get,omitempty" json:"get,omitempty" mapstructure:"get"`
@@ -245,6 +343,22 @@ type PlanConfig struct {
 	// inlined task config
 	TaskConfig *TaskConfig `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
 
+	// corresponds to a SetPipeline plan
+	// name of the pipeline to configure, e.g. other-pipeline
+	SetPipeline string `yaml:"set_pipeline,omitempty" json:"set_pipeline,omitempty" mapstructure:"set_pipeline"`
+	// static vars to interpolate into the pipeline config
+	Vars Params `yaml:"vars,omitempty" json:"vars,omitempty" mapstructure:"vars"`
+	// paths to files containing vars to interpolate into the pipeline config
+	VarFiles []string `yaml:"var_files,omitempty" json:"var_files,omitempty" mapstructure:"var_files"`
+
+	// corresponds to a LoadVar plan
+	// name of the build-local var to set, e.g. version
+	LoadVar string `yaml:"load_var,omitempty" json:"load_var,omitempty" mapstructure:"load_var"`
+	// format of the var file: raw, trim, json or yaml
+	Format string `yaml:"format,omitempty" json:"format,omitempty" mapstructure:"format"`
+	// redact the var's value from the build's logs
+	Sensitive bool `yaml:"sensitive,omitempty" json:"sensitive,omitempty" mapstructure:"sensitive"`
+
 	// used by Get and Put for specifying params to the resource
 	Params Params `yaml:"params,omitempty" json:"params,omitempty" mapstructure:"params"`
 
@@ -261,6 +375,9 @@ type PlanConfig struct {
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `yaml:"tags,omitempty" json:"tags,omitempty" mapstructure:"tags"`
 
+	// used by get, put and task to only run the step on workers whose labels match
+	Placement PlacementConstraints `yaml:"placement,omitempty" json:"placement,omitempty" mapstructure:"placement"`
+
 	// used by any step to run something when the build is aborted during execution of the step
 	Abort *PlanConfig `yaml:"on_abort,omitempty" json:"on_abort,omitempty" mapstructure:"on_abort"`
 
@@ -285,9 +402,23 @@ type PlanConfig struct {
 	// repeat the step up to N times, until it works
 	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
 
+	// used on any step to run it once for every combination of the vars' values
+	Across []AcrossVarConfig `yaml:"across,omitempty" json:"across,omitempty" mapstructure:"across"`
+	// used with across to abort the remaining combinations once one fails
+	FailFast bool `yaml:"fail_fast,omitempty" json:"fail_fast,omitempty" mapstructure:"fail_fast"`
+
 	Version *VersionConfig `yaml:"version,omitempty" json:"version,omitempty" mapstructure:"version"`
 }
 
+// An AcrossVarConfig is a var which a step is run across, along with the values
+// it takes. The values are either a static list or a ((var)) which resolves to
+// a list when the build is created.
+type AcrossVarConfig struct {
+	Var         string      `yaml:"var" json:"var" mapstructure:"var"`
+	Values      interface{} `yaml:"values,omitempty" json:"values,omitempty" mapstructure:"values"`
+	MaxInFlight int         `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
+}
+
 func (config PlanConfig) Name() string {
 	if config.RawName != "" {
 		return config.RawName
@@ -305,6 +436,14 @@ func (config PlanConfig) Name() string {
 		return config.Task
 	}
 
+	if config.SetPipeline != "" {
+		return config.SetPipeline
+	}
+
+	if config.LoadVar != "" {
+		return config.LoadVar
+	}
+
 	return ""
 }
 
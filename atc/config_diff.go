@@ -0,0 +1,358 @@
+package atc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/aryann/difflib"
+	"github.com/mgutz/ansi"
+	"gopkg.in/yaml.v2"
+)
+
+type diffIndex interface {
+	FindEquivalent(interface{}) (interface{}, bool)
+	Slice() []interface{}
+}
+
+type configDiffs []configDiff
+
+type configDiff struct {
+	Before interface{}
+	After  interface{}
+}
+
+func diffName(v interface{}) string {
+	return reflect.ValueOf(v).FieldByName("Name").String()
+}
+
+func (diff configDiff) Render(to io.Writer, label string) {
+
+	if diff.Before != nil && diff.After != nil {
+		fmt.Fprintf(to, ansi.Color("%s %s has changed:", "yellow")+"\n", label, diffName(diff.Before))
+
+		payloadA, _ := yaml.Marshal(diff.Before)
+		payloadB, _ := yaml.Marshal(diff.After)
+
+		renderDiff(to, string(payloadA), string(payloadB))
+	} else if diff.Before != nil {
+		fmt.Fprintf(to, ansi.Color("%s %s has been removed:", "yellow")+"\n", label, diffName(diff.Before))
+
+		payloadA, _ := yaml.Marshal(diff.Before)
+
+		renderDiff(to, string(payloadA), "")
+	} else {
+		fmt.Fprintf(to, ansi.Color("%s %s has been added:", "yellow")+"\n", label, diffName(diff.After))
+
+		payloadB, _ := yaml.Marshal(diff.After)
+
+		renderDiff(to, "", string(payloadB))
+	}
+}
+
+type groupIndex GroupConfigs
+
+func (index groupIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index groupIndex) FindEquivalentWithOrder(obj interface{}) (interface{}, int, bool) {
+	return GroupConfigs(index).Lookup(diffName(obj))
+}
+
+type jobIndex JobConfigs
+
+func (index jobIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index jobIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return JobConfigs(index).Lookup(diffName(obj))
+}
+
+type resourceIndex ResourceConfigs
+
+func (index resourceIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index resourceIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return ResourceConfigs(index).Lookup(diffName(obj))
+}
+
+type resourceTypeIndex ResourceTypes
+
+func (index resourceTypeIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index resourceTypeIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return ResourceTypes(index).Lookup(diffName(obj))
+}
+
+type varSourceIndex VarSourceConfigs
+
+func (index varSourceIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index varSourceIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return VarSourceConfigs(index).Lookup(diffName(obj))
+}
+
+func groupDiffIndices(oldIndex groupIndex, newIndex groupIndex) configDiffs {
+	diffs := configDiffs{}
+
+	for oldIndexNum, thing := range oldIndex.Slice() {
+		newThing, newIndexNum, found := newIndex.FindEquivalentWithOrder(thing)
+		if !found {
+			diffs = append(diffs, configDiff{
+				Before: thing,
+				After:  nil,
+			})
+			continue
+		}
+
+		if practicallyDifferent(thing, newThing) {
+			diffs = append(diffs, configDiff{
+				Before: thing,
+				After:  newThing,
+			})
+		}
+
+		if oldIndexNum != newIndexNum {
+			diffs = append(diffs, configDiff{
+				Before: thing,
+				After:  newThing,
+			})
+		}
+	}
+
+	for _, thing := range newIndex.Slice() {
+		_, _, found := oldIndex.FindEquivalentWithOrder(thing)
+		if !found {
+			diffs = append(diffs, configDiff{
+				Before: nil,
+				After:  thing,
+			})
+			continue
+		}
+	}
+
+	return diffs
+}
+
+func diffIndices(oldIndex diffIndex, newIndex diffIndex) configDiffs {
+	diffs := configDiffs{}
+
+	for _, thing := range oldIndex.Slice() {
+		newThing, found := newIndex.FindEquivalent(thing)
+		if !found {
+			diffs = append(diffs, configDiff{
+				Before: thing,
+				After:  nil,
+			})
+			continue
+		}
+
+		if practicallyDifferent(thing, newThing) {
+			diffs = append(diffs, configDiff{
+				Before: thing,
+				After:  newThing,
+			})
+		}
+	}
+
+	for _, thing := range newIndex.Slice() {
+		_, found := oldIndex.FindEquivalent(thing)
+		if !found {
+			diffs = append(diffs, configDiff{
+				Before: nil,
+				After:  thing,
+			})
+			continue
+		}
+	}
+
+	return diffs
+}
+
+// varSourceDiffIndices diffs the var sources without rendering their configs,
+// which hold credentials. A var source whose config is missing on either side
+// (e.g. because it was redacted by the API) is treated as changed whenever the
+// other side has one, as there is no telling whether it differs.
+func varSourceDiffIndices(oldSources VarSourceConfigs, newSources VarSourceConfigs) configDiffs {
+	diffs := diffIndices(varSourceIndex(oldSources.Redacted()), varSourceIndex(newSources.Redacted()))
+
+	for _, newSource := range newSources {
+		oldSource, found := oldSources.Lookup(newSource.Name)
+		if !found || oldSource.Type != newSource.Type {
+			continue
+		}
+
+		if practicallyDifferent(oldSource.Config, newSource.Config) {
+			diffs = append(diffs, configDiff{
+				Before: oldSource.Redacted(),
+				After:  newSource.Redacted(),
+			})
+		}
+	}
+
+	return diffs
+}
+
+func renderDiff(to io.Writer, a, b string) {
+	diffs := difflib.Diff(strings.Split(a, "\n"), strings.Split(b, "\n"))
+	indent := newPrefixedWriter("\b\b", to)
+
+	for _, diff := range diffs {
+		text := diff.Payload
+
+		switch diff.Delta {
+		case difflib.RightOnly:
+			fmt.Fprintf(indent, "%s %s\n", ansi.Color("+", "green"), ansi.Color(text, "green"))
+		case difflib.LeftOnly:
+			fmt.Fprintf(indent, "%s %s\n", ansi.Color("-", "red"), ansi.Color(text, "red"))
+		case difflib.Common:
+			fmt.Fprintf(to, "%s\n", text)
+		}
+	}
+}
+
+func practicallyDifferent(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return false
+	}
+
+	// prevent silly things like 300 != 300.0 due to YAML vs. JSON
+	// inconsistencies
+
+	marshalledA, _ := yaml.Marshal(a)
+	marshalledB, _ := yaml.Marshal(b)
+
+	return !bytes.Equal(marshalledA, marshalledB)
+}
+
+// Diff renders the differences between the config and the given new config to
+// the writer, returning whether there were any changes.
+func (c Config) Diff(out io.Writer, newConfig Config) bool {
+	var diffExists bool
+
+	indent := newPrefixedWriter("  ", out)
+
+	groupDiffs := groupDiffIndices(groupIndex(c.Groups), groupIndex(newConfig.Groups))
+	if len(groupDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "groups:")
+
+		for _, diff := range groupDiffs {
+			diff.Render(indent, "group")
+		}
+	}
+
+	resourceDiffs := diffIndices(resourceIndex(c.Resources), resourceIndex(newConfig.Resources))
+	if len(resourceDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "resources:")
+
+		for _, diff := range resourceDiffs {
+			diff.Render(indent, "resource")
+		}
+	}
+
+	resourceTypeDiffs := diffIndices(resourceTypeIndex(c.ResourceTypes), resourceTypeIndex(newConfig.ResourceTypes))
+	if len(resourceTypeDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "resource types:")
+
+		for _, diff := range resourceTypeDiffs {
+			diff.Render(indent, "resource type")
+		}
+	}
+
+	jobDiffs := diffIndices(jobIndex(c.Jobs), jobIndex(newConfig.Jobs))
+	if len(jobDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "jobs:")
+
+		for _, diff := range jobDiffs {
+			diff.Render(indent, "job")
+		}
+	}
+
+	varSourceDiffs := varSourceDiffIndices(c.VarSources, newConfig.VarSources)
+	if len(varSourceDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "var sources:")
+
+		for _, diff := range varSourceDiffs {
+			diff.Render(indent, "var source")
+		}
+	}
+
+	return diffExists
+}
+
+// prefixedWriter prefixes every line written to it, mirroring the behavior of
+// gexec's PrefixedWriter without pulling test dependencies into the ATC.
+type prefixedWriter struct {
+	prefix        []byte
+	writer        io.Writer
+	atStartOfLine bool
+}
+
+func newPrefixedWriter(prefix string, writer io.Writer) *prefixedWriter {
+	return &prefixedWriter{
+		prefix:        []byte(prefix),
+		writer:        writer,
+		atStartOfLine: true,
+	}
+}
+
+func (w *prefixedWriter) Write(b []byte) (int, error) {
+	toWrite := []byte{}
+
+	for _, c := range b {
+		if w.atStartOfLine {
+			toWrite = append(toWrite, w.prefix...)
+		}
+
+		toWrite = append(toWrite, c)
+
+		w.atStartOfLine = c == '\n'
+	}
+
+	_, err := w.writer.Write(toWrite)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
@@ -46,4 +46,122 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("InParallelConfig", func() {
+		expected := InParallelConfig{
+			Steps: PlanSequence{
+				{Task: "some-task"},
+				{Get: "some-resource"},
+			},
+		}
+
+		Context("when unmarshaling a list of steps from YAML", func() {
+			It("uses the list as the steps", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte("[{task: some-task}, {get: some-resource}]")
+				err := yaml.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(inParallelConfig).To(Equal(expected))
+			})
+		})
+
+		Context("when unmarshaling a list of steps from JSON", func() {
+			It("uses the list as the steps", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`[{"task":"some-task"},{"get":"some-resource"}]`)
+				err := json.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(inParallelConfig).To(Equal(expected))
+			})
+		})
+
+		Context("when unmarshaling the steps with options from YAML", func() {
+			It("produces the correct config without error", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte("{steps: [{task: some-task}, {get: some-resource}], limit: 2, fail_fast: true}")
+				err := yaml.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				withOptions := expected
+				withOptions.Limit = 2
+				withOptions.FailFast = true
+				Expect(inParallelConfig).To(Equal(withOptions))
+			})
+		})
+
+		Context("when unmarshaling the steps with options from JSON", func() {
+			It("produces the correct config without error", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`{"steps":[{"task":"some-task"},{"get":"some-resource"}],"limit":2,"fail_fast":true}`)
+				err := json.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				withOptions := expected
+				withOptions.Limit = 2
+				withOptions.FailFast = true
+				Expect(inParallelConfig).To(Equal(withOptions))
+			})
+		})
+	})
+
+	Describe("BuildLogRetention", func() {
+		Context("when unmarshaling a number from YAML", func() {
+			It("uses it as the number of builds", func() {
+				var retention BuildLogRetention
+				err := yaml.Unmarshal([]byte("10"), &retention)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(retention).To(Equal(BuildLogRetention{Builds: 10}))
+			})
+		})
+
+		Context("when unmarshaling a number from JSON", func() {
+			It("uses it as the number of builds", func() {
+				var retention BuildLogRetention
+				err := json.Unmarshal([]byte("10"), &retention)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(retention).To(Equal(BuildLogRetention{Builds: 10}))
+			})
+		})
+
+		Context("when unmarshaling a policy from YAML", func() {
+			It("produces the correct config without error", func() {
+				var retention BuildLogRetention
+				err := yaml.Unmarshal([]byte("{builds: 10, days: 7, minimum_succeeded_builds: 2}"), &retention)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(retention).To(Equal(BuildLogRetention{Builds: 10, Days: 7, MinimumSucceededBuilds: 2}))
+			})
+		})
+
+		Context("when marshaling only a number of builds", func() {
+			It("uses the shorthand form", func() {
+				payload, err := json.Marshal(BuildLogRetention{Builds: 10})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(payload).To(MatchJSON("10"))
+
+				payload, err = yaml.Marshal(BuildLogRetention{Builds: 10})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(payload)).To(Equal("10\n"))
+			})
+		})
+
+		Context("when marshaling a policy", func() {
+			It("round-trips through JSON", func() {
+				retention := BuildLogRetention{Days: 7, MinimumSucceededBuilds: 2}
+
+				payload, err := json.Marshal(retention)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(payload).To(MatchJSON(`{"days":7,"minimum_succeeded_builds":2}`))
+
+				var unmarshaled BuildLogRetention
+				err = json.Unmarshal(payload, &unmarshaled)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unmarshaled).To(Equal(retention))
+			})
+		})
+	})
 })
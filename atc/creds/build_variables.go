@@ -0,0 +1,328 @@
+package creds
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const redactedValue = "((redacted))"
+
+var (
+	localVarRegex         = regexp.MustCompile(`\(\(\.:([-/\.\w\pL]+)\)\)`)
+	localVarAnchoredRegex = regexp.MustCompile(`\A` + localVarRegex.String() + `\z`)
+)
+
+// BuildVariables holds the vars set by steps while a build is running (e.g.
+// by a load_var step). They are referenced with the ((.:name)) syntax, and are
+// only visible within the build that set them.
+type BuildVariables struct {
+	parent *BuildVariables
+
+	vars     map[string]interface{}
+	redacted map[string]bool
+	lock     sync.RWMutex
+}
+
+func NewBuildVariables() *BuildVariables {
+	return &BuildVariables{
+		vars:     map[string]interface{}{},
+		redacted: map[string]bool{},
+	}
+}
+
+// NewLocalScope returns BuildVariables which inherit the vars of v, but whose
+// own vars are not visible to v. This is used to give each combination of an
+// across step its own values without leaking them into the rest of the build.
+func (v *BuildVariables) NewLocalScope() *BuildVariables {
+	scope := NewBuildVariables()
+	scope.parent = v
+	return scope
+}
+
+// AddLocalVar sets a build-local var, replacing any previous value. If redact
+// is true, the value will be redacted by Redact.
+func (v *BuildVariables) AddLocalVar(name string, val interface{}, redact bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.vars[name] = val
+	v.redacted[name] = redact
+}
+
+// Get returns the value of a build-local var.
+func (v *BuildVariables) Get(name string) (interface{}, bool) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	val, found := v.vars[name]
+	if !found && v.parent != nil {
+		return v.parent.Get(name)
+	}
+
+	return val, found
+}
+
+// Redact replaces any values of vars that were added with redact set within
+// the given text.
+func (v *BuildVariables) Redact(text string) string {
+	return redact(text, v.secrets())
+}
+
+// RedactChunk is like Redact, but for a chunk of a stream such as a step's
+// output, where a value may be split across chunks. Any trailing text which
+// may be the start of a value is not redacted yet; it is returned separately
+// so that it can be prepended to the next chunk.
+func (v *BuildVariables) RedactChunk(text string) (string, string) {
+	secrets := v.secrets()
+
+	redacted := redact(text, secrets)
+
+	held := 0
+	for _, secret := range secrets {
+		// a complete value would have been redacted already
+		for n := len(secret) - 1; n > held; n-- {
+			if strings.HasSuffix(redacted, secret[:n]) {
+				held = n
+				break
+			}
+		}
+	}
+
+	return redacted[:len(redacted)-held], redacted[len(redacted)-held:]
+}
+
+func redact(text string, secrets []string) string {
+	// replace longer values first so that a value containing another is not
+	// left partially visible
+	sort.Slice(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+
+	for _, secret := range secrets {
+		text = strings.Replace(text, secret, redactedValue, -1)
+	}
+
+	return text
+}
+
+func (v *BuildVariables) secrets() []string {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	secrets := []string{}
+	for name, redact := range v.redacted {
+		if redact {
+			secrets = append(secrets, redactableStrings(v.vars[name])...)
+		}
+	}
+
+	if v.parent != nil {
+		secrets = append(secrets, v.parent.secrets()...)
+	}
+
+	return secrets
+}
+
+// WithParent returns Variables which resolve ((.:name)) references against
+// the build-local vars, and all other references against the parent.
+func (v *BuildVariables) WithParent(parent Variables) Variables {
+	return layeredVariables{
+		Variables: parent,
+		local:     v,
+	}
+}
+
+type layeredVariables struct {
+	Variables
+
+	local *BuildVariables
+}
+
+func (v layeredVariables) localVar(name string) (interface{}, bool) {
+	return v.local.Get(name)
+}
+
+func (v layeredVariables) varSources() *varSources {
+	sourced, ok := v.Variables.(varSourcedVariables)
+	if !ok {
+		return nil
+	}
+
+	return sourced.varSources()
+}
+
+type localVariables interface {
+	localVar(string) (interface{}, bool)
+}
+
+// interpolateLocalVars resolves ((.:name)) references in the given JSON
+// payload. These are handled separately as they are not valid var names
+// according to the template package.
+func interpolateLocalVars(variablesResolver Variables, payload []byte) ([]byte, error) {
+	if !localVarRegex.Match(payload) {
+		return payload, nil
+	}
+
+	local, ok := variablesResolver.(localVariables)
+	if !ok {
+		return nil, fmt.Errorf("build-local vars are not available: %s", localVarRegex.Find(payload))
+	}
+
+	var node interface{}
+	err := json.Unmarshal(payload, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err = interpolateVarsInNode(
+		node,
+		localVarRegex,
+		localVarAnchoredRegex,
+		"build-local var",
+		func(ref string) (interface{}, error) {
+			return lookupLocalVar(local, ref)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(node)
+}
+
+// interpolateVarsInNode replaces the references matched by regex within the
+// strings of the given JSON node with the values returned by lookup.
+func interpolateVarsInNode(
+	node interface{},
+	regex *regexp.Regexp,
+	anchoredRegex *regexp.Regexp,
+	kind string,
+	lookup func(string) (interface{}, error),
+) (interface{}, error) {
+	switch typedNode := node.(type) {
+	case map[string]interface{}:
+		for k, v := range typedNode {
+			evaluated, err := interpolateVarsInNode(v, regex, anchoredRegex, kind, lookup)
+			if err != nil {
+				return nil, err
+			}
+
+			typedNode[k] = evaluated
+		}
+
+	case []interface{}:
+		for i, v := range typedNode {
+			evaluated, err := interpolateVarsInNode(v, regex, anchoredRegex, kind, lookup)
+			if err != nil {
+				return nil, err
+			}
+
+			typedNode[i] = evaluated
+		}
+
+	case string:
+		for _, match := range regex.FindAllStringSubmatch(typedNode, -1) {
+			val, err := lookup(match[1])
+			if err != nil {
+				return nil, err
+			}
+
+			// preserve the value's type when it is the entire field
+			if anchoredRegex.MatchString(typedNode) {
+				return val, nil
+			}
+
+			switch val.(type) {
+			case string, float64, int, json.Number:
+				typedNode = strings.Replace(typedNode, match[0], fmt.Sprintf("%v", val), -1)
+			default:
+				return nil, fmt.Errorf("%s '%s' cannot be interpolated within a string as it is of type '%T'", kind, match[1], val)
+			}
+		}
+
+		return typedNode, nil
+	}
+
+	return node, nil
+}
+
+func lookupLocalVar(local localVariables, ref string) (interface{}, error) {
+	path := strings.Split(ref, ".")
+
+	val, found := local.localVar(path[0])
+	if !found {
+		return nil, fmt.Errorf("undefined build-local var: %s", path[0])
+	}
+
+	for _, field := range path[1:] {
+		fields, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("build-local var '%s' has no field '%s'", path[0], field)
+		}
+
+		val, found = fields[field]
+		if !found {
+			return nil, fmt.Errorf("build-local var '%s' has no field '%s'", path[0], field)
+		}
+	}
+
+	return val, nil
+}
+
+// minRedactableNumberLength is the length below which a sensitive number is
+// not redacted, as short numbers such as 0 or 1 appear throughout output
+// regardless of the var.
+const minRedactableNumberLength = 4
+
+// redactableStrings returns the strings which would appear in output if the
+// value were printed: strings as they are, numbers stringified, and the values
+// within maps and slices. Booleans and short numbers are left out, as
+// redacting them would blank every other occurrence in the output too.
+func redactableStrings(val interface{}) []string {
+	switch typedVal := val.(type) {
+	case nil, bool:
+		return nil
+
+	case string:
+		if typedVal == "" {
+			return nil
+		}
+
+		return []string{typedVal}
+
+	case map[string]interface{}:
+		strs := []string{}
+		for _, v := range typedVal {
+			strs = append(strs, redactableStrings(v)...)
+		}
+
+		return strs
+
+	case map[interface{}]interface{}:
+		strs := []string{}
+		for _, v := range typedVal {
+			strs = append(strs, redactableStrings(v)...)
+		}
+
+		return strs
+
+	case []interface{}:
+		strs := []string{}
+		for _, v := range typedVal {
+			strs = append(strs, redactableStrings(v)...)
+		}
+
+		return strs
+	}
+
+	str := fmt.Sprint(val)
+	if len(str) < minRedactableNumberLength {
+		return nil
+	}
+
+	return []string{str}
+}
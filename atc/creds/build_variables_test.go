@@ -0,0 +1,190 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildVariables", func() {
+	var (
+		buildVars *creds.BuildVariables
+		variables creds.Variables
+	)
+
+	BeforeEach(func() {
+		buildVars = creds.NewBuildVariables()
+		buildVars.AddLocalVar("version", "1.2.3", false)
+		buildVars.AddLocalVar("metadata", map[string]interface{}{
+			"sha":   "abcdef",
+			"count": float64(7),
+		}, true)
+
+		variables = buildVars.WithParent(template.StaticVariables{
+			"some-param": "lol",
+		})
+	})
+
+	Describe("evaluating params", func() {
+		It("resolves build-local vars alongside the parent's vars", func() {
+			params, err := creds.NewParams(variables, atc.Params{
+				"version": "((.:version))",
+				"tag":     "v((.:version))-((.:metadata.sha))",
+				"count":   "((.:metadata.count))",
+				"param":   "((some-param))",
+			}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(params).To(Equal(atc.Params{
+				"version": "1.2.3",
+				"tag":     "v1.2.3-abcdef",
+				"count":   7,
+				"param":   "lol",
+			}))
+		})
+
+		It("preserves the type of a var used as an entire field", func() {
+			params, err := creds.NewParams(variables, atc.Params{
+				"metadata": "((.:metadata))",
+			}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(params).To(Equal(atc.Params{
+				"metadata": map[string]interface{}{
+					"sha":   "abcdef",
+					"count": 7,
+				},
+			}))
+		})
+
+		It("resolves build-local vars in task params", func() {
+			params, err := creds.NewTaskParams(variables, map[string]string{
+				"VERSION": "((.:version))",
+			}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(params).To(Equal(map[string]string{"VERSION": "1.2.3"}))
+		})
+
+		It("errors when the var is not defined", func() {
+			_, err := creds.NewParams(variables, atc.Params{
+				"version": "((.:bogus))",
+			}).Evaluate()
+			Expect(err).To(MatchError("undefined build-local var: bogus"))
+		})
+
+		It("errors when the field is not defined", func() {
+			_, err := creds.NewParams(variables, atc.Params{
+				"version": "((.:metadata.bogus))",
+			}).Evaluate()
+			Expect(err).To(MatchError("build-local var 'metadata' has no field 'bogus'"))
+		})
+
+		It("errors when interpolating a map within a string", func() {
+			_, err := creds.NewParams(variables, atc.Params{
+				"version": "v((.:metadata))",
+			}).Evaluate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("errors when build-local vars are not available", func() {
+			_, err := creds.NewParams(template.StaticVariables{}, atc.Params{
+				"version": "((.:version))",
+			}).Evaluate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Redact", func() {
+		It("redacts the values of vars added with redact set", func() {
+			Expect(buildVars.Redact("version 1.2.3 at abcdef")).To(Equal("version 1.2.3 at ((redacted))"))
+		})
+
+		Context("when a var is overwritten without redact set", func() {
+			BeforeEach(func() {
+				buildVars.AddLocalVar("metadata", "abcdef", false)
+			})
+
+			It("no longer redacts it", func() {
+				Expect(buildVars.Redact("at abcdef")).To(Equal("at abcdef"))
+			})
+		})
+
+		Context("when a sensitive var holds values other than strings", func() {
+			BeforeEach(func() {
+				buildVars.AddLocalVar("config", map[string]interface{}{
+					"port":    float64(31337),
+					"enabled": true,
+					"nested": map[interface{}]interface{}{
+						"token": "some-token",
+						"ids":   []interface{}{42, "some-id"},
+					},
+				}, true)
+			})
+
+			It("redacts their stringified values", func() {
+				Expect(buildVars.Redact("port=31337 token=some-token ids=some-id")).To(Equal(
+					"port=((redacted)) token=((redacted)) ids=((redacted))",
+				))
+			})
+
+			It("does not redact booleans or short numbers", func() {
+				Expect(buildVars.Redact("enabled=true ids=42 exit 1")).To(Equal("enabled=true ids=42 exit 1"))
+			})
+		})
+	})
+
+	Describe("RedactChunk", func() {
+		It("redacts the values of vars added with redact set", func() {
+			redacted, held := buildVars.RedactChunk("at abcdef\n")
+			Expect(redacted).To(Equal("at ((redacted))\n"))
+			Expect(held).To(BeEmpty())
+		})
+
+		It("holds back the start of a value which may continue in the next chunk", func() {
+			redacted, held := buildVars.RedactChunk("version 1.2.3 at abc")
+			Expect(redacted).To(Equal("version 1.2.3 at "))
+			Expect(held).To(Equal("abc"))
+
+			redacted, held = buildVars.RedactChunk(held + "def\n")
+			Expect(redacted).To(Equal("((redacted))\n"))
+			Expect(held).To(BeEmpty())
+		})
+	})
+
+	Describe("NewLocalScope", func() {
+		var scope *creds.BuildVariables
+
+		BeforeEach(func() {
+			scope = buildVars.NewLocalScope()
+			scope.AddLocalVar("platform", "linux", false)
+			scope.AddLocalVar("version", "4.5.6", false)
+		})
+
+		It("inherits the parent's vars", func() {
+			val, found := scope.Get("metadata")
+			Expect(found).To(BeTrue())
+			Expect(val).To(HaveKeyWithValue("sha", "abcdef"))
+		})
+
+		It("shadows the parent's vars", func() {
+			val, _ := scope.Get("version")
+			Expect(val).To(Equal("4.5.6"))
+
+			val, _ = buildVars.Get("version")
+			Expect(val).To(Equal("1.2.3"))
+		})
+
+		It("does not leak vars into the parent", func() {
+			_, found := buildVars.Get("platform")
+			Expect(found).To(BeFalse())
+		})
+
+		It("redacts the parent's sensitive vars", func() {
+			Expect(scope.Redact("at abcdef")).To(Equal("at ((redacted))"))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package credhub
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -27,3 +29,20 @@ func (factory *credhubManagerFactory) AddConfig(group *flags.Group) creds.Manage
 
 	return manager
 }
+
+func (factory *credhubManagerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &CredHubManager{}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	// the client cert identifies the ATC itself, so it must not be lent to
+	// pipelines
+	if manager.TLS.ClientCert != "" || manager.TLS.ClientKey != "" {
+		return nil, errors.New("client certs cannot be configured for a var source")
+	}
+
+	return manager, nil
+}
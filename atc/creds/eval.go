@@ -13,6 +13,16 @@ func evaluate(variablesResolver Variables, in, out interface{}) error {
 		return err
 	}
 
+	byteParams, err = interpolateLocalVars(variablesResolver, byteParams)
+	if err != nil {
+		return err
+	}
+
+	byteParams, err = interpolateVarSources(variablesResolver, byteParams)
+	if err != nil {
+		return err
+	}
+
 	tpl := template.NewTemplate(byteParams)
 
 	bytes, err := tpl.Evaluate(variablesResolver, nil, template.EvaluateOpts{
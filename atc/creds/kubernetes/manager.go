@@ -15,6 +15,9 @@ import (
 type KubernetesManager struct {
 	InClusterConfig bool   `long:"in-cluster" description:"Enables the in-cluster client."`
 	ConfigPath      string `long:"config-path" description:"Path to Kubernetes config when running ATC outside Kubernetes."`
+	Host            string `long:"host" description:"Address of the Kubernetes API server, authenticated with --kubernetes-token."`
+	Token           string `long:"token" description:"Service account token used to authenticate with the Kubernetes API server at --kubernetes-host."`
+	CACert          string `long:"ca-cert" description:"PEM-encoded CA certificate of the Kubernetes API server at --kubernetes-host."`
 	NamespacePrefix string `long:"namespace-prefix" default:"concourse-" description:"Prefix to use for Kubernetes namespaces under which secrets will be looked up."`
 }
 
@@ -23,6 +26,7 @@ func (manager *KubernetesManager) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&map[string]interface{}{
 		"in_cluster_config": manager.InClusterConfig,
 		"config_path":       manager.ConfigPath,
+		"host":              manager.Host,
 		"namespace_config":  manager.NamespacePrefix,
 	})
 }
@@ -32,7 +36,7 @@ func (manager KubernetesManager) Init(log lager.Logger) error {
 }
 
 func (manager KubernetesManager) IsConfigured() bool {
-	return manager.InClusterConfig || manager.ConfigPath != ""
+	return manager.InClusterConfig || manager.ConfigPath != "" || manager.Host != ""
 }
 
 func (manager KubernetesManager) buildConfig() (*rest.Config, error) {
@@ -40,6 +44,16 @@ func (manager KubernetesManager) buildConfig() (*rest.Config, error) {
 		return rest.InClusterConfig()
 	}
 
+	if manager.Host != "" {
+		return &rest.Config{
+			Host:        manager.Host,
+			BearerToken: manager.Token,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData: []byte(manager.CACert),
+			},
+		}, nil
+	}
+
 	return clientcmd.BuildConfigFromFlags("", manager.ConfigPath)
 }
 
@@ -48,8 +62,19 @@ func (manager KubernetesManager) Health() (*creds.HealthResponse, error) {
 }
 
 func (manager KubernetesManager) Validate() error {
-	if manager.InClusterConfig && manager.ConfigPath != "" {
-		return errors.New("Either in-cluster or config-path can be used, not both.")
+	configured := 0
+	for _, set := range []bool{manager.InClusterConfig, manager.ConfigPath != "", manager.Host != ""} {
+		if set {
+			configured++
+		}
+	}
+
+	if configured > 1 {
+		return errors.New("Only one of in-cluster, config-path or host can be used.")
+	}
+
+	if manager.Host != "" && manager.Token == "" {
+		return errors.New("A token must be configured along with the host.")
 	}
 	_, err := manager.buildConfig()
 	return err
@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -27,3 +29,29 @@ func (factory *kubernetesManagerFactory) AddConfig(group *flags.Group) creds.Man
 
 	return manager
 }
+
+// NewInstance configures a client for a var source. Only a host and token can
+// be used, as every other way of configuring the client would authenticate
+// with credentials belonging to the ATC rather than the pipeline.
+func (factory *kubernetesManagerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &KubernetesManager{}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case manager.InClusterConfig:
+		// the in-cluster client authenticates as the ATC's own service account
+		return nil, errors.New("the in-cluster client cannot be configured for a var source")
+	case manager.ConfigPath != "":
+		// the kubeconfig would be read from the ATC's host
+		return nil, errors.New("config-path cannot be configured for a var source")
+	case manager.Host == "":
+		// without a host, the client falls back to the in-cluster config
+		return nil, errors.New("host must be configured for a var source")
+	}
+
+	return manager, nil
+}
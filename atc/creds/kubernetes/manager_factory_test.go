@@ -0,0 +1,65 @@
+package kubernetes_test
+
+import (
+	"github.com/concourse/concourse/atc/creds/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KubernetesManagerFactory", func() {
+	Describe("NewInstance()", func() {
+		It("rejects the in-cluster client", func() {
+			_, err := kubernetes.NewKubernetesManagerFactory().NewInstance(map[string]interface{}{
+				"in-cluster": true,
+			})
+			Expect(err).To(MatchError("the in-cluster client cannot be configured for a var source"))
+		})
+
+		It("rejects a kubeconfig on the ATC's host", func() {
+			_, err := kubernetes.NewKubernetesManagerFactory().NewInstance(map[string]interface{}{
+				"config-path": "/root/.kube/config",
+			})
+			Expect(err).To(MatchError("config-path cannot be configured for a var source"))
+		})
+
+		It("rejects a config which would fall back to the in-cluster client", func() {
+			_, err := kubernetes.NewKubernetesManagerFactory().NewInstance(map[string]interface{}{
+				"namespace-prefix": "some-prefix-",
+			})
+			Expect(err).To(MatchError("host must be configured for a var source"))
+		})
+
+		It("configures a client for the host, authenticated with the token", func() {
+			manager, err := kubernetes.NewKubernetesManagerFactory().NewInstance(map[string]interface{}{
+				"host":             "https://kubernetes.example.com",
+				"token":            "some-token",
+				"ca-cert":          "some-ca-cert",
+				"namespace-prefix": "some-prefix-",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager).To(Equal(&kubernetes.KubernetesManager{
+				Host:            "https://kubernetes.example.com",
+				Token:           "some-token",
+				CACert:          "some-ca-cert",
+				NamespacePrefix: "some-prefix-",
+			}))
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("requires a token along with the host", func() {
+			manager, err := kubernetes.NewKubernetesManagerFactory().NewInstance(map[string]interface{}{
+				"host": "https://kubernetes.example.com",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.Validate()).To(MatchError("A token must be configured along with the host."))
+		})
+
+		It("rejects unknown config", func() {
+			_, err := kubernetes.NewKubernetesManagerFactory().NewInstance(map[string]interface{}{
+				"bogus": "config",
+			})
+			Expect(err).To(MatchError("unknown config: [bogus]"))
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package creds
+
+import "fmt"
+
+type List struct {
+	variablesResolver Variables
+	rawList           interface{}
+}
+
+func NewList(variables Variables, list interface{}) List {
+	return List{
+		variablesResolver: variables,
+		rawList:           list,
+	}
+}
+
+func (l List) Evaluate() ([]interface{}, error) {
+	var untypedInput interface{}
+
+	err := evaluate(l.variablesResolver, l.rawList, &untypedInput)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := untypedInput.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list, got '%T'", untypedInput)
+	}
+
+	return list, nil
+}
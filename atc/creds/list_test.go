@@ -0,0 +1,37 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("List", func() {
+	var variables template.StaticVariables
+
+	BeforeEach(func() {
+		variables = template.StaticVariables{
+			"some-list":   []interface{}{"a", "b"},
+			"some-string": "lol",
+		}
+	})
+
+	It("resolves a var to a list", func() {
+		list, err := creds.NewList(variables, "((some-list))").Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(Equal([]interface{}{"a", "b"}))
+	})
+
+	It("resolves vars within a static list", func() {
+		list, err := creds.NewList(variables, []interface{}{"((some-string))", "c"}).Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(Equal([]interface{}{"lol", "c"}))
+	})
+
+	It("errors when the var does not resolve to a list", func() {
+		_, err := creds.NewList(variables, "((some-string))").Evaluate()
+		Expect(err).To(MatchError("expected a list, got 'string'"))
+	})
+})
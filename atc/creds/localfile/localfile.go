@@ -0,0 +1,61 @@
+package localfile
+
+import (
+	"io/ioutil"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
+)
+
+// LocalFile reads vars from a YAML file, keyed by name. The file is read for
+// every lookup so that changes to it are picked up without a restart.
+type LocalFile struct {
+	Path string
+}
+
+func (l *LocalFile) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	vars, err := l.read()
+	if err != nil {
+		return nil, false, err
+	}
+
+	val, found := vars[varDef.Name]
+	return val, found, nil
+}
+
+func (l *LocalFile) List() ([]template.VariableDefinition, error) {
+	vars, err := l.read()
+	if err != nil {
+		return nil, err
+	}
+
+	defs := []template.VariableDefinition{}
+	for name := range vars {
+		defs = append(defs, template.VariableDefinition{Name: name})
+	}
+
+	return defs, nil
+}
+
+func (l *LocalFile) read() (map[string]interface{}, error) {
+	payload, err := ioutil.ReadFile(l.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var vars map[string]interface{}
+	err = yaml.Unmarshal(payload, &vars)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, val := range vars {
+		vars[name], err = atc.SanitizeYAML(val)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,19 @@
+package localfile
+
+import "github.com/concourse/concourse/atc/creds"
+
+type localFileFactory struct {
+	path string
+}
+
+func NewLocalFileFactory(path string) *localFileFactory {
+	return &localFileFactory{
+		path: path,
+	}
+}
+
+func (factory *localFileFactory) NewVariables(string, string) creds.Variables {
+	return &LocalFile{
+		Path: factory.path,
+	}
+}
@@ -0,0 +1,140 @@
+package localfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/localfile"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalFile", func() {
+	var (
+		dir     string
+		factory creds.ManagerFactory
+		manager *localfile.LocalFileManager
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "local-file")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(dir, "vars.yml"), []byte(`
+some-param: some-value
+some-secret:
+  username: some-user
+`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+
+		factory = localfile.NewLocalFileManagerFactory()
+		manager = factory.AddConfig(flags.NewParser(&struct{}{}, flags.Default).Group).(*localfile.LocalFileManager)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("Get", func() {
+		var variables creds.Variables
+
+		BeforeEach(func() {
+			manager.Path = filepath.Join(dir, "vars.yml")
+			Expect(manager.Validate()).To(Succeed())
+
+			variablesFactory, err := manager.NewVariablesFactory(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			variables = variablesFactory.NewVariables("some-team", "some-pipeline")
+		})
+
+		It("returns the vars in the file", func() {
+			val, found, err := variables.Get(template.VariableDefinition{Name: "some-param"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("some-value"))
+
+			val, found, err = variables.Get(template.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal(map[string]interface{}{"username": "some-user"}))
+		})
+
+		It("does not find vars missing from the file", func() {
+			_, found, err := variables.Get(template.VariableDefinition{Name: "bogus"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("NewInstance", func() {
+		var (
+			config   map[string]interface{}
+			instance creds.Manager
+			err      error
+		)
+
+		BeforeEach(func() {
+			config = map[string]interface{}{"path": "vars.yml"}
+		})
+
+		JustBeforeEach(func() {
+			instance, err = factory.NewInstance(config)
+		})
+
+		Context("when the var sources dir is not configured", func() {
+			It("returns an error", func() {
+				Expect(err).To(MatchError("local-file var sources are not enabled"))
+			})
+		})
+
+		Context("when the var sources dir is configured", func() {
+			BeforeEach(func() {
+				manager.VarSourcesDir = dir
+			})
+
+			It("reads the file within the dir", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(instance).To(Equal(&localfile.LocalFileManager{
+					Path: filepath.Join(dir, "vars.yml"),
+				}))
+			})
+
+			Context("when the path escapes the dir", func() {
+				BeforeEach(func() {
+					config = map[string]interface{}{"path": "../etc/passwd"}
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError("path must be relative to the var sources dir: ../etc/passwd"))
+				})
+			})
+
+			Context("when the path is absolute", func() {
+				BeforeEach(func() {
+					config = map[string]interface{}{"path": filepath.Join(dir, "vars.yml")}
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			Context("when the config tries to set the dir", func() {
+				BeforeEach(func() {
+					config = map[string]interface{}{"path": "vars.yml", "var-sources-dir": "/"}
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError("unknown config: [var-sources-dir]"))
+				})
+			})
+		})
+	})
+})
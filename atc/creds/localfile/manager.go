@@ -0,0 +1,66 @@
+package localfile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type LocalFileManager struct {
+	Path string `long:"path" description:"Path to a YAML file of vars, keyed by name, to use for every pipeline."`
+
+	VarSourcesDir string `long:"var-sources-dir" description:"Directory of YAML files of vars which pipelines may configure as local-file var sources. If not set, pipelines cannot use local-file var sources."`
+}
+
+func (manager *LocalFileManager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"path":   manager.Path,
+		"health": health,
+	})
+}
+
+func (manager *LocalFileManager) Init(log lager.Logger) error {
+	return nil
+}
+
+func (manager *LocalFileManager) IsConfigured() bool {
+	return manager.Path != ""
+}
+
+func (manager *LocalFileManager) Validate() error {
+	if manager.Path == "" {
+		return errors.New("must configure path")
+	}
+
+	return nil
+}
+
+func (manager *LocalFileManager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "stat",
+	}
+
+	_, err := os.Stat(manager.Path)
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *LocalFileManager) NewVariablesFactory(log lager.Logger) (creds.VariablesFactory, error) {
+	return NewLocalFileFactory(manager.Path), nil
+}
@@ -0,0 +1,69 @@
+package localfile
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type localFileManagerFactory struct {
+	manager *LocalFileManager
+}
+
+func init() {
+	creds.Register("local-file", NewLocalFileManagerFactory())
+}
+
+func NewLocalFileManagerFactory() creds.ManagerFactory {
+	return &localFileManagerFactory{}
+}
+
+func (factory *localFileManagerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &LocalFileManager{}
+
+	subGroup, err := group.AddGroup("Local File Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "local-file"
+
+	factory.manager = manager
+
+	return manager
+}
+
+// NewInstance configures a manager reading one of the files within the ATC's
+// --local-file-var-sources-dir, so that pipelines cannot read arbitrary files
+// from the ATC's disk.
+func (factory *localFileManagerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	if factory.manager == nil || factory.manager.VarSourcesDir == "" {
+		return nil, errors.New("local-file var sources are not enabled")
+	}
+
+	var sourceConfig struct {
+		Path string `long:"path"`
+	}
+
+	err := creds.DecodeManagerConfig(config, &sourceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceConfig.Path == "" {
+		return nil, errors.New("must configure path")
+	}
+
+	dir := filepath.Clean(factory.manager.VarSourcesDir)
+	path := filepath.Join(dir, sourceConfig.Path)
+
+	if filepath.IsAbs(sourceConfig.Path) || !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		return nil, fmt.Errorf("path must be relative to the var sources dir: %s", sourceConfig.Path)
+	}
+
+	return &LocalFileManager{Path: path}, nil
+}
@@ -5,6 +5,8 @@ import (
 	flags "github.com/jessevdk/go-flags"
 )
 
+//go:generate counterfeiter . Manager
+
 type Manager interface {
 	IsConfigured() bool
 	Validate() error
@@ -14,8 +16,15 @@ type Manager interface {
 	NewVariablesFactory(lager.Logger) (VariablesFactory, error)
 }
 
+//go:generate counterfeiter . ManagerFactory
+
 type ManagerFactory interface {
 	AddConfig(*flags.Group) Manager
+
+	// NewInstance returns a Manager configured by a pipeline's var source
+	// rather than by the ATC's flags. See DecodeManagerConfig for the format
+	// of the config.
+	NewInstance(map[string]interface{}) (Manager, error)
 }
 
 type Managers map[string]Manager
@@ -0,0 +1,122 @@
+package creds
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/mitchellh/mapstructure"
+)
+
+// DecodeManagerConfig configures a Manager, whose fields are declared with
+// go-flags tags, from the config of a pipeline's var source.
+//
+// Each key of the config is the long name of one of the manager's flags,
+// without its namespace; e.g. "url" configures --vault-url. Flags which are
+// not present take their default value. Unlike flags, values are never read
+// from the ATC's environment.
+func DecodeManagerConfig(config map[string]interface{}, manager interface{}) error {
+	fields := map[string]reflect.Value{}
+
+	err := collectFlagFields(reflect.ValueOf(manager).Elem(), fields)
+	if err != nil {
+		return err
+	}
+
+	unknown := []string{}
+	for key, value := range config {
+		field, found := fields[key]
+		if !found {
+			unknown = append(unknown, key)
+			continue
+		}
+
+		err := decodeFlagValue(value, field)
+		if err != nil {
+			return fmt.Errorf("invalid value for '%s': %s", key, err)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown config: %v", unknown)
+	}
+
+	return nil
+}
+
+func collectFlagFields(val reflect.Value, fields map[string]reflect.Value) error {
+	typ := val.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		long := field.Tag.Get("long")
+		if long == "" {
+			if field.Type.Kind() == reflect.Struct {
+				err := collectFlagFields(val.Field(i), fields)
+				if err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		fields[long] = val.Field(i)
+
+		def, hasDefault := field.Tag.Lookup("default")
+		if hasDefault {
+			err := decodeFlagValue(def, val.Field(i))
+			if err != nil {
+				return fmt.Errorf("invalid default for '%s': %s", long, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func decodeFlagValue(value interface{}, field reflect.Value) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           field.Addr().Interface(),
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			flagUnmarshalerDecodeHook,
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(value)
+}
+
+// flagUnmarshalerDecodeHook decodes strings into values which know how to
+// parse themselves as flags, e.g. template.VarKV.
+func flagUnmarshalerDecodeHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	str, ok := data.(string)
+	if !ok || from.Kind() != reflect.String {
+		return data, nil
+	}
+
+	ptr := reflect.New(to)
+
+	unmarshaler, ok := ptr.Interface().(flags.Unmarshaler)
+	if !ok {
+		return data, nil
+	}
+
+	err := unmarshaler.UnmarshalFlag(str)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptr.Elem().Interface(), nil
+}
@@ -0,0 +1,98 @@
+package creds_test
+
+import (
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type someManagerConfig struct {
+	URL        string        `long:"url"`
+	PathPrefix string        `long:"path-prefix" default:"/concourse"`
+	Timeout    time.Duration `long:"timeout" default:"1m"`
+	Insecure   bool          `long:"insecure-skip-verify"`
+
+	Auth struct {
+		Params []template.VarKV `long:"auth-param"`
+	}
+
+	NotAFlag string
+}
+
+var _ = Describe("DecodeManagerConfig", func() {
+	var (
+		config  map[string]interface{}
+		manager someManagerConfig
+		err     error
+	)
+
+	BeforeEach(func() {
+		config = map[string]interface{}{}
+		manager = someManagerConfig{}
+	})
+
+	JustBeforeEach(func() {
+		err = creds.DecodeManagerConfig(config, &manager)
+	})
+
+	It("applies the flags' defaults", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.PathPrefix).To(Equal("/concourse"))
+		Expect(manager.Timeout).To(Equal(time.Minute))
+	})
+
+	Context("when the config sets flags", func() {
+		BeforeEach(func() {
+			config = map[string]interface{}{
+				"url":                  "https://example.com",
+				"path-prefix":          "/custom",
+				"timeout":              "5s",
+				"insecure-skip-verify": true,
+				"auth-param":           []interface{}{"role_id=some-role", "secret_id=some-secret"},
+			}
+		})
+
+		It("decodes them by their long name", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.URL).To(Equal("https://example.com"))
+			Expect(manager.PathPrefix).To(Equal("/custom"))
+			Expect(manager.Timeout).To(Equal(5 * time.Second))
+			Expect(manager.Insecure).To(BeTrue())
+			Expect(manager.Auth.Params).To(Equal([]template.VarKV{
+				{Name: "role_id", Value: "some-role"},
+				{Name: "secret_id", Value: "some-secret"},
+			}))
+		})
+	})
+
+	Context("when the config has unknown keys", func() {
+		BeforeEach(func() {
+			config = map[string]interface{}{
+				"url":      "https://example.com",
+				"NotAFlag": "nope",
+				"bogus":    "nope",
+			}
+		})
+
+		It("returns an error listing them", func() {
+			Expect(err).To(MatchError("unknown config: [NotAFlag bogus]"))
+		})
+	})
+
+	Context("when a value cannot be decoded", func() {
+		BeforeEach(func() {
+			config = map[string]interface{}{
+				"timeout": "soon",
+			}
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("invalid value for 'timeout': "))
+		})
+	})
+})
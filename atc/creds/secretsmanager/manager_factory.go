@@ -1,6 +1,8 @@
 package secretsmanager
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -27,3 +29,20 @@ func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
 	subGroup.Namespace = "aws-secretsmanager"
 	return manager
 }
+
+func (factory *managerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &Manager{}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	// without keys the AWS session would fall back to the ATC's own
+	// credentials
+	if manager.AwsAccessKeyID == "" || manager.AwsSecretAccessKey == "" {
+		return nil, errors.New("must provide aws access key id and secret access key")
+	}
+
+	return manager, nil
+}
@@ -1,6 +1,8 @@
 package ssm
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -25,3 +27,20 @@ func (factory *ssmManagerFactory) AddConfig(group *flags.Group) creds.Manager {
 	subGroup.Namespace = "aws-ssm"
 	return manager
 }
+
+func (factory *ssmManagerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &SsmManager{}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	// without keys the AWS session would fall back to the ATC's own
+	// credentials
+	if manager.AwsAccessKeyID == "" || manager.AwsSecretAccessKey == "" {
+		return nil, errors.New("must provide aws access key id and secret access key")
+	}
+
+	return manager, nil
+}
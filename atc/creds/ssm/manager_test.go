@@ -109,4 +109,27 @@ var _ = Describe("SsmManager", func() {
 			Expect(manager.Validate()).ToNot(BeNil())
 		})
 	})
+
+	Describe("NewInstance()", func() {
+		It("configures the manager from a var source's config", func() {
+			instance, err := ssm.NewSsmManagerFactory().NewInstance(map[string]interface{}{
+				"region":     "test-region",
+				"access-key": "access",
+				"secret-key": "secret",
+			})
+			Expect(err).To(BeNil())
+
+			manager := instance.(*ssm.SsmManager)
+			Expect(manager.AwsRegion).To(Equal("test-region"))
+			Expect(manager.PipelineSecretTemplate).To(Equal(ssm.DefaultPipelineSecretTemplate))
+			Expect(manager.Validate()).To(BeNil())
+		})
+
+		It("fails without aws credentials, rather than using the ATC's own", func() {
+			_, err := ssm.NewSsmManagerFactory().NewInstance(map[string]interface{}{
+				"region": "test-region",
+			})
+			Expect(err).ToNot(BeNil())
+		})
+	})
 })
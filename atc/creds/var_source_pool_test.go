@@ -0,0 +1,190 @@
+package creds
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// credsfakes can't be used here, as it imports this package
+
+type poolManagerFactory struct {
+	ManagerFactory
+
+	instances int
+	factory   VariablesFactory
+	init      chan struct{}
+}
+
+func (factory *poolManagerFactory) NewInstance(map[string]interface{}) (Manager, error) {
+	factory.instances++
+	return poolManager{factory: factory.factory, init: factory.init}, nil
+}
+
+type poolManager struct {
+	Manager
+
+	factory VariablesFactory
+	init    chan struct{}
+}
+
+func (manager poolManager) Validate() error { return nil }
+
+func (manager poolManager) Init(lager.Logger) error {
+	if manager.init != nil {
+		<-manager.init
+	}
+
+	return nil
+}
+
+func (manager poolManager) NewVariablesFactory(lager.Logger) (VariablesFactory, error) {
+	return manager.factory, nil
+}
+
+type closingVariablesFactory struct {
+	VariablesFactory
+
+	closed bool
+}
+
+func (factory *closingVariablesFactory) Close() error {
+	factory.closed = true
+	return errors.New("already closed")
+}
+
+var _ = Describe("varSourcePool", func() {
+	var (
+		fakeClock      *fakeclock.FakeClock
+		managerFactory *poolManagerFactory
+		sourceFactory  *closingVariablesFactory
+
+		pool   *varSourcePool
+		source atc.VarSourceConfig
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		sourceFactory = &closingVariablesFactory{}
+		managerFactory = &poolManagerFactory{factory: sourceFactory}
+
+		Register("some-pooled-type", managerFactory)
+
+		pool = newVarSourcePool(fakeClock, time.Hour)
+
+		source = atc.VarSourceConfig{
+			Name:   "some-source",
+			Type:   "some-pooled-type",
+			Config: map[string]interface{}{"some": "config"},
+		}
+	})
+
+	It("reuses the manager of a source with the same config", func() {
+		_, err := pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+		Expect(err).ToNot(HaveOccurred())
+
+		source.Name = "some-other-source"
+
+		factory, err := pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(factory).To(Equal(sourceFactory))
+
+		Expect(managerFactory.instances).To(Equal(1))
+	})
+
+	It("keeps a manager which is still being used", func() {
+		for i := 0; i < 3; i++ {
+			_, err := pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.Increment(59 * time.Minute)
+		}
+
+		Expect(managerFactory.instances).To(Equal(1))
+		Expect(sourceFactory.closed).To(BeFalse())
+	})
+
+	It("evicts and closes a manager once it has been idle", func() {
+		_, err := pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeClock.Increment(time.Hour)
+
+		source.Config = map[string]interface{}{"some": "other-config"}
+
+		_, err = pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(sourceFactory.closed).To(BeTrue())
+		Expect(pool.entries).To(HaveLen(1))
+	})
+
+	Context("when a manager is slow to initialize", func() {
+		var (
+			init       chan struct{}
+			slowSource atc.VarSourceConfig
+			slowResult chan error
+			slowUsers  *sync.WaitGroup
+		)
+
+		BeforeEach(func() {
+			init = make(chan struct{})
+
+			Register("some-slow-type", &poolManagerFactory{
+				factory: &closingVariablesFactory{},
+				init:    init,
+			})
+
+			slowSource = atc.VarSourceConfig{
+				Name:   "some-slow-source",
+				Type:   "some-slow-type",
+				Config: map[string]interface{}{"some": "config"},
+			}
+
+			slowResult = make(chan error, 2)
+			slowUsers = new(sync.WaitGroup)
+			for i := 0; i < 2; i++ {
+				slowUsers.Add(1)
+				go func(pool *varSourcePool, source atc.VarSourceConfig) {
+					defer GinkgoRecover()
+					defer slowUsers.Done()
+
+					_, err := pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+					slowResult <- err
+				}(pool, slowSource)
+			}
+		})
+
+		AfterEach(func() {
+			if init != nil {
+				close(init)
+			}
+
+			slowUsers.Wait()
+		})
+
+		It("does not hold up the other sources", func() {
+			_, err := pool.variablesFactory(lagertest.NewTestLogger("test"), source)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("waits for the manager to be initialized before using it", func() {
+			Consistently(slowResult).ShouldNot(Receive())
+
+			close(init)
+			init = nil
+
+			Eventually(slowResult).Should(Receive(BeNil()))
+			Eventually(slowResult).Should(Receive(BeNil()))
+		})
+	})
+})
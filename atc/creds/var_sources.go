@@ -0,0 +1,316 @@
+package creds
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+)
+
+var (
+	varSourceVarRegex         = regexp.MustCompile(`\(\(([-\w\pL]+:[-/\.\w\pL]+)\)\)`)
+	varSourceVarAnchoredRegex = regexp.MustCompile(`\A` + varSourceVarRegex.String() + `\z`)
+)
+
+// NewVarSourceManager constructs the Manager for one of a pipeline's var
+// sources and validates its config. The Manager has not been initialized.
+func NewVarSourceManager(source atc.VarSourceConfig) (Manager, error) {
+	factory, found := managerFactories[source.Type]
+	if !found {
+		return nil, fmt.Errorf("unknown credential manager type: %s", source.Type)
+	}
+
+	manager, err := factory.NewInstance(source.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+// NewPipelineVariables returns the Variables for a pipeline. References to
+// one of its var sources, e.g. ((source:path.field)), are resolved by that
+// source's credential manager, and all other references by the factory.
+//
+// A source's manager is only initialized once one of its vars is used, and
+// is shared by every pipeline which configures an identical source.
+func NewPipelineVariables(
+	logger lager.Logger,
+	factory VariablesFactory,
+	teamName string,
+	pipelineName string,
+	sources atc.VarSourceConfigs,
+) Variables {
+	variables := factory.NewVariables(teamName, pipelineName)
+	if len(sources) == 0 {
+		return variables
+	}
+
+	return pipelineVariables{
+		Variables: variables,
+		sources: &varSources{
+			logger:       logger,
+			pool:         defaultVarSourcePool,
+			teamName:     teamName,
+			pipelineName: pipelineName,
+			configs:      sources,
+		},
+	}
+}
+
+type pipelineVariables struct {
+	Variables
+
+	sources *varSources
+}
+
+func (v pipelineVariables) varSources() *varSources {
+	return v.sources
+}
+
+type varSourcedVariables interface {
+	varSources() *varSources
+}
+
+type varSources struct {
+	logger lager.Logger
+	pool   *varSourcePool
+
+	teamName     string
+	pipelineName string
+	configs      atc.VarSourceConfigs
+}
+
+func (sources *varSources) lookup(ref string) (interface{}, error) {
+	segments := strings.SplitN(ref, ":", 2)
+	name, path := segments[0], strings.Split(segments[1], ".")
+
+	config, found := sources.configs.Lookup(name)
+	if !found {
+		return nil, fmt.Errorf("undefined var source: %s", name)
+	}
+
+	factory, err := sources.pool.variablesFactory(sources.logger, config)
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s': %s", name, err)
+	}
+
+	variables := factory.NewVariables(sources.teamName, sources.pipelineName)
+
+	val, found, err := variables.Get(template.VariableDefinition{Name: path[0]})
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s': %s", name, err)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("undefined var: %s:%s", name, path[0])
+	}
+
+	for _, field := range path[1:] {
+		switch fields := val.(type) {
+		case map[string]interface{}:
+			val, found = fields[field]
+		case map[interface{}]interface{}:
+			val, found = fields[field]
+		default:
+			found = false
+		}
+
+		if !found {
+			return nil, fmt.Errorf("var '%s:%s' has no field '%s'", name, path[0], field)
+		}
+	}
+
+	return val, nil
+}
+
+// interpolateVarSources resolves ((source:path)) references in the given JSON
+// payload. These are handled separately as they are not valid var names
+// according to the template package, which leaves them untouched.
+//
+// References are only resolved for pipelines with var sources, so that
+// params which happen to look like one keep working for other pipelines.
+func interpolateVarSources(variablesResolver Variables, payload []byte) ([]byte, error) {
+	if !varSourceVarRegex.Match(payload) {
+		return payload, nil
+	}
+
+	sourced, ok := variablesResolver.(varSourcedVariables)
+	if !ok || sourced.varSources() == nil {
+		return payload, nil
+	}
+
+	var node interface{}
+	err := json.Unmarshal(payload, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err = interpolateVarsInNode(
+		node,
+		varSourceVarRegex,
+		varSourceVarAnchoredRegex,
+		"var",
+		sourced.varSources().lookup,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(node)
+}
+
+// varSourceIdleTimeout is how long a var source's credential manager is kept
+// around after its vars were last used, e.g. once its pipeline's config has
+// changed.
+const varSourceIdleTimeout = time.Hour
+
+var defaultVarSourcePool = newVarSourcePool(clock.NewClock(), varSourceIdleTimeout)
+
+// varSourcePool holds the initialized credential managers of var sources,
+// keyed by a hash of their config. Managers are initialized without holding
+// up the pool, so that a slow credential manager only delays its own vars.
+// Managers which have not been used for the idle timeout are evicted, and
+// closed if they hold any resources.
+type varSourcePool struct {
+	clock       clock.Clock
+	idleTimeout time.Duration
+
+	entries map[string]*varSourceEntry
+	lock    sync.Mutex
+}
+
+// varSourceEntry is a var source's manager, which is ready once its
+// initialization has finished, successfully or not.
+type varSourceEntry struct {
+	ready    chan struct{}
+	factory  VariablesFactory
+	err      error
+	lastUsed time.Time
+}
+
+func newVarSourcePool(clock clock.Clock, idleTimeout time.Duration) *varSourcePool {
+	return &varSourcePool{
+		clock:       clock,
+		idleTimeout: idleTimeout,
+
+		entries: map[string]*varSourceEntry{},
+	}
+}
+
+func (pool *varSourcePool) variablesFactory(logger lager.Logger, source atc.VarSourceConfig) (VariablesFactory, error) {
+	config, err := json.Marshal(atc.VarSourceConfig{
+		Type:   source.Type,
+		Config: source.Config,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%x", sha256.Sum256(config))
+
+	pool.lock.Lock()
+
+	now := pool.clock.Now()
+
+	evicted := pool.evictIdle(now)
+
+	entry, found := pool.entries[key]
+	if found {
+		entry.lastUsed = now
+	} else {
+		entry = &varSourceEntry{
+			ready:    make(chan struct{}),
+			lastUsed: now,
+		}
+
+		pool.entries[key] = entry
+	}
+
+	pool.lock.Unlock()
+
+	for _, factory := range evicted {
+		closer, ok := factory.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		err := closer.Close()
+		if err != nil {
+			logger.Error("failed-to-close-var-source", err)
+		}
+	}
+
+	if !found {
+		entry.factory, entry.err = newVarSourceVariablesFactory(logger, source)
+		if entry.err != nil {
+			// leave the next use to try again
+			pool.lock.Lock()
+			delete(pool.entries, key)
+			pool.lock.Unlock()
+		}
+
+		close(entry.ready)
+	}
+
+	<-entry.ready
+
+	return entry.factory, entry.err
+}
+
+// evictIdle removes the managers which have not been used for the idle
+// timeout, returning their factories to be closed. Managers which are still
+// being initialized are kept.
+func (pool *varSourcePool) evictIdle(now time.Time) []VariablesFactory {
+	var evicted []VariablesFactory
+	for key, entry := range pool.entries {
+		if now.Sub(entry.lastUsed) < pool.idleTimeout {
+			continue
+		}
+
+		select {
+		case <-entry.ready:
+		default:
+			continue
+		}
+
+		delete(pool.entries, key)
+
+		evicted = append(evicted, entry.factory)
+	}
+
+	return evicted
+}
+
+func newVarSourceVariablesFactory(logger lager.Logger, source atc.VarSourceConfig) (VariablesFactory, error) {
+	manager, err := NewVarSourceManager(source)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceLogger := logger.Session("var-source", lager.Data{
+		"name": source.Name,
+		"type": source.Type,
+	})
+
+	err = manager.Init(sourceLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.NewVariablesFactory(sourceLogger)
+}
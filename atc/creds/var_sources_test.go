@@ -0,0 +1,176 @@
+package creds_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewPipelineVariables", func() {
+	var (
+		fakeManagerFactory *credsfakes.FakeManagerFactory
+		fakeManager        *credsfakes.FakeManager
+		fakeSourceFactory  *credsfakes.FakeVariablesFactory
+
+		sources   atc.VarSourceConfigs
+		variables creds.Variables
+	)
+
+	// var sources are shared by config, so every test gets its own
+	sourceID := 0
+
+	BeforeEach(func() {
+		fakeSourceFactory = new(credsfakes.FakeVariablesFactory)
+		fakeSourceFactory.NewVariablesReturns(template.StaticVariables{
+			"some-secret": map[interface{}]interface{}{
+				"username": "some-user",
+				"password": "some-password",
+			},
+			"some-count": 3,
+		})
+
+		fakeManager = new(credsfakes.FakeManager)
+		fakeManager.NewVariablesFactoryReturns(fakeSourceFactory, nil)
+
+		fakeManagerFactory = new(credsfakes.FakeManagerFactory)
+		fakeManagerFactory.NewInstanceReturns(fakeManager, nil)
+
+		creds.Register("some-type", fakeManagerFactory)
+
+		sourceID++
+
+		sources = atc.VarSourceConfigs{
+			{
+				Name:   "some-source",
+				Type:   "some-type",
+				Config: map[string]interface{}{"id": sourceID},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		fakeGlobalFactory := new(credsfakes.FakeVariablesFactory)
+		fakeGlobalFactory.NewVariablesReturns(template.StaticVariables{
+			"some-param": "lol",
+		})
+
+		variables = creds.NewPipelineVariables(
+			lagertest.NewTestLogger("test"),
+			fakeGlobalFactory,
+			"some-team",
+			"some-pipeline",
+			sources,
+		)
+	})
+
+	It("resolves vars through the source alongside the global vars", func() {
+		params, err := creds.NewParams(variables, atc.Params{
+			"username": "((some-source:some-secret.username))",
+			"auth":     "((some-source:some-secret.username)):((some-source:some-secret.password))",
+			"count":    "((some-source:some-count))",
+			"param":    "((some-param))",
+		}).Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(params).To(Equal(atc.Params{
+			"username": "some-user",
+			"auth":     "some-user:some-password",
+			"count":    3,
+			"param":    "lol",
+		}))
+	})
+
+	It("initializes the source's manager once with its config", func() {
+		for i := 0; i < 2; i++ {
+			_, err := creds.NewParams(variables, atc.Params{
+				"count": "((some-source:some-count))",
+			}).Evaluate()
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(fakeManagerFactory.NewInstanceCallCount()).To(Equal(1))
+		Expect(fakeManagerFactory.NewInstanceArgsForCall(0)).To(Equal(map[string]interface{}{"id": sourceID}))
+		Expect(fakeManager.ValidateCallCount()).To(Equal(1))
+		Expect(fakeManager.InitCallCount()).To(Equal(1))
+
+		team, pipeline := fakeSourceFactory.NewVariablesArgsForCall(0)
+		Expect(team).To(Equal("some-team"))
+		Expect(pipeline).To(Equal("some-pipeline"))
+	})
+
+	It("resolves build-local vars layered on top", func() {
+		buildVars := creds.NewBuildVariables()
+		buildVars.AddLocalVar("version", "1.2.3", false)
+
+		params, err := creds.NewParams(buildVars.WithParent(variables), atc.Params{
+			"tag": "v((.:version))-((some-source:some-count))",
+		}).Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(Equal(atc.Params{"tag": "v1.2.3-3"}))
+	})
+
+	It("errors when the source is not defined", func() {
+		_, err := creds.NewParams(variables, atc.Params{
+			"username": "((bogus-source:some-secret))",
+		}).Evaluate()
+		Expect(err).To(MatchError("undefined var source: bogus-source"))
+	})
+
+	It("errors when the var is not defined", func() {
+		_, err := creds.NewParams(variables, atc.Params{
+			"username": "((some-source:bogus))",
+		}).Evaluate()
+		Expect(err).To(MatchError("undefined var: some-source:bogus"))
+	})
+
+	It("errors when the field is not defined", func() {
+		_, err := creds.NewParams(variables, atc.Params{
+			"username": "((some-source:some-secret.bogus))",
+		}).Evaluate()
+		Expect(err).To(MatchError("var 'some-source:some-secret' has no field 'bogus'"))
+	})
+
+	Context("when the source is misconfigured", func() {
+		BeforeEach(func() {
+			fakeManager.ValidateReturns(errors.New("nope"))
+		})
+
+		It("returns the error when it is used", func() {
+			_, err := creds.NewParams(variables, atc.Params{
+				"username": "((some-source:some-secret.username))",
+			}).Evaluate()
+			Expect(err).To(MatchError("var source 'some-source': nope"))
+		})
+	})
+
+	Context("when the pipeline has no var sources", func() {
+		BeforeEach(func() {
+			sources = nil
+		})
+
+		It("leaves references to them untouched", func() {
+			params, err := creds.NewParams(variables, atc.Params{
+				"username": "((some-source:some-secret))",
+			}).Evaluate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params).To(Equal(atc.Params{"username": "((some-source:some-secret))"}))
+		})
+	})
+})
+
+var _ = Describe("NewVarSourceManager", func() {
+	It("errors for an unknown type", func() {
+		_, err := creds.NewVarSourceManager(atc.VarSourceConfig{
+			Name: "some-source",
+			Type: "bogus",
+		})
+		Expect(err).To(MatchError("unknown credential manager type: bogus"))
+	})
+})
@@ -23,6 +23,9 @@ type Cache struct {
 	sr       SecretReader
 	context  context.Context
 	maxLease time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
 }
 
 // TODO: Should a cache have a max size to
@@ -35,6 +38,7 @@ func NewCache(sr SecretReader, maxLease time.Duration) *Cache {
 		newItems: make(chan time.Time, 100),
 		sr:       sr,
 		maxLease: maxLease,
+		stop:     make(chan struct{}),
 	}
 	go c.reaperThread()
 	return c
@@ -74,13 +78,31 @@ func (c *Cache) reaperThread() {
 			}
 			nextWakeup = t
 			sleep.Reset(t.Sub(time.Now()))
+		case <-c.stop:
+			return
 		}
 	}
 }
 
+// Close stops reaping expired secrets. Secrets read afterwards are no longer
+// cached.
+func (c *Cache) Close() error {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+
+	return nil
+}
+
 // Read a secret from the cache or the underlying client if not
 // present.
 func (c *Cache) Read(path string) (*vaultapi.Secret, error) {
+	select {
+	case <-c.stop:
+		return c.sr.Read(path)
+	default:
+	}
+
 	// If we have the secret in our cache just return it
 	c.RLock() // don't use defer because we want to agressively release this lock
 	cs, cached := c.cache[path]
@@ -117,7 +139,10 @@ func (c *Cache) Read(path string) (*vaultapi.Secret, error) {
 	c.Unlock()
 
 	// Tell the reaper thread it has new items to cleanup
-	c.newItems <- cs.deadline
+	select {
+	case c.newItems <- cs.deadline:
+	case <-c.stop:
+	}
 
 	return secret, nil
 }
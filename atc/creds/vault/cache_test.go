@@ -107,3 +107,37 @@ func TestCache(t *testing.T) {
 	cache.RUnlock()
 
 }
+
+func TestCacheClose(t *testing.T) {
+	msr := &MockSecretReader{
+		secrets: []*vaultapi.Secret{
+			&vaultapi.Secret{
+				RequestID:     "1",
+				LeaseDuration: 10,
+			},
+			&vaultapi.Secret{
+				RequestID:     "2",
+				LeaseDuration: 10,
+			},
+		},
+	}
+
+	cache := NewCache(msr, 5*time.Second)
+	cache.Close()
+
+	_, err := cache.Read("path1")
+	if err != nil {
+		t.Error("got error reading valid secret", err)
+	}
+
+	secret, err := cache.Read("path1")
+	if err != nil {
+		t.Error("got error reading valid secret", err)
+	}
+	if secret.RequestID != "2" {
+		t.Errorf("read secret %s expected %s", secret.RequestID, "2")
+	}
+	if len(msr.reads) != 2 {
+		t.Errorf("Got reads [%v], expected [\"%s\"]", msr.reads, "path1 path1")
+	}
+}
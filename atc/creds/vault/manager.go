@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 
@@ -127,10 +128,17 @@ func (manager VaultManager) Health() (*creds.HealthResponse, error) {
 
 func (manager VaultManager) NewVariablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
 	ra := NewReAuther(manager.Client, manager.Auth.BackendMaxTTL, manager.Auth.RetryInitial, manager.Auth.RetryMax)
+	closers := []io.Closer{ra}
+
 	var sr SecretReader = manager.Client
 	if manager.Cache {
-		sr = NewCache(manager.Client, manager.MaxLease)
+		cache := NewCache(manager.Client, manager.MaxLease)
+		closers = append(closers, cache)
+		sr = cache
 	}
 
-	return NewVaultFactory(sr, ra.LoggedIn(), manager.PathPrefix), nil
+	factory := NewVaultFactory(sr, ra.LoggedIn(), manager.PathPrefix)
+	factory.closers = closers
+
+	return factory, nil
 }
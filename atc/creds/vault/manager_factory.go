@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc/creds"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -27,3 +29,20 @@ func (factory *vaultManagerFactory) AddConfig(group *flags.Group) creds.Manager
 
 	return manager
 }
+
+func (factory *vaultManagerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &VaultManager{}
+
+	err := creds.DecodeManagerConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	// the client cert identifies the ATC itself, so it must not be lent to
+	// pipelines
+	if manager.TLS.ClientCert != "" || manager.TLS.ClientKey != "" {
+		return nil, errors.New("client certs cannot be configured for a var source")
+	}
+
+	return manager, nil
+}
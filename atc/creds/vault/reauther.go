@@ -25,6 +25,9 @@ type ReAuther struct {
 
 	loggedIn     chan struct{}
 	loggedInOnce *sync.Once
+
+	stop     chan struct{}
+	stopOnce *sync.Once
 }
 
 // NewReAuther with a retry time and a max retry time.
@@ -37,6 +40,9 @@ func NewReAuther(auther Auther, maxTTL, retry, max time.Duration) *ReAuther {
 
 		loggedIn:     make(chan struct{}, 1),
 		loggedInOnce: &sync.Once{},
+
+		stop:     make(chan struct{}),
+		stopOnce: &sync.Once{},
 	}
 
 	go ra.authLoop()
@@ -50,6 +56,30 @@ func (ra *ReAuther) LoggedIn() <-chan struct{} {
 	return ra.loggedIn
 }
 
+// Close stops the authorization loop. The token is no longer renewed, and
+// expires at the end of its lease.
+func (ra *ReAuther) Close() error {
+	ra.stopOnce.Do(func() {
+		close(ra.stop)
+	})
+
+	return nil
+}
+
+// wait sleeps for the given duration and returns whether the ReAuther is still
+// running.
+func (ra *ReAuther) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ra.stop:
+		return false
+	}
+}
+
 // we can't renew a secret that has exceeded it's maxTTL or it's lease
 func (ra *ReAuther) renewable(leaseEnd, tokenEOL time.Time) bool {
 	now := time.Now()
@@ -67,13 +97,14 @@ func (ra *ReAuther) renewable(leaseEnd, tokenEOL time.Time) bool {
 	return true
 }
 
-// sleep until the tokenEOl or half the lease duration
-func (ra *ReAuther) sleep(leaseEnd, tokenEOL time.Time) {
+// sleep until the tokenEOl or half the lease duration, returning whether the
+// ReAuther is still running
+func (ra *ReAuther) sleep(leaseEnd, tokenEOL time.Time) bool {
 	if ra.maxTTL != 0 && leaseEnd.After(tokenEOL) {
-		time.Sleep(tokenEOL.Sub(time.Now()))
-	} else {
-		time.Sleep(leaseEnd.Sub(time.Now()) / 2)
+		return ra.wait(tokenEOL.Sub(time.Now()))
 	}
+
+	return ra.wait(leaseEnd.Sub(time.Now()) / 2)
 }
 
 func (ra *ReAuther) authLoop() {
@@ -88,7 +119,9 @@ func (ra *ReAuther) authLoop() {
 		for {
 			lease, err := ra.auther.Login()
 			if err != nil {
-				time.Sleep(exp.NextBackOff())
+				if !ra.wait(exp.NextBackOff()) {
+					return
+				}
 				continue
 			}
 
@@ -101,7 +134,9 @@ func (ra *ReAuther) authLoop() {
 			now := time.Now()
 			tokenEOL = now.Add(ra.maxTTL)
 			leaseEnd = now.Add(lease)
-			ra.sleep(leaseEnd, tokenEOL)
+			if !ra.sleep(leaseEnd, tokenEOL) {
+				return
+			}
 
 			break
 		}
@@ -113,14 +148,18 @@ func (ra *ReAuther) authLoop() {
 
 			lease, err := ra.auther.Renew()
 			if err != nil {
-				time.Sleep(exp.NextBackOff())
+				if !ra.wait(exp.NextBackOff()) {
+					return
+				}
 				continue
 			}
 
 			exp.Reset()
 
 			leaseEnd = time.Now().Add(lease)
-			ra.sleep(leaseEnd, tokenEOL)
+			if !ra.sleep(leaseEnd, tokenEOL) {
+				return
+			}
 		}
 	}
 }
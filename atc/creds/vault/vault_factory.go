@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"io"
 	"time"
 
 	"github.com/concourse/concourse/atc/creds"
@@ -11,6 +12,8 @@ type vaultFactory struct {
 	sr       SecretReader
 	prefix   string
 	loggedIn <-chan struct{}
+
+	closers []io.Closer
 }
 
 func NewVaultFactory(sr SecretReader, loggedIn <-chan struct{}, prefix string) *vaultFactory {
@@ -38,3 +41,16 @@ func (factory *vaultFactory) NewVariables(teamName string, pipelineName string)
 		PipelineName: pipelineName,
 	}
 }
+
+// Close stops the login renewal and cache reaping started along with the
+// factory.
+func (factory *vaultFactory) Close() error {
+	for _, closer := range factory.closers {
+		err := closer.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Package cron parses standard five-field cron expressions and computes
+// their activation times.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression, evaluated in a particular location.
+type Schedule struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// as with cron(8), when both the day of month and the day of week are
+	// restricted, a day matching either of them is used
+	daysRestricted     bool
+	weekdaysRestricted bool
+
+	location *time.Location
+}
+
+type bounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteBounds = bounds{0, 59, nil}
+	hourBounds   = bounds{0, 23, nil}
+	dayBounds    = bounds{1, 31, nil}
+	monthBounds  = bounds{1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	weekdayBounds = bounds{0, 7, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses the expression in UTC.
+func Parse(spec string) (Schedule, error) {
+	return ParseInLocation(spec, time.UTC)
+}
+
+// ParseInLocation parses the expression, to be evaluated in the given
+// location.
+func ParseInLocation(spec string, location *time.Location) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, found := macros[strings.ToLower(spec)]; found {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields in cron expression '%s', found %d", spec, len(fields))
+	}
+
+	schedule := Schedule{location: location}
+
+	var err error
+	schedule.minutes, err = parseField(fields[0], minuteBounds)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid minute: %s", err)
+	}
+
+	schedule.hours, err = parseField(fields[1], hourBounds)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid hour: %s", err)
+	}
+
+	schedule.days, err = parseField(fields[2], dayBounds)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid day of month: %s", err)
+	}
+
+	schedule.months, err = parseField(fields[3], monthBounds)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid month: %s", err)
+	}
+
+	schedule.weekdays, err = parseField(fields[4], weekdayBounds)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid day of week: %s", err)
+	}
+
+	// 7 is an alias for sunday
+	if schedule.weekdays&(1<<7) != 0 {
+		schedule.weekdays |= 1
+	}
+
+	schedule.daysRestricted = fields[2] != "*" && fields[2] != "?"
+	schedule.weekdaysRestricted = fields[4] != "*" && fields[4] != "?"
+
+	return schedule, nil
+}
+
+// Next returns the first activation strictly after the given time. The zero
+// time is returned if the schedule never activates, e.g. for February 30th.
+func (schedule Schedule) Next(after time.Time) time.Time {
+	t := after.In(schedule.location).Truncate(time.Minute).Add(time.Minute)
+
+	// a schedule which matches at all matches within a few years
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if schedule.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, schedule.location)
+			continue
+		}
+
+		if !schedule.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, schedule.location)
+			continue
+		}
+
+		if schedule.hours&(1<<uint(t.Hour())) == 0 {
+			// step in the schedule's location; truncating would align to UTC
+			// hours, which is wrong for zones with a non-whole-hour offset
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, schedule.location)
+			if !next.After(t) {
+				// the hour repeats as daylight saving time ends
+				next = t.Add(time.Hour)
+			}
+
+			t = next
+			continue
+		}
+
+		if schedule.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (schedule Schedule) dayMatches(t time.Time) bool {
+	dayMatches := schedule.days&(1<<uint(t.Day())) != 0
+	weekdayMatches := schedule.weekdays&(1<<uint(t.Weekday())) != 0
+
+	if schedule.daysRestricted && schedule.weekdaysRestricted {
+		return dayMatches || weekdayMatches
+	}
+
+	return dayMatches && weekdayMatches
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		partBits, err := parseRange(part, b)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= partBits
+	}
+
+	return bits, nil
+}
+
+func parseRange(part string, b bounds) (uint64, error) {
+	rangeAndStep := strings.SplitN(part, "/", 2)
+
+	start, end := b.min, b.max
+	step := 1
+
+	switch rangeAndStep[0] {
+	case "*", "?":
+	default:
+		startAndEnd := strings.SplitN(rangeAndStep[0], "-", 2)
+
+		var err error
+		start, err = parseValue(startAndEnd[0], b)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(startAndEnd) == 2 {
+			end, err = parseValue(startAndEnd[1], b)
+			if err != nil {
+				return 0, err
+			}
+		} else if len(rangeAndStep) == 1 {
+			end = start
+		}
+	}
+
+	if len(rangeAndStep) == 2 {
+		var err error
+		step, err = strconv.Atoi(rangeAndStep[1])
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step '%s'", rangeAndStep[1])
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("range '%s' ends before it starts", part)
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (int, error) {
+	if n, found := b.names[strings.ToLower(value)]; found {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", value)
+	}
+
+	if n < b.min || n > b.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, b.min, b.max)
+	}
+
+	return n, nil
+}
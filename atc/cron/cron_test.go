@@ -0,0 +1,110 @@
+package cron_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	at := func(s string) time.Time {
+		t, err := time.Parse("2006-01-02 15:04", s)
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	DescribeTable("Next",
+		func(spec string, after string, expected string) {
+			schedule, err := cron.Parse(spec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(schedule.Next(at(after))).To(Equal(at(expected)))
+		},
+
+		Entry("every minute", "* * * * *", "2018-01-01 10:30", "2018-01-01 10:31"),
+		Entry("a fixed time later today", "15 12 * * *", "2018-01-01 10:30", "2018-01-01 12:15"),
+		Entry("a fixed time tomorrow", "15 9 * * *", "2018-01-01 10:30", "2018-01-02 09:15"),
+		Entry("is strictly after", "30 10 * * *", "2018-01-01 10:30", "2018-01-02 10:30"),
+		Entry("steps", "*/20 * * * *", "2018-01-01 10:41", "2018-01-01 11:00"),
+		Entry("ranges with steps", "5-30/10 * * * *", "2018-01-01 10:16", "2018-01-01 10:25"),
+		Entry("lists", "0 8,17 * * *", "2018-01-01 09:00", "2018-01-01 17:00"),
+		Entry("month names", "0 0 1 mar *", "2018-01-15 00:00", "2018-03-01 00:00"),
+		Entry("weekday names", "0 9 * * mon-fri", "2018-01-06 10:00", "2018-01-08 09:00"),
+		Entry("7 as sunday", "0 0 * * 7", "2018-01-01 00:00", "2018-01-07 00:00"),
+		Entry("day of month or day of week", "0 0 13 * fri", "2018-01-01 00:00", "2018-01-05 00:00"),
+		Entry("leap days", "0 0 29 2 *", "2018-01-01 00:00", "2020-02-29 00:00"),
+		Entry("across years", "0 0 1 1 *", "2018-06-01 00:00", "2019-01-01 00:00"),
+		Entry("@hourly", "@hourly", "2018-01-01 10:30", "2018-01-01 11:00"),
+		Entry("@daily", "@daily", "2018-01-01 10:30", "2018-01-02 00:00"),
+		Entry("@weekly", "@weekly", "2018-01-01 10:30", "2018-01-07 00:00"),
+	)
+
+	It("evaluates the expression in its location", func() {
+		location, err := time.LoadLocation("America/New_York")
+		Expect(err).NotTo(HaveOccurred())
+
+		schedule, err := cron.ParseInLocation("0 9 * * *", location)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(schedule.Next(at("2018-01-01 10:00")).UTC()).To(Equal(at("2018-01-01 14:00")))
+	})
+
+	It("evaluates the expression in a location with a non-whole-hour offset", func() {
+		location, err := time.LoadLocation("Asia/Kolkata")
+		Expect(err).NotTo(HaveOccurred())
+
+		schedule, err := cron.ParseInLocation("0 11 * * *", location)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(schedule.Next(at("2018-01-01 00:00")).UTC()).To(Equal(at("2018-01-01 05:30")))
+		Expect(schedule.Next(at("2018-01-01 05:30")).UTC()).To(Equal(at("2018-01-02 05:30")))
+	})
+
+	It("skips the hour lost when daylight saving time starts", func() {
+		location, err := time.LoadLocation("America/New_York")
+		Expect(err).NotTo(HaveOccurred())
+
+		schedule, err := cron.ParseInLocation("30 * * * *", location)
+		Expect(err).NotTo(HaveOccurred())
+
+		// 01:30 EST is followed by 03:30 EDT, an hour later
+		Expect(schedule.Next(at("2018-03-11 06:30")).UTC()).To(Equal(at("2018-03-11 07:30")))
+	})
+
+	It("activates at the same local time after daylight saving time ends", func() {
+		location, err := time.LoadLocation("America/New_York")
+		Expect(err).NotTo(HaveOccurred())
+
+		schedule, err := cron.ParseInLocation("0 9 * * *", location)
+		Expect(err).NotTo(HaveOccurred())
+
+		// 09:00 EDT on the 3rd, then 09:00 EST on the 4th
+		Expect(schedule.Next(at("2018-11-03 00:00")).UTC()).To(Equal(at("2018-11-03 13:00")))
+		Expect(schedule.Next(at("2018-11-03 13:00")).UTC()).To(Equal(at("2018-11-04 14:00")))
+	})
+
+	It("returns the zero time for a schedule that never activates", func() {
+		schedule, err := cron.Parse("0 0 30 2 *")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(schedule.Next(at("2018-01-01 00:00")).IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("invalid expressions",
+		func(spec string) {
+			_, err := cron.Parse(spec)
+			Expect(err).To(HaveOccurred())
+		},
+
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("unknown names", "* * * foo *"),
+		Entry("backwards ranges", "30-10 * * * *"),
+		Entry("zero steps", "*/0 * * * *"),
+		Entry("unknown macros", "@sometimes"),
+	)
+})
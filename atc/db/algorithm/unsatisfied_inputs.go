@@ -0,0 +1,83 @@
+package algorithm
+
+import "sort"
+
+type UnsatisfiedReason string
+
+const (
+	UnsatisfiedNoVersions               UnsatisfiedReason = "no_versions"
+	UnsatisfiedPinnedVersionUnavailable UnsatisfiedReason = "pinned_version_unavailable"
+	UnsatisfiedPassedConstraints        UnsatisfiedReason = "passed_constraints"
+)
+
+type UnsatisfiedInputs map[string]UnsatisfiedInput
+
+type UnsatisfiedInput struct {
+	Reason     UnsatisfiedReason
+	PassedJobs []string
+}
+
+// Unsatisfied explains why the configs do not resolve. Inputs which cannot
+// be satisfied on their own are reported by themselves; if each input can be
+// satisfied alone, every input with passed constraints is reported, as no
+// version satisfies all of them at once.
+func (configs InputConfigs) Unsatisfied(db *VersionsDB) UnsatisfiedInputs {
+	unsatisfied := UnsatisfiedInputs{}
+
+	for _, inputConfig := range configs {
+		_, ok := InputConfigs{inputConfig}.Resolve(db)
+		if ok {
+			continue
+		}
+
+		if len(inputConfig.Passed) > 0 && !db.AllVersionsOfResource(inputConfig.ResourceID).IsEmpty() {
+			unsatisfied[inputConfig.Name] = UnsatisfiedInput{
+				Reason:     UnsatisfiedPassedConstraints,
+				PassedJobs: db.JobNames(inputConfig.Passed),
+			}
+		} else if inputConfig.PinnedVersionID != 0 {
+			unsatisfied[inputConfig.Name] = UnsatisfiedInput{
+				Reason: UnsatisfiedPinnedVersionUnavailable,
+			}
+		} else {
+			unsatisfied[inputConfig.Name] = UnsatisfiedInput{
+				Reason: UnsatisfiedNoVersions,
+			}
+		}
+	}
+
+	if len(unsatisfied) > 0 {
+		return unsatisfied
+	}
+
+	_, ok := configs.Resolve(db)
+	if ok {
+		return unsatisfied
+	}
+
+	for _, inputConfig := range configs {
+		if len(inputConfig.Passed) == 0 {
+			continue
+		}
+
+		unsatisfied[inputConfig.Name] = UnsatisfiedInput{
+			Reason:     UnsatisfiedPassedConstraints,
+			PassedJobs: db.JobNames(inputConfig.Passed),
+		}
+	}
+
+	return unsatisfied
+}
+
+func (db VersionsDB) JobNames(jobs JobSet) []string {
+	names := []string{}
+	for name, id := range db.JobIDs {
+		if jobs.Contains(id) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
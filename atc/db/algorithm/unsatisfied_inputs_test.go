@@ -0,0 +1,135 @@
+package algorithm_test
+
+import (
+	"github.com/concourse/concourse/atc/db/algorithm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Unsatisfied", func() {
+	var (
+		versionsDB   *algorithm.VersionsDB
+		inputConfigs algorithm.InputConfigs
+		unsatisfied  algorithm.UnsatisfiedInputs
+	)
+
+	BeforeEach(func() {
+		versionsDB = &algorithm.VersionsDB{
+			ResourceVersions: []algorithm.ResourceVersion{
+				{VersionID: 1, ResourceID: 21, CheckOrder: 1},
+				{VersionID: 2, ResourceID: 21, CheckOrder: 2},
+			},
+			BuildOutputs: []algorithm.BuildOutput{},
+			BuildInputs:  []algorithm.BuildInput{},
+			JobIDs:       map[string]int{"j1": 11, "j2": 12, "j3": 13},
+			ResourceIDs:  map[string]int{"r1": 21, "r2": 22},
+		}
+	})
+
+	JustBeforeEach(func() {
+		unsatisfied = inputConfigs.Unsatisfied(versionsDB)
+	})
+
+	Context("when every input can be satisfied", func() {
+		BeforeEach(func() {
+			inputConfigs = algorithm.InputConfigs{
+				{Name: "some-input", Passed: algorithm.JobSet{}, ResourceID: 21, JobID: 11},
+			}
+		})
+
+		It("returns nothing", func() {
+			Expect(unsatisfied).To(BeEmpty())
+		})
+	})
+
+	Context("when a resource has no versions", func() {
+		BeforeEach(func() {
+			inputConfigs = algorithm.InputConfigs{
+				{Name: "some-input", Passed: algorithm.JobSet{}, ResourceID: 21, JobID: 11},
+				{Name: "other-input", Passed: algorithm.JobSet{}, ResourceID: 22, JobID: 11},
+			}
+		})
+
+		It("reports only that input", func() {
+			Expect(unsatisfied).To(Equal(algorithm.UnsatisfiedInputs{
+				"other-input": {Reason: algorithm.UnsatisfiedNoVersions},
+			}))
+		})
+	})
+
+	Context("when a pinned version is not a version of the resource", func() {
+		BeforeEach(func() {
+			inputConfigs = algorithm.InputConfigs{
+				{Name: "some-input", Passed: algorithm.JobSet{}, ResourceID: 21, PinnedVersionID: 3, JobID: 11},
+			}
+		})
+
+		It("reports the pinned version as unavailable", func() {
+			Expect(unsatisfied).To(Equal(algorithm.UnsatisfiedInputs{
+				"some-input": {Reason: algorithm.UnsatisfiedPinnedVersionUnavailable},
+			}))
+		})
+	})
+
+	Context("when no version has passed the upstream jobs", func() {
+		BeforeEach(func() {
+			inputConfigs = algorithm.InputConfigs{
+				{
+					Name:       "some-input",
+					Passed:     algorithm.JobSet{12: struct{}{}, 13: struct{}{}},
+					ResourceID: 21,
+					JobID:      11,
+				},
+			}
+		})
+
+		It("reports the jobs of the passed constraint", func() {
+			Expect(unsatisfied).To(Equal(algorithm.UnsatisfiedInputs{
+				"some-input": {
+					Reason:     algorithm.UnsatisfiedPassedConstraints,
+					PassedJobs: []string{"j2", "j3"},
+				},
+			}))
+		})
+	})
+
+	Context("when the inputs can only be satisfied on their own", func() {
+		BeforeEach(func() {
+			versionsDB.ResourceVersions = append(versionsDB.ResourceVersions, algorithm.ResourceVersion{
+				VersionID: 3, ResourceID: 22, CheckOrder: 1,
+			})
+
+			versionsDB.BuildOutputs = []algorithm.BuildOutput{
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 21, CheckOrder: 1},
+					BuildID:         31,
+					JobID:           12,
+				},
+				{
+					ResourceVersion: algorithm.ResourceVersion{VersionID: 3, ResourceID: 22, CheckOrder: 1},
+					BuildID:         32,
+					JobID:           12,
+				},
+			}
+
+			inputConfigs = algorithm.InputConfigs{
+				{Name: "some-input", Passed: algorithm.JobSet{12: struct{}{}}, ResourceID: 21, JobID: 11},
+				{Name: "other-input", Passed: algorithm.JobSet{12: struct{}{}}, ResourceID: 22, JobID: 11},
+				{Name: "unconstrained-input", Passed: algorithm.JobSet{}, ResourceID: 21, JobID: 11},
+			}
+		})
+
+		It("reports every input with passed constraints", func() {
+			Expect(unsatisfied).To(Equal(algorithm.UnsatisfiedInputs{
+				"some-input": {
+					Reason:     algorithm.UnsatisfiedPassedConstraints,
+					PassedJobs: []string{"j2"},
+				},
+				"other-input": {
+					Reason:     algorithm.UnsatisfiedPassedConstraints,
+					PassedJobs: []string{"j2"},
+				},
+			}))
+		})
+	})
+})
@@ -28,7 +28,7 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained, b.input_overrides, b.rerun_of, b.rerun_number, b.priority, b.queued, b.create_time, b.logs_archived").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
@@ -49,13 +49,20 @@ type Build interface {
 	EngineMetadata() string
 	PublicPlan() *json.RawMessage
 	Status() BuildStatus
+	CreateTime() time.Time
 	StartTime() time.Time
 	EndTime() time.Time
 	ReapTime() time.Time
+	LogsArchived() bool
 	Tracker() string
 	IsManuallyTriggered() bool
+	InputOverrides() atc.InputOverrides
+	RerunOf() int
+	RerunNumber() int
 	IsScheduled() bool
 	IsRunning() bool
+	Priority() int
+	IsQueued() bool
 
 	Reload() (bool, error)
 
@@ -86,6 +93,7 @@ type Build interface {
 
 	Delete() (bool, error)
 	MarkAsAborted() error
+	MarkLogsArchived() error
 	AbortNotifier() (Notifier, error)
 	Schedule() (bool, error)
 
@@ -108,14 +116,24 @@ type build struct {
 	jobName      string
 
 	isManuallyTriggered bool
+	inputOverrides      atc.InputOverrides
+
+	rerunOf     int
+	rerunNumber int
+
+	priority int
+	queued   bool
 
 	engine         string
 	engineMetadata string
 	publicPlan     *json.RawMessage
 
-	startTime time.Time
-	endTime   time.Time
-	reapTime  time.Time
+	createTime time.Time
+	startTime  time.Time
+	endTime    time.Time
+	reapTime   time.Time
+
+	logsArchived bool
 
 	trackedBy string
 
@@ -126,25 +144,32 @@ type build struct {
 
 var ErrBuildDisappeared = errors.New("build-disappeared-from-db")
 
-func (b *build) ID() int                      { return b.id }
-func (b *build) Name() string                 { return b.name }
-func (b *build) JobID() int                   { return b.jobID }
-func (b *build) JobName() string              { return b.jobName }
-func (b *build) PipelineID() int              { return b.pipelineID }
-func (b *build) PipelineName() string         { return b.pipelineName }
-func (b *build) TeamID() int                  { return b.teamID }
-func (b *build) TeamName() string             { return b.teamName }
-func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
-func (b *build) Engine() string               { return b.engine }
-func (b *build) EngineMetadata() string       { return b.engineMetadata }
-func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
-func (b *build) StartTime() time.Time         { return b.startTime }
-func (b *build) EndTime() time.Time           { return b.endTime }
-func (b *build) ReapTime() time.Time          { return b.reapTime }
-func (b *build) Status() BuildStatus          { return b.status }
-func (b *build) Tracker() string              { return b.trackedBy }
-func (b *build) IsScheduled() bool            { return b.scheduled }
-func (b *build) IsDrained() bool              { return b.drained }
+func (b *build) ID() int                            { return b.id }
+func (b *build) Name() string                       { return b.name }
+func (b *build) JobID() int                         { return b.jobID }
+func (b *build) JobName() string                    { return b.jobName }
+func (b *build) PipelineID() int                    { return b.pipelineID }
+func (b *build) PipelineName() string               { return b.pipelineName }
+func (b *build) TeamID() int                        { return b.teamID }
+func (b *build) TeamName() string                   { return b.teamName }
+func (b *build) IsManuallyTriggered() bool          { return b.isManuallyTriggered }
+func (b *build) InputOverrides() atc.InputOverrides { return b.inputOverrides }
+func (b *build) RerunOf() int                       { return b.rerunOf }
+func (b *build) RerunNumber() int                   { return b.rerunNumber }
+func (b *build) Engine() string                     { return b.engine }
+func (b *build) EngineMetadata() string             { return b.engineMetadata }
+func (b *build) PublicPlan() *json.RawMessage       { return b.publicPlan }
+func (b *build) CreateTime() time.Time              { return b.createTime }
+func (b *build) StartTime() time.Time               { return b.startTime }
+func (b *build) EndTime() time.Time                 { return b.endTime }
+func (b *build) ReapTime() time.Time                { return b.reapTime }
+func (b *build) LogsArchived() bool                 { return b.logsArchived }
+func (b *build) Status() BuildStatus                { return b.status }
+func (b *build) Tracker() string                    { return b.trackedBy }
+func (b *build) IsScheduled() bool                  { return b.scheduled }
+func (b *build) IsDrained() bool                    { return b.drained }
+func (b *build) Priority() int                      { return b.priority }
+func (b *build) IsQueued() bool                     { return b.queued }
 
 func (b *build) IsRunning() bool {
 	switch b.status {
@@ -230,6 +255,7 @@ func (b *build) Start(engine, metadata string, plan atc.Plan) (bool, error) {
 
 	err = psql.Update("builds").
 		Set("status", "started").
+		Set("queued", false).
 		Set("start_time", sq.Expr("now()")).
 		Set("engine", engine).
 		Set("engine_metadata", encryptedMetadata).
@@ -301,6 +327,7 @@ func (b *build) Finish(status BuildStatus) error {
 
 	err = psql.Update("builds").
 		Set("status", status).
+		Set("queued", false).
 		Set("end_time", sq.Expr("now()")).
 		Set("completed", true).
 		Set("engine_metadata", nil).
@@ -421,6 +448,7 @@ func (b *build) Delete() (bool, error) {
 func (b *build) MarkAsAborted() error {
 	_, err := psql.Update("builds").
 		Set("status", string(BuildStatusAborted)).
+		Set("queued", false).
 		Where(sq.Eq{"id": b.id}).
 		RunWith(b.conn).
 		Exec()
@@ -431,6 +459,23 @@ func (b *build) MarkAsAborted() error {
 	return b.conn.Bus().Notify(buildAbortChannel(b.id))
 }
 
+// MarkLogsArchived notes that the build's events have been archived, so that
+// they can still be read once they have been reaped.
+func (b *build) MarkLogsArchived() error {
+	_, err := psql.Update("builds").
+		Set("logs_archived", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.logsArchived = true
+
+	return nil
+}
+
 // AbortNotifier returns a Notifier that can be watched for when the build
 // is marked as aborted. Once the build is marked as aborted it will send a
 // notification to finish the build to ATC that is tracking this build.
@@ -451,6 +496,7 @@ func (b *build) AbortNotifier() (Notifier, error) {
 func (b *build) Schedule() (bool, error) {
 	result, err := psql.Update("builds").
 		Set("scheduled", true).
+		Set("queued", false).
 		Where(sq.Eq{"id": b.id}).
 		RunWith(b.conn).
 		Exec()
@@ -595,17 +641,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -1018,16 +1054,17 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID                                                    sql.NullInt64
+		jobID, pipelineID, rerunOf, rerunNumber                              sql.NullInt64
 		engine, engineMetadata, jobName, pipelineName, publicPlan, trackedBy sql.NullString
 		startTime, endTime, reapTime                                         pq.NullTime
 		nonce                                                                sql.NullString
 		drained                                                              bool
+		inputOverrides                                                       []byte
 
 		status string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained, &inputOverrides, &rerunOf, &rerunNumber, &b.priority, &b.queued, &b.createTime, &b.logsArchived)
 	if err != nil {
 		return err
 	}
@@ -1043,6 +1080,16 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.reapTime = reapTime.Time
 	b.trackedBy = trackedBy.String
 	b.drained = drained
+	b.rerunOf = int(rerunOf.Int64)
+	b.rerunNumber = int(rerunNumber.Int64)
+
+	b.inputOverrides = atc.InputOverrides{}
+	if inputOverrides != nil {
+		err = json.Unmarshal(inputOverrides, &b.inputOverrides)
+		if err != nil {
+			return err
+		}
+	}
 
 	var (
 		noncense                *string
@@ -17,6 +17,7 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	BuildsBlockingWorker(string) ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -108,6 +109,24 @@ func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// BuildsBlockingWorker returns the builds which keep a landing or retiring
+// worker from finishing, i.e. the running builds with containers on it which
+// can not be interrupted.
+func (f *buildFactory) BuildsBlockingWorker(workerName string) ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.status": []string{string(BuildStatusPending), string(BuildStatusStarted)},
+		}).
+		Where(sq.Or{
+			sq.Eq{"j.interruptible": false},
+			sq.Eq{"b.job_id": nil},
+		}).
+		Where("EXISTS (SELECT 1 FROM containers c WHERE c.build_id = b.id AND c.worker_name = ?)", workerName).
+		OrderBy("b.id ASC")
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFactory) ([]Build, error) {
 	rows, err := buildsQuery.RunWith(conn).Query()
 	if err != nil {
@@ -1,6 +1,10 @@
 package db
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc/db/algorithm"
+)
 
 type BuildPreparationStatus string
 
@@ -39,3 +43,49 @@ type BuildPreparation struct {
 	InputsSatisfied     BuildPreparationStatus
 	MissingInputReasons MissingInputReasons
 }
+
+type SchedulingBlockerReason string
+
+const (
+	SchedulingBlockerPipelinePaused     SchedulingBlockerReason = "pipeline_paused"
+	SchedulingBlockerJobPaused          SchedulingBlockerReason = "job_paused"
+	SchedulingBlockerMaxInFlightReached SchedulingBlockerReason = "max_in_flight_reached"
+	SchedulingBlockerSerialGroupBlocked SchedulingBlockerReason = "serial_group_blocked"
+	SchedulingBlockerInputUnsatisfied   SchedulingBlockerReason = "input_unsatisfied"
+	SchedulingBlockerCheckErrored       SchedulingBlockerReason = "check_errored"
+	SchedulingBlockerTeamQuotaReached   SchedulingBlockerReason = "team_quota_reached"
+)
+
+// SchedulingBlocker is one reason for a job's pending builds not being
+// started. Which of the other fields are set depends on the reason.
+type SchedulingBlocker struct {
+	Reason SchedulingBlockerReason
+
+	// set for input_unsatisfied and check_errored
+	Input    string
+	Resource string
+
+	// set for input_unsatisfied
+	InputReason algorithm.UnsatisfiedReason
+	PassedJobs  []string
+
+	// set for max_in_flight_reached and serial_group_blocked, naming the
+	// build which is running or next in line
+	BuildID   int
+	BuildName string
+	JobName   string
+
+	// set for check_errored
+	CheckError string
+
+	// set for team_quota_reached
+	ActiveBuilds        int
+	MaxConcurrentBuilds int
+}
+
+type JobSchedulingStatus struct {
+	JobName          string
+	PendingBuildID   int
+	PendingBuildName string
+	Blockers         []SchedulingBlocker
+}
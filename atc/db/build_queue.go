@@ -0,0 +1,333 @@
+package db
+
+import (
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+//go:generate counterfeiter . BuildQueue
+
+// BuildQueue orders the pending builds of every pipeline by priority, so that
+// when only a limited number of builds may be active at once the most
+// important ones are started first.
+type BuildQueue interface {
+	Usage() (*QueueUsage, error)
+	Admit(Build, *QueueUsage) (Admission, error)
+	Withdraw(Build) error
+	PendingBuilds(teamNames []string) ([]QueuedBuild, error)
+}
+
+// Admission is the outcome of offering a build to the queue.
+type Admission struct {
+	Admitted bool
+
+	// TeamQuotaReached is set when the build has to wait because its team
+	// already has as many builds running as it is allowed.
+	TeamQuotaReached bool
+
+	// TeamActiveBuilds is the number of the team's builds which are
+	// scheduled or running.
+	TeamActiveBuilds int
+}
+
+// QueueUsage is how many builds each team has scheduled or running, counted
+// once per scheduling tick rather than for every build offered to the queue.
+// Builds admitted with it are added to it, so that the builds admitted later
+// in the same tick make way for them.
+type QueueUsage struct {
+	teams map[int]*teamUsage
+}
+
+type teamUsage struct {
+	maxConcurrentBuilds int
+	buildWeight         int
+	active              int
+}
+
+// team returns the usage of the given team. Teams created since the usage
+// was counted have no builds yet.
+func (usage *QueueUsage) team(teamID int) *teamUsage {
+	if usage.teams == nil {
+		usage.teams = map[int]*teamUsage{}
+	}
+
+	team, found := usage.teams[teamID]
+	if !found {
+		team = &teamUsage{buildWeight: 1}
+		usage.teams[teamID] = team
+	}
+
+	return team
+}
+
+func (usage *QueueUsage) active() int {
+	active := 0
+	for _, team := range usage.teams {
+		active += team.active
+	}
+
+	return active
+}
+
+// table lists the usage of every team in place of teamUsageTable.
+func (usage *QueueUsage) table() (string, []interface{}) {
+	rows := []string{}
+	args := []interface{}{}
+	for teamID, team := range usage.teams {
+		rows = append(rows, "(?::integer, ?::integer, ?::integer, ?::integer)")
+		args = append(args, teamID, team.maxConcurrentBuilds, team.buildWeight, team.active)
+	}
+
+	return "(VALUES " + strings.Join(rows, ", ") + ") AS u(team_id, max_concurrent_builds, build_weight, active)", args
+}
+
+// QueuedBuild is a pending build along with its position in the queue. Builds
+// which are still waiting on something other than the queue, e.g. their
+// inputs, have no position.
+type QueuedBuild struct {
+	Build    Build
+	Position int
+}
+
+type buildQueue struct {
+	conn            Conn
+	lockFactory     lock.LockFactory
+	maxActiveBuilds int
+}
+
+// NewBuildQueue returns a queue which admits builds while fewer than
+// maxActiveBuilds are scheduled or running. If maxActiveBuilds is zero,
+// every build is admitted straight away.
+func NewBuildQueue(conn Conn, lockFactory lock.LockFactory, maxActiveBuilds int) BuildQueue {
+	return &buildQueue{
+		conn:            conn,
+		lockFactory:     lockFactory,
+		maxActiveBuilds: maxActiveBuilds,
+	}
+}
+
+// Usage counts how many builds each team has scheduled or running.
+func (q *buildQueue) Usage() (*QueueUsage, error) {
+	rows, err := psql.Select("u.team_id", "u.max_concurrent_builds", "u.build_weight", "u.active").
+		From(teamUsageTable).
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	usage := &QueueUsage{teams: map[int]*teamUsage{}}
+	for rows.Next() {
+		var teamID int
+		team := &teamUsage{}
+		err = rows.Scan(&teamID, &team.maxConcurrentBuilds, &team.buildWeight, &team.active)
+		if err != nil {
+			return nil, err
+		}
+
+		usage.teams[teamID] = team
+	}
+
+	return usage, nil
+}
+
+// Admit marks the build as ready to start and returns whether there is room
+// for it. A build is held back while its team is at its quota, and otherwise
+// has to wait for the ready builds ahead of it: those of teams using less of
+// their share of the cluster, then those of its own team with a higher
+// priority. Several ATCs admitting builds at the same time may briefly exceed
+// the limits.
+func (q *buildQueue) Admit(build Build, usage *QueueUsage) (Admission, error) {
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return Admission{}, err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("builds").
+		Set("queued", true).
+		Where(sq.Eq{
+			"id":        build.ID(),
+			"status":    BuildStatusPending,
+			"scheduled": false,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return Admission{}, err
+	}
+
+	team := usage.team(build.TeamID())
+	teamActive := team.active
+
+	admission := Admission{TeamActiveBuilds: teamActive}
+
+	if team.maxConcurrentBuilds > 0 && teamActive >= team.maxConcurrentBuilds {
+		admission.TeamQuotaReached = true
+		return admission, tx.Commit()
+	}
+
+	if q.maxActiveBuilds <= 0 {
+		err = tx.Commit()
+		if err != nil {
+			return Admission{}, err
+		}
+
+		team.active++
+
+		admission.Admitted = true
+		return admission, nil
+	}
+
+	buildWeight := team.buildWeight
+	usageTable, usageArgs := usage.table()
+
+	// compare each team's active builds per unit of weight without dividing
+	var ahead int
+	err = readyBuildsQuery.
+		Columns("COUNT(*)").
+		Join(usageTable+" ON b.team_id = u.team_id", usageArgs...).
+		Where("(u.max_concurrent_builds = 0 OR u.active < u.max_concurrent_builds)").
+		Where(sq.Or{
+			sq.Expr("u.active * ? < ? * u.build_weight", buildWeight, teamActive),
+			sq.And{
+				sq.Expr("u.active * ? = ? * u.build_weight", buildWeight, teamActive),
+				sq.Or{
+					sq.Gt{"b.priority": build.Priority()},
+					sq.And{
+						sq.Eq{"b.priority": build.Priority()},
+						sq.Lt{"b.id": build.ID()},
+					},
+				},
+			},
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&ahead)
+	if err != nil {
+		return Admission{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return Admission{}, err
+	}
+
+	admission.Admitted = usage.active()+ahead < q.maxActiveBuilds
+	if admission.Admitted {
+		team.active++
+	}
+
+	return admission, nil
+}
+
+// Withdraw takes a build back out of the queue when it turns out to be
+// waiting on something other than the queue, e.g. its job reaching its max in
+// flight or its inputs no longer being satisfied, so that it doesn't hold up
+// the builds behind it. Builds are also withdrawn once they are scheduled,
+// started or finished.
+func (q *buildQueue) Withdraw(build Build) error {
+	_, err := psql.Update("builds").
+		Set("queued", false).
+		Where(sq.Eq{
+			"id":     build.ID(),
+			"queued": true,
+		}).
+		RunWith(q.conn).
+		Exec()
+
+	return err
+}
+
+// PendingBuilds returns the pending builds of jobs visible to the given
+// teams, highest priority first. Builds of teams at their quota have no
+// position.
+func (q *buildQueue) PendingBuilds(teamNames []string) ([]QueuedBuild, error) {
+	rows, err := readyBuildsQuery.
+		Columns("b.id", "row_number() OVER (ORDER BY u.active::float / u.build_weight ASC, b.priority DESC, b.id ASC)").
+		Join(teamUsageTable+" ON b.team_id = u.team_id").
+		Where("(u.max_concurrent_builds = 0 OR u.active < u.max_concurrent_builds)").
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	positions := map[int]int{}
+	for rows.Next() {
+		var id, position int
+		err = rows.Scan(&id, &position)
+		if err != nil {
+			return nil, err
+		}
+
+		positions[id] = position
+	}
+
+	rows, err = buildsQuery.
+		Where(sq.Eq{
+			"b.status":    BuildStatusPending,
+			"b.scheduled": false,
+		}).
+		Where(sq.NotEq{"b.job_id": nil}).
+		Where(sq.Or{
+			sq.Eq{"p.public": true},
+			sq.Eq{"t.name": teamNames},
+		}).
+		OrderBy("b.priority DESC", "b.id ASC").
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	queuedBuilds := []QueuedBuild{}
+	for rows.Next() {
+		build := &build{conn: q.conn, lockFactory: q.lockFactory}
+		err = scanBuild(build, rows, q.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		queuedBuilds = append(queuedBuilds, QueuedBuild{
+			Build:    build,
+			Position: positions[build.ID()],
+		})
+	}
+
+	return queuedBuilds, nil
+}
+
+// readyBuildsQuery selects builds which are only waiting on the queue. Builds
+// of paused jobs and pipelines stay out of the way until they are unpaused,
+// and those of jobs removed from their pipeline never start.
+var readyBuildsQuery = psql.Select().
+	From("builds b").
+	Join("jobs j ON b.job_id = j.id").
+	Join("pipelines p ON j.pipeline_id = p.id").
+	Where(sq.Eq{
+		"b.status":    BuildStatusPending,
+		"b.scheduled": false,
+		"b.queued":    true,
+		"j.active":    true,
+		"j.paused":    false,
+		"p.paused":    false,
+	})
+
+// teamUsageTable counts each team's scheduled and running builds alongside
+// its quota.
+const teamUsageTable = `(
+	SELECT t.id AS team_id, t.max_concurrent_builds, t.build_weight, COUNT(a.id) AS active
+	FROM teams t
+	LEFT JOIN builds a ON a.team_id = t.id AND (a.status = 'started' OR (a.status = 'pending' AND a.scheduled))
+	GROUP BY t.id
+) u`
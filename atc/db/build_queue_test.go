@@ -0,0 +1,400 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildQueue", func() {
+	var (
+		pipeline   db.Pipeline
+		releaseJob db.Job
+		prJob      db.Job
+
+		releaseBuild db.Build
+		prBuild      db.Build
+	)
+
+	BeforeEach(func() {
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "queue-pipeline"}, atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "release", Priority: 10},
+				{Name: "pr"},
+			},
+		}, db.ConfigVersion(0), db.PipelineUnpaused)
+		Expect(err).NotTo(HaveOccurred())
+
+		var found bool
+		releaseJob, found, err = pipeline.Job("release")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		prJob, found, err = pipeline.Job("pr")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		prBuild, err = prJob.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		releaseBuild, err = releaseJob.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("gives builds the priority of their job", func() {
+		Expect(releaseBuild.Priority()).To(Equal(10))
+		Expect(prBuild.Priority()).To(Equal(0))
+	})
+
+	It("gives manually triggered builds the priority they were triggered with", func() {
+		priority := 20
+		build, err := prJob.CreateBuildWithInputOverrides(atc.InputOverrides{Priority: &priority})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(build.Priority()).To(Equal(20))
+	})
+
+	Describe("Admit", func() {
+		Context("when there is no limit", func() {
+			It("admits every build", func() {
+				buildQueue := db.NewBuildQueue(dbConn, lockFactory, 0)
+
+				admission, err := admit(buildQueue, prBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+
+				admission, err = admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+			})
+		})
+
+		Context("when there is a limit", func() {
+			var buildQueue db.BuildQueue
+
+			BeforeEach(func() {
+				buildQueue = db.NewBuildQueue(dbConn, lockFactory, 1)
+			})
+
+			It("marks the build as queued", func() {
+				_, err := admit(buildQueue, prBuild)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := prBuild.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(prBuild.IsQueued()).To(BeTrue())
+			})
+
+			It("admits higher priority builds first", func() {
+				admission, err := admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+
+				admission, err = admit(buildQueue, prBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeFalse())
+			})
+
+			It("does not admit builds once the limit is reached", func() {
+				admission, err := admit(buildQueue, prBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+
+				scheduled, err := prBuild.Schedule()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(scheduled).To(BeTrue())
+
+				admission, err = admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeFalse())
+			})
+
+			It("admits builds again once active builds finish", func() {
+				scheduled, err := prBuild.Schedule()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(scheduled).To(BeTrue())
+
+				err = prBuild.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				admission, err := admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+			})
+
+			It("does not hold builds up behind builds of paused jobs", func() {
+				_, err := admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = releaseJob.Pause()
+				Expect(err).NotTo(HaveOccurred())
+
+				admission, err := admit(buildQueue, prBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+			})
+
+			It("does not hold builds up behind builds of jobs removed from their pipeline", func() {
+				_, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "queue-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "pr"},
+					},
+				}, pipeline.ConfigVersion(), db.PipelineUnpaused)
+				Expect(err).NotTo(HaveOccurred())
+
+				admission, err := admit(buildQueue, prBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("team quotas", func() {
+		var (
+			otherJob   db.Job
+			otherBuild db.Build
+		)
+
+		BeforeEach(func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).NotTo(HaveOccurred())
+
+			otherPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "other-job", Priority: 5},
+				},
+			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			Expect(err).NotTo(HaveOccurred())
+
+			var found bool
+			otherJob, found, err = otherPipeline.Job("other-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherBuild, err = otherJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the team has as many active builds as its quota allows", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdateBuildQuota(1, 1)
+				Expect(err).NotTo(HaveOccurred())
+
+				scheduled, err := prBuild.Schedule()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(scheduled).To(BeTrue())
+			})
+
+			It("holds the team's builds back even without a global limit", func() {
+				buildQueue := db.NewBuildQueue(dbConn, lockFactory, 0)
+
+				admission, err := admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeFalse())
+				Expect(admission.TeamQuotaReached).To(BeTrue())
+				Expect(admission.TeamActiveBuilds).To(Equal(1))
+			})
+
+			It("does not hold other teams' builds up behind them", func() {
+				buildQueue := db.NewBuildQueue(dbConn, lockFactory, 2)
+
+				_, err := admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+
+				admission, err := admit(buildQueue, otherBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+			})
+		})
+
+		Context("when builds are admitted with the same usage", func() {
+			It("counts the builds admitted before them", func() {
+				buildQueue := db.NewBuildQueue(dbConn, lockFactory, 0)
+
+				err := defaultTeam.UpdateBuildQuota(1, 1)
+				Expect(err).NotTo(HaveOccurred())
+
+				usage, err := buildQueue.Usage()
+				Expect(err).NotTo(HaveOccurred())
+
+				admission, err := buildQueue.Admit(releaseBuild, usage)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+
+				admission, err = buildQueue.Admit(prBuild, usage)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeFalse())
+				Expect(admission.TeamQuotaReached).To(BeTrue())
+			})
+		})
+
+		Context("when the cluster is at capacity", func() {
+			It("admits builds of teams using less of their share first", func() {
+				buildQueue := db.NewBuildQueue(dbConn, lockFactory, 2)
+
+				scheduled, err := prBuild.Schedule()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(scheduled).To(BeTrue())
+
+				_, err = admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+
+				admission, err := admit(buildQueue, otherBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeTrue())
+
+				admission, err = admit(buildQueue, releaseBuild)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admission.Admitted).To(BeFalse())
+			})
+
+			Context("when the teams have different weights", func() {
+				var (
+					buildQueue     db.BuildQueue
+					nextPRBuild    db.Build
+					nextOtherBuild db.Build
+				)
+
+				BeforeEach(func() {
+					buildQueue = db.NewBuildQueue(dbConn, lockFactory, 3)
+
+					err := defaultTeam.UpdateBuildQuota(0, 2)
+					Expect(err).NotTo(HaveOccurred())
+
+					scheduled, err := prBuild.Schedule()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+
+					scheduled, err = otherBuild.Schedule()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+
+					nextPRBuild, err = prJob.CreateBuild()
+					Expect(err).NotTo(HaveOccurred())
+
+					nextOtherBuild, err = otherJob.CreateBuild()
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = admit(buildQueue, nextPRBuild)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("admits builds of the heavier team ahead of higher priority builds", func() {
+					admission, err := admit(buildQueue, nextOtherBuild)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(admission.Admitted).To(BeFalse())
+
+					admission, err = admit(buildQueue, nextPRBuild)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(admission.Admitted).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("Withdraw", func() {
+		var buildQueue db.BuildQueue
+
+		BeforeEach(func() {
+			buildQueue = db.NewBuildQueue(dbConn, lockFactory, 1)
+
+			_, err := admit(buildQueue, releaseBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := releaseBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(releaseBuild.IsQueued()).To(BeTrue())
+		})
+
+		It("takes the build out of the queue", func() {
+			err := buildQueue.Withdraw(releaseBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := releaseBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(releaseBuild.IsQueued()).To(BeFalse())
+		})
+
+		It("no longer holds up the builds behind it", func() {
+			err := buildQueue.Withdraw(releaseBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			admission, err := admit(buildQueue, prBuild)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.Admitted).To(BeTrue())
+		})
+
+		It("takes the build out of the queue once it is scheduled", func() {
+			_, err := releaseBuild.Schedule()
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := releaseBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(releaseBuild.IsQueued()).To(BeFalse())
+		})
+
+		It("takes the build out of the queue once it is aborted", func() {
+			err := releaseBuild.MarkAsAborted()
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := releaseBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(releaseBuild.IsQueued()).To(BeFalse())
+		})
+
+		It("takes the build out of the queue once it finishes", func() {
+			err := releaseBuild.Finish(db.BuildStatusErrored)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := releaseBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(releaseBuild.IsQueued()).To(BeFalse())
+		})
+	})
+
+	Describe("PendingBuilds", func() {
+		var buildQueue db.BuildQueue
+
+		BeforeEach(func() {
+			buildQueue = db.NewBuildQueue(dbConn, lockFactory, 1)
+		})
+
+		It("returns pending builds in priority order, with positions for queued builds", func() {
+			_, err := admit(buildQueue, prBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			queuedBuilds, err := buildQueue.PendingBuilds([]string{defaultTeam.Name()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queuedBuilds).To(HaveLen(2))
+
+			Expect(queuedBuilds[0].Build.ID()).To(Equal(releaseBuild.ID()))
+			Expect(queuedBuilds[0].Position).To(Equal(0))
+
+			Expect(queuedBuilds[1].Build.ID()).To(Equal(prBuild.ID()))
+			Expect(queuedBuilds[1].Position).To(Equal(1))
+		})
+
+		It("does not return builds of other teams' private pipelines", func() {
+			queuedBuilds, err := buildQueue.PendingBuilds([]string{"some-other-team"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queuedBuilds).To(BeEmpty())
+		})
+	})
+})
+
+// admit admits the build with the usage of the build queue at the time.
+func admit(buildQueue db.BuildQueue, build db.Build) (db.Admission, error) {
+	usage, err := buildQueue.Usage()
+	if err != nil {
+		return db.Admission{}, err
+	}
+
+	return buildQueue.Admit(build, usage)
+}
@@ -121,6 +121,11 @@ func (container *creatingContainer) Failed() (FailedContainer, error) {
 		return nil, ErrContainerDisappeared
 	}
 
+	err = closeContainerUsage(container.conn, container.handle)
+	if err != nil {
+		return nil, err
+	}
+
 	return newFailedContainer(
 		container.id,
 		container.handle,
@@ -202,6 +207,11 @@ func (container *createdContainer) Destroying() (DestroyingContainer, error) {
 		return nil, err
 	}
 
+	err = closeContainerUsage(container.conn, container.handle)
+	if err != nil {
+		return nil, err
+	}
+
 	return newDestroyingContainer(
 		container.id,
 		container.handle,
@@ -238,6 +248,11 @@ func (container *createdContainer) Discontinue() (DestroyingContainer, error) {
 		return nil, ErrContainerDisappeared
 	}
 
+	err = closeContainerUsage(container.conn, container.handle)
+	if err != nil {
+		return nil, err
+	}
+
 	return newDestroyingContainer(
 		container.id,
 		container.handle,
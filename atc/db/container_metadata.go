@@ -18,6 +18,11 @@ type ContainerMetadata struct {
 	PipelineName string
 	JobName      string
 	BuildName    string
+
+	// CPULimit and MemoryLimit are only recorded for usage accounting; they
+	// are not stored with the container or used to look it up.
+	CPULimit    uint64
+	MemoryLimit uint64
 }
 
 type ContainerType string
@@ -41,6 +41,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	CreateTimeStub        func() time.Time
+	createTimeMutex       sync.RWMutex
+	createTimeArgsForCall []struct {
+	}
+	createTimeReturns struct {
+		result1 time.Time
+	}
+	createTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -140,6 +150,16 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputOverridesStub        func() atc.InputOverrides
+	inputOverridesMutex       sync.RWMutex
+	inputOverridesArgsForCall []struct {
+	}
+	inputOverridesReturns struct {
+		result1 atc.InputOverrides
+	}
+	inputOverridesReturnsOnCall map[int]struct {
+		result1 atc.InputOverrides
+	}
 	InterceptibleStub        func() (bool, error)
 	interceptibleMutex       sync.RWMutex
 	interceptibleArgsForCall []struct {
@@ -172,6 +192,16 @@ type FakeBuild struct {
 	isManuallyTriggeredReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsQueuedStub        func() bool
+	isQueuedMutex       sync.RWMutex
+	isQueuedArgsForCall []struct {
+	}
+	isQueuedReturns struct {
+		result1 bool
+	}
+	isQueuedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsRunningStub        func() bool
 	isRunningMutex       sync.RWMutex
 	isRunningArgsForCall []struct {
@@ -212,6 +242,16 @@ type FakeBuild struct {
 	jobNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LogsArchivedStub        func() bool
+	logsArchivedMutex       sync.RWMutex
+	logsArchivedArgsForCall []struct {
+	}
+	logsArchivedReturns struct {
+		result1 bool
+	}
+	logsArchivedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	MarkAsAbortedStub        func() error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
@@ -222,6 +262,16 @@ type FakeBuild struct {
 	markAsAbortedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkLogsArchivedStub        func() error
+	markLogsArchivedMutex       sync.RWMutex
+	markLogsArchivedArgsForCall []struct {
+	}
+	markLogsArchivedReturns struct {
+		result1 error
+	}
+	markLogsArchivedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -280,6 +330,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PublicPlanStub        func() *json.RawMessage
 	publicPlanMutex       sync.RWMutex
 	publicPlanArgsForCall []struct {
@@ -312,6 +372,26 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RerunNumberStub        func() int
+	rerunNumberMutex       sync.RWMutex
+	rerunNumberArgsForCall []struct {
+	}
+	rerunNumberReturns struct {
+		result1 int
+	}
+	rerunNumberReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RerunOfStub        func() int
+	rerunOfMutex       sync.RWMutex
+	rerunOfArgsForCall []struct {
+	}
+	rerunOfReturns struct {
+		result1 int
+	}
+	rerunOfReturnsOnCall map[int]struct {
+		result1 int
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -597,6 +677,48 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) CreateTime() time.Time {
+	fake.createTimeMutex.Lock()
+	ret, specificReturn := fake.createTimeReturnsOnCall[len(fake.createTimeArgsForCall)]
+	fake.createTimeArgsForCall = append(fake.createTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateTime", []interface{}{})
+	fake.createTimeMutex.Unlock()
+	if fake.CreateTimeStub != nil {
+		return fake.CreateTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreateTimeCallCount() int {
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	return len(fake.createTimeArgsForCall)
+}
+
+func (fake *FakeBuild) CreateTimeReturns(result1 time.Time) {
+	fake.CreateTimeStub = nil
+	fake.createTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.CreateTimeStub = nil
+	if fake.createTimeReturnsOnCall == nil {
+		fake.createTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1008,6 +1130,48 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) InputOverrides() atc.InputOverrides {
+	fake.inputOverridesMutex.Lock()
+	ret, specificReturn := fake.inputOverridesReturnsOnCall[len(fake.inputOverridesArgsForCall)]
+	fake.inputOverridesArgsForCall = append(fake.inputOverridesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InputOverrides", []interface{}{})
+	fake.inputOverridesMutex.Unlock()
+	if fake.InputOverridesStub != nil {
+		return fake.InputOverridesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.inputOverridesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) InputOverridesCallCount() int {
+	fake.inputOverridesMutex.RLock()
+	defer fake.inputOverridesMutex.RUnlock()
+	return len(fake.inputOverridesArgsForCall)
+}
+
+func (fake *FakeBuild) InputOverridesReturns(result1 atc.InputOverrides) {
+	fake.InputOverridesStub = nil
+	fake.inputOverridesReturns = struct {
+		result1 atc.InputOverrides
+	}{result1}
+}
+
+func (fake *FakeBuild) InputOverridesReturnsOnCall(i int, result1 atc.InputOverrides) {
+	fake.InputOverridesStub = nil
+	if fake.inputOverridesReturnsOnCall == nil {
+		fake.inputOverridesReturnsOnCall = make(map[int]struct {
+			result1 atc.InputOverrides
+		})
+	}
+	fake.inputOverridesReturnsOnCall[i] = struct {
+		result1 atc.InputOverrides
+	}{result1}
+}
+
 func (fake *FakeBuild) Interceptible() (bool, error) {
 	fake.interceptibleMutex.Lock()
 	ret, specificReturn := fake.interceptibleReturnsOnCall[len(fake.interceptibleArgsForCall)]
@@ -1137,6 +1301,48 @@ func (fake *FakeBuild) IsManuallyTriggeredReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeBuild) IsQueued() bool {
+	fake.isQueuedMutex.Lock()
+	ret, specificReturn := fake.isQueuedReturnsOnCall[len(fake.isQueuedArgsForCall)]
+	fake.isQueuedArgsForCall = append(fake.isQueuedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsQueued", []interface{}{})
+	fake.isQueuedMutex.Unlock()
+	if fake.IsQueuedStub != nil {
+		return fake.IsQueuedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isQueuedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) IsQueuedCallCount() int {
+	fake.isQueuedMutex.RLock()
+	defer fake.isQueuedMutex.RUnlock()
+	return len(fake.isQueuedArgsForCall)
+}
+
+func (fake *FakeBuild) IsQueuedReturns(result1 bool) {
+	fake.IsQueuedStub = nil
+	fake.isQueuedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) IsQueuedReturnsOnCall(i int, result1 bool) {
+	fake.IsQueuedStub = nil
+	if fake.isQueuedReturnsOnCall == nil {
+		fake.isQueuedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isQueuedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) IsRunning() bool {
 	fake.isRunningMutex.Lock()
 	ret, specificReturn := fake.isRunningReturnsOnCall[len(fake.isRunningArgsForCall)]
@@ -1305,6 +1511,48 @@ func (fake *FakeBuild) JobNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) LogsArchived() bool {
+	fake.logsArchivedMutex.Lock()
+	ret, specificReturn := fake.logsArchivedReturnsOnCall[len(fake.logsArchivedArgsForCall)]
+	fake.logsArchivedArgsForCall = append(fake.logsArchivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LogsArchived", []interface{}{})
+	fake.logsArchivedMutex.Unlock()
+	if fake.LogsArchivedStub != nil {
+		return fake.LogsArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.logsArchivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) LogsArchivedCallCount() int {
+	fake.logsArchivedMutex.RLock()
+	defer fake.logsArchivedMutex.RUnlock()
+	return len(fake.logsArchivedArgsForCall)
+}
+
+func (fake *FakeBuild) LogsArchivedReturns(result1 bool) {
+	fake.LogsArchivedStub = nil
+	fake.logsArchivedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) LogsArchivedReturnsOnCall(i int, result1 bool) {
+	fake.LogsArchivedStub = nil
+	if fake.logsArchivedReturnsOnCall == nil {
+		fake.logsArchivedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.logsArchivedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) MarkAsAborted() error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
@@ -1347,6 +1595,48 @@ func (fake *FakeBuild) MarkAsAbortedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) MarkLogsArchived() error {
+	fake.markLogsArchivedMutex.Lock()
+	ret, specificReturn := fake.markLogsArchivedReturnsOnCall[len(fake.markLogsArchivedArgsForCall)]
+	fake.markLogsArchivedArgsForCall = append(fake.markLogsArchivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MarkLogsArchived", []interface{}{})
+	fake.markLogsArchivedMutex.Unlock()
+	if fake.MarkLogsArchivedStub != nil {
+		return fake.MarkLogsArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markLogsArchivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) MarkLogsArchivedCallCount() int {
+	fake.markLogsArchivedMutex.RLock()
+	defer fake.markLogsArchivedMutex.RUnlock()
+	return len(fake.markLogsArchivedArgsForCall)
+}
+
+func (fake *FakeBuild) MarkLogsArchivedReturns(result1 error) {
+	fake.MarkLogsArchivedStub = nil
+	fake.markLogsArchivedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) MarkLogsArchivedReturnsOnCall(i int, result1 error) {
+	fake.MarkLogsArchivedStub = nil
+	if fake.markLogsArchivedReturnsOnCall == nil {
+		fake.markLogsArchivedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markLogsArchivedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1569,6 +1859,48 @@ func (fake *FakeBuild) PreparationReturnsOnCall(i int, result1 db.BuildPreparati
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if fake.PriorityStub != nil {
+		return fake.PriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.priorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeBuild) PriorityReturns(result1 int) {
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) PriorityReturnsOnCall(i int, result1 int) {
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) PublicPlan() *json.RawMessage {
 	fake.publicPlanMutex.Lock()
 	ret, specificReturn := fake.publicPlanReturnsOnCall[len(fake.publicPlanArgsForCall)]
@@ -1698,6 +2030,90 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RerunNumber() int {
+	fake.rerunNumberMutex.Lock()
+	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
+	fake.rerunNumberArgsForCall = append(fake.rerunNumberArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunNumber", []interface{}{})
+	fake.rerunNumberMutex.Unlock()
+	if fake.RerunNumberStub != nil {
+		return fake.RerunNumberStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunNumberReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunNumberCallCount() int {
+	fake.rerunNumberMutex.RLock()
+	defer fake.rerunNumberMutex.RUnlock()
+	return len(fake.rerunNumberArgsForCall)
+}
+
+func (fake *FakeBuild) RerunNumberReturns(result1 int) {
+	fake.RerunNumberStub = nil
+	fake.rerunNumberReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunNumberReturnsOnCall(i int, result1 int) {
+	fake.RerunNumberStub = nil
+	if fake.rerunNumberReturnsOnCall == nil {
+		fake.rerunNumberReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunNumberReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOf() int {
+	fake.rerunOfMutex.Lock()
+	ret, specificReturn := fake.rerunOfReturnsOnCall[len(fake.rerunOfArgsForCall)]
+	fake.rerunOfArgsForCall = append(fake.rerunOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOf", []interface{}{})
+	fake.rerunOfMutex.Unlock()
+	if fake.RerunOfStub != nil {
+		return fake.RerunOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfCallCount() int {
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	return len(fake.rerunOfArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfReturns(result1 int) {
+	fake.RerunOfStub = nil
+	fake.rerunOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfReturnsOnCall(i int, result1 int) {
+	fake.RerunOfStub = nil
+	if fake.rerunOfReturnsOnCall == nil {
+		fake.rerunOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -2468,6 +2884,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -2486,12 +2904,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.getVersionedResourcesMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputOverridesMutex.RLock()
+	defer fake.inputOverridesMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
 	defer fake.interceptibleMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
 	defer fake.isDrainedMutex.RUnlock()
 	fake.isManuallyTriggeredMutex.RLock()
 	defer fake.isManuallyTriggeredMutex.RUnlock()
+	fake.isQueuedMutex.RLock()
+	defer fake.isQueuedMutex.RUnlock()
 	fake.isRunningMutex.RLock()
 	defer fake.isRunningMutex.RUnlock()
 	fake.isScheduledMutex.RLock()
@@ -2500,8 +2922,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobIDMutex.RUnlock()
 	fake.jobNameMutex.RLock()
 	defer fake.jobNameMutex.RUnlock()
+	fake.logsArchivedMutex.RLock()
+	defer fake.logsArchivedMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
+	fake.markLogsArchivedMutex.RLock()
+	defer fake.markLogsArchivedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pipelineMutex.RLock()
@@ -2512,12 +2938,18 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunNumberMutex.RLock()
+	defer fake.rerunNumberMutex.RUnlock()
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
 	fake.saveEventMutex.RLock()
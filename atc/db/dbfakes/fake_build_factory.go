@@ -23,6 +23,19 @@ type FakeBuildFactory struct {
 		result2 bool
 		result3 error
 	}
+	BuildsBlockingWorkerStub        func(string) ([]db.Build, error)
+	buildsBlockingWorkerMutex       sync.RWMutex
+	buildsBlockingWorkerArgsForCall []struct {
+		arg1 string
+	}
+	buildsBlockingWorkerReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	buildsBlockingWorkerReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	GetAllStartedBuildsStub        func() ([]db.Build, error)
 	getAllStartedBuildsMutex       sync.RWMutex
 	getAllStartedBuildsArgsForCall []struct {
@@ -148,6 +161,59 @@ func (fake *FakeBuildFactory) BuildReturnsOnCall(i int, result1 db.Build, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) BuildsBlockingWorker(arg1 string) ([]db.Build, error) {
+	fake.buildsBlockingWorkerMutex.Lock()
+	ret, specificReturn := fake.buildsBlockingWorkerReturnsOnCall[len(fake.buildsBlockingWorkerArgsForCall)]
+	fake.buildsBlockingWorkerArgsForCall = append(fake.buildsBlockingWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("BuildsBlockingWorker", []interface{}{arg1})
+	fake.buildsBlockingWorkerMutex.Unlock()
+	if fake.BuildsBlockingWorkerStub != nil {
+		return fake.BuildsBlockingWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildsBlockingWorkerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) BuildsBlockingWorkerCallCount() int {
+	fake.buildsBlockingWorkerMutex.RLock()
+	defer fake.buildsBlockingWorkerMutex.RUnlock()
+	return len(fake.buildsBlockingWorkerArgsForCall)
+}
+
+func (fake *FakeBuildFactory) BuildsBlockingWorkerArgsForCall(i int) string {
+	fake.buildsBlockingWorkerMutex.RLock()
+	defer fake.buildsBlockingWorkerMutex.RUnlock()
+	argsForCall := fake.buildsBlockingWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) BuildsBlockingWorkerReturns(result1 []db.Build, result2 error) {
+	fake.BuildsBlockingWorkerStub = nil
+	fake.buildsBlockingWorkerReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) BuildsBlockingWorkerReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.BuildsBlockingWorkerStub = nil
+	if fake.buildsBlockingWorkerReturnsOnCall == nil {
+		fake.buildsBlockingWorkerReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.buildsBlockingWorkerReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetAllStartedBuilds() ([]db.Build, error) {
 	fake.getAllStartedBuildsMutex.Lock()
 	ret, specificReturn := fake.getAllStartedBuildsReturnsOnCall[len(fake.getAllStartedBuildsArgsForCall)]
@@ -403,6 +469,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
+	fake.buildsBlockingWorkerMutex.RLock()
+	defer fake.buildsBlockingWorkerMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
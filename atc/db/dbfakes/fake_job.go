@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	sync "sync"
+	time "time"
 
 	atc "github.com/concourse/concourse/atc"
 	db "github.com/concourse/concourse/atc/db"
@@ -76,6 +77,35 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateBuildWithInputOverridesStub        func(atc.InputOverrides) (db.Build, error)
+	createBuildWithInputOverridesMutex       sync.RWMutex
+	createBuildWithInputOverridesArgsForCall []struct {
+		arg1 atc.InputOverrides
+	}
+	createBuildWithInputOverridesReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	createBuildWithInputOverridesReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
+	CreateScheduledBuildStub        func(time.Time, time.Time) (db.Build, bool, error)
+	createScheduledBuildMutex       sync.RWMutex
+	createScheduledBuildArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	createScheduledBuildReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	createScheduledBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	DeleteNextInputMappingStub        func() error
 	deleteNextInputMappingMutex       sync.RWMutex
 	deleteNextInputMappingArgsForCall []struct {
@@ -196,6 +226,40 @@ type FakeJob struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InitializeLastScheduledStub        func(time.Time) error
+	initializeLastScheduledMutex       sync.RWMutex
+	initializeLastScheduledArgsForCall []struct {
+		arg1 time.Time
+	}
+	initializeLastScheduledReturns struct {
+		result1 error
+	}
+	initializeLastScheduledReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LastScheduledStub        func() time.Time
+	lastScheduledMutex       sync.RWMutex
+	lastScheduledArgsForCall []struct {
+	}
+	lastScheduledReturns struct {
+		result1 time.Time
+	}
+	lastScheduledReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	LatestSucceededBuildsStub        func(int) ([]db.Build, error)
+	latestSucceededBuildsMutex       sync.RWMutex
+	latestSucceededBuildsArgsForCall []struct {
+		arg1 int
+	}
+	latestSucceededBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	latestSucceededBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -258,6 +322,19 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	RerunBuildStub        func(db.Build) (db.Build, error)
+	rerunBuildMutex       sync.RWMutex
+	rerunBuildArgsForCall []struct {
+		arg1 db.Build
+	}
+	rerunBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	rerunBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	SaveIndependentInputMappingStub        func(algorithm.InputMapping) error
 	saveIndependentInputMappingMutex       sync.RWMutex
 	saveIndependentInputMappingArgsForCall []struct {
@@ -280,6 +357,29 @@ type FakeJob struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveUnsatisfiedInputsStub        func(algorithm.UnsatisfiedInputs) error
+	saveUnsatisfiedInputsMutex       sync.RWMutex
+	saveUnsatisfiedInputsArgsForCall []struct {
+		arg1 algorithm.UnsatisfiedInputs
+	}
+	saveUnsatisfiedInputsReturns struct {
+		result1 error
+	}
+	saveUnsatisfiedInputsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SchedulingStatusStub        func() (db.JobSchedulingStatus, error)
+	schedulingStatusMutex       sync.RWMutex
+	schedulingStatusArgsForCall []struct {
+	}
+	schedulingStatusReturns struct {
+		result1 db.JobSchedulingStatus
+		result2 error
+	}
+	schedulingStatusReturnsOnCall map[int]struct {
+		result1 db.JobSchedulingStatus
+		result2 error
+	}
 	SetMaxInFlightReachedStub        func(bool) error
 	setMaxInFlightReachedMutex       sync.RWMutex
 	setMaxInFlightReachedArgsForCall []struct {
@@ -599,6 +699,116 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateBuildWithInputOverrides(arg1 atc.InputOverrides) (db.Build, error) {
+	fake.createBuildWithInputOverridesMutex.Lock()
+	ret, specificReturn := fake.createBuildWithInputOverridesReturnsOnCall[len(fake.createBuildWithInputOverridesArgsForCall)]
+	fake.createBuildWithInputOverridesArgsForCall = append(fake.createBuildWithInputOverridesArgsForCall, struct {
+		arg1 atc.InputOverrides
+	}{arg1})
+	fake.recordInvocation("CreateBuildWithInputOverrides", []interface{}{arg1})
+	fake.createBuildWithInputOverridesMutex.Unlock()
+	if fake.CreateBuildWithInputOverridesStub != nil {
+		return fake.CreateBuildWithInputOverridesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createBuildWithInputOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesCallCount() int {
+	fake.createBuildWithInputOverridesMutex.RLock()
+	defer fake.createBuildWithInputOverridesMutex.RUnlock()
+	return len(fake.createBuildWithInputOverridesArgsForCall)
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesArgsForCall(i int) atc.InputOverrides {
+	fake.createBuildWithInputOverridesMutex.RLock()
+	defer fake.createBuildWithInputOverridesMutex.RUnlock()
+	argsForCall := fake.createBuildWithInputOverridesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesReturns(result1 db.Build, result2 error) {
+	fake.CreateBuildWithInputOverridesStub = nil
+	fake.createBuildWithInputOverridesReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.CreateBuildWithInputOverridesStub = nil
+	if fake.createBuildWithInputOverridesReturnsOnCall == nil {
+		fake.createBuildWithInputOverridesReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.createBuildWithInputOverridesReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateScheduledBuild(arg1 time.Time, arg2 time.Time) (db.Build, bool, error) {
+	fake.createScheduledBuildMutex.Lock()
+	ret, specificReturn := fake.createScheduledBuildReturnsOnCall[len(fake.createScheduledBuildArgsForCall)]
+	fake.createScheduledBuildArgsForCall = append(fake.createScheduledBuildArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("CreateScheduledBuild", []interface{}{arg1, arg2})
+	fake.createScheduledBuildMutex.Unlock()
+	if fake.CreateScheduledBuildStub != nil {
+		return fake.CreateScheduledBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createScheduledBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) CreateScheduledBuildCallCount() int {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	return len(fake.createScheduledBuildArgsForCall)
+}
+
+func (fake *FakeJob) CreateScheduledBuildArgsForCall(i int) (time.Time, time.Time) {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	argsForCall := fake.createScheduledBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.CreateScheduledBuildStub = nil
+	fake.createScheduledBuildReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.CreateScheduledBuildStub = nil
+	if fake.createScheduledBuildReturnsOnCall == nil {
+		fake.createScheduledBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createScheduledBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) DeleteNextInputMapping() error {
 	fake.deleteNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.deleteNextInputMappingReturnsOnCall[len(fake.deleteNextInputMappingArgsForCall)]
@@ -1072,6 +1282,151 @@ func (fake *FakeJob) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) InitializeLastScheduled(arg1 time.Time) error {
+	fake.initializeLastScheduledMutex.Lock()
+	ret, specificReturn := fake.initializeLastScheduledReturnsOnCall[len(fake.initializeLastScheduledArgsForCall)]
+	fake.initializeLastScheduledArgsForCall = append(fake.initializeLastScheduledArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("InitializeLastScheduled", []interface{}{arg1})
+	fake.initializeLastScheduledMutex.Unlock()
+	if fake.InitializeLastScheduledStub != nil {
+		return fake.InitializeLastScheduledStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.initializeLastScheduledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) InitializeLastScheduledCallCount() int {
+	fake.initializeLastScheduledMutex.RLock()
+	defer fake.initializeLastScheduledMutex.RUnlock()
+	return len(fake.initializeLastScheduledArgsForCall)
+}
+
+func (fake *FakeJob) InitializeLastScheduledArgsForCall(i int) time.Time {
+	fake.initializeLastScheduledMutex.RLock()
+	defer fake.initializeLastScheduledMutex.RUnlock()
+	argsForCall := fake.initializeLastScheduledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) InitializeLastScheduledReturns(result1 error) {
+	fake.InitializeLastScheduledStub = nil
+	fake.initializeLastScheduledReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) InitializeLastScheduledReturnsOnCall(i int, result1 error) {
+	fake.InitializeLastScheduledStub = nil
+	if fake.initializeLastScheduledReturnsOnCall == nil {
+		fake.initializeLastScheduledReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initializeLastScheduledReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) LastScheduled() time.Time {
+	fake.lastScheduledMutex.Lock()
+	ret, specificReturn := fake.lastScheduledReturnsOnCall[len(fake.lastScheduledArgsForCall)]
+	fake.lastScheduledArgsForCall = append(fake.lastScheduledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastScheduled", []interface{}{})
+	fake.lastScheduledMutex.Unlock()
+	if fake.LastScheduledStub != nil {
+		return fake.LastScheduledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastScheduledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) LastScheduledCallCount() int {
+	fake.lastScheduledMutex.RLock()
+	defer fake.lastScheduledMutex.RUnlock()
+	return len(fake.lastScheduledArgsForCall)
+}
+
+func (fake *FakeJob) LastScheduledReturns(result1 time.Time) {
+	fake.LastScheduledStub = nil
+	fake.lastScheduledReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) LastScheduledReturnsOnCall(i int, result1 time.Time) {
+	fake.LastScheduledStub = nil
+	if fake.lastScheduledReturnsOnCall == nil {
+		fake.lastScheduledReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastScheduledReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) LatestSucceededBuilds(arg1 int) ([]db.Build, error) {
+	fake.latestSucceededBuildsMutex.Lock()
+	ret, specificReturn := fake.latestSucceededBuildsReturnsOnCall[len(fake.latestSucceededBuildsArgsForCall)]
+	fake.latestSucceededBuildsArgsForCall = append(fake.latestSucceededBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("LatestSucceededBuilds", []interface{}{arg1})
+	fake.latestSucceededBuildsMutex.Unlock()
+	if fake.LatestSucceededBuildsStub != nil {
+		return fake.LatestSucceededBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.latestSucceededBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) LatestSucceededBuildsCallCount() int {
+	fake.latestSucceededBuildsMutex.RLock()
+	defer fake.latestSucceededBuildsMutex.RUnlock()
+	return len(fake.latestSucceededBuildsArgsForCall)
+}
+
+func (fake *FakeJob) LatestSucceededBuildsArgsForCall(i int) int {
+	fake.latestSucceededBuildsMutex.RLock()
+	defer fake.latestSucceededBuildsMutex.RUnlock()
+	argsForCall := fake.latestSucceededBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) LatestSucceededBuildsReturns(result1 []db.Build, result2 error) {
+	fake.LatestSucceededBuildsStub = nil
+	fake.latestSucceededBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) LatestSucceededBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.LatestSucceededBuildsStub = nil
+	if fake.latestSucceededBuildsReturnsOnCall == nil {
+		fake.latestSucceededBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.latestSucceededBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1327,6 +1682,59 @@ func (fake *FakeJob) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+	fake.rerunBuildMutex.Lock()
+	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
+	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
+		arg1 db.Build
+	}{arg1})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+	fake.rerunBuildMutex.Unlock()
+	if fake.RerunBuildStub != nil {
+		return fake.RerunBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) RerunBuildCallCount() int {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	return len(fake.rerunBuildArgsForCall)
+}
+
+func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	argsForCall := fake.rerunBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
+	fake.RerunBuildStub = nil
+	fake.rerunBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.RerunBuildStub = nil
+	if fake.rerunBuildReturnsOnCall == nil {
+		fake.rerunBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.rerunBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveIndependentInputMapping(arg1 algorithm.InputMapping) error {
 	fake.saveIndependentInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveIndependentInputMappingReturnsOnCall[len(fake.saveIndependentInputMappingArgsForCall)]
@@ -1427,6 +1835,101 @@ func (fake *FakeJob) SaveNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) SaveUnsatisfiedInputs(arg1 algorithm.UnsatisfiedInputs) error {
+	fake.saveUnsatisfiedInputsMutex.Lock()
+	ret, specificReturn := fake.saveUnsatisfiedInputsReturnsOnCall[len(fake.saveUnsatisfiedInputsArgsForCall)]
+	fake.saveUnsatisfiedInputsArgsForCall = append(fake.saveUnsatisfiedInputsArgsForCall, struct {
+		arg1 algorithm.UnsatisfiedInputs
+	}{arg1})
+	fake.recordInvocation("SaveUnsatisfiedInputs", []interface{}{arg1})
+	fake.saveUnsatisfiedInputsMutex.Unlock()
+	if fake.SaveUnsatisfiedInputsStub != nil {
+		return fake.SaveUnsatisfiedInputsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveUnsatisfiedInputsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SaveUnsatisfiedInputsCallCount() int {
+	fake.saveUnsatisfiedInputsMutex.RLock()
+	defer fake.saveUnsatisfiedInputsMutex.RUnlock()
+	return len(fake.saveUnsatisfiedInputsArgsForCall)
+}
+
+func (fake *FakeJob) SaveUnsatisfiedInputsArgsForCall(i int) algorithm.UnsatisfiedInputs {
+	fake.saveUnsatisfiedInputsMutex.RLock()
+	defer fake.saveUnsatisfiedInputsMutex.RUnlock()
+	argsForCall := fake.saveUnsatisfiedInputsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SaveUnsatisfiedInputsReturns(result1 error) {
+	fake.SaveUnsatisfiedInputsStub = nil
+	fake.saveUnsatisfiedInputsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SaveUnsatisfiedInputsReturnsOnCall(i int, result1 error) {
+	fake.SaveUnsatisfiedInputsStub = nil
+	if fake.saveUnsatisfiedInputsReturnsOnCall == nil {
+		fake.saveUnsatisfiedInputsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveUnsatisfiedInputsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SchedulingStatus() (db.JobSchedulingStatus, error) {
+	fake.schedulingStatusMutex.Lock()
+	ret, specificReturn := fake.schedulingStatusReturnsOnCall[len(fake.schedulingStatusArgsForCall)]
+	fake.schedulingStatusArgsForCall = append(fake.schedulingStatusArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SchedulingStatus", []interface{}{})
+	fake.schedulingStatusMutex.Unlock()
+	if fake.SchedulingStatusStub != nil {
+		return fake.SchedulingStatusStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.schedulingStatusReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) SchedulingStatusCallCount() int {
+	fake.schedulingStatusMutex.RLock()
+	defer fake.schedulingStatusMutex.RUnlock()
+	return len(fake.schedulingStatusArgsForCall)
+}
+
+func (fake *FakeJob) SchedulingStatusReturns(result1 db.JobSchedulingStatus, result2 error) {
+	fake.SchedulingStatusStub = nil
+	fake.schedulingStatusReturns = struct {
+		result1 db.JobSchedulingStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) SchedulingStatusReturnsOnCall(i int, result1 db.JobSchedulingStatus, result2 error) {
+	fake.SchedulingStatusStub = nil
+	if fake.schedulingStatusReturnsOnCall == nil {
+		fake.schedulingStatusReturnsOnCall = make(map[int]struct {
+			result1 db.JobSchedulingStatus
+			result2 error
+		})
+	}
+	fake.schedulingStatusReturnsOnCall[i] = struct {
+		result1 db.JobSchedulingStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SetMaxInFlightReached(arg1 bool) error {
 	fake.setMaxInFlightReachedMutex.Lock()
 	ret, specificReturn := fake.setMaxInFlightReachedReturnsOnCall[len(fake.setMaxInFlightReachedArgsForCall)]
@@ -1708,6 +2211,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createBuildWithInputOverridesMutex.RLock()
+	defer fake.createBuildWithInputOverridesMutex.RUnlock()
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
 	fake.deleteNextInputMappingMutex.RLock()
 	defer fake.deleteNextInputMappingMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -1728,6 +2235,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.getRunningBuildsBySerialGroupMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.initializeLastScheduledMutex.RLock()
+	defer fake.initializeLastScheduledMutex.RUnlock()
+	fake.lastScheduledMutex.RLock()
+	defer fake.lastScheduledMutex.RUnlock()
+	fake.latestSucceededBuildsMutex.RLock()
+	defer fake.latestSucceededBuildsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -1740,10 +2253,16 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveIndependentInputMappingMutex.RLock()
 	defer fake.saveIndependentInputMappingMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
+	fake.saveUnsatisfiedInputsMutex.RLock()
+	defer fake.saveUnsatisfiedInputsMutex.RUnlock()
+	fake.schedulingStatusMutex.RLock()
+	defer fake.schedulingStatusMutex.RUnlock()
 	fake.setMaxInFlightReachedMutex.RLock()
 	defer fake.setMaxInFlightReachedMutex.RUnlock()
 	fake.tagsMutex.RLock()
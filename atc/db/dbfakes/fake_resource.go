@@ -10,6 +10,16 @@ import (
 )
 
 type FakeResource struct {
+	APIPinnedVersionStub        func() atc.Version
+	aPIPinnedVersionMutex       sync.RWMutex
+	aPIPinnedVersionArgsForCall []struct {
+	}
+	aPIPinnedVersionReturns struct {
+		result1 atc.Version
+	}
+	aPIPinnedVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+	}
 	CheckErrorStub        func() error
 	checkErrorMutex       sync.RWMutex
 	checkErrorArgsForCall []struct {
@@ -40,6 +50,16 @@ type FakeResource struct {
 	checkTimeoutReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CurrentPinnedVersionStub        func() atc.Version
+	currentPinnedVersionMutex       sync.RWMutex
+	currentPinnedVersionArgsForCall []struct {
+	}
+	currentPinnedVersionReturns struct {
+		result1 atc.Version
+	}
+	currentPinnedVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+	}
 	FailingToCheckStub        func() bool
 	failingToCheckMutex       sync.RWMutex
 	failingToCheckArgsForCall []struct {
@@ -100,6 +120,30 @@ type FakeResource struct {
 	pausedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PinCommentStub        func() string
+	pinCommentMutex       sync.RWMutex
+	pinCommentArgsForCall []struct {
+	}
+	pinCommentReturns struct {
+		result1 string
+	}
+	pinCommentReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PinVersionStub        func(int, string) (bool, error)
+	pinVersionMutex       sync.RWMutex
+	pinVersionArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	pinVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	pinVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PinnedVersionStub        func() atc.Version
 	pinnedVersionMutex       sync.RWMutex
 	pinnedVersionArgsForCall []struct {
@@ -193,6 +237,16 @@ type FakeResource struct {
 	unpauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UnpinVersionStub        func() error
+	unpinVersionMutex       sync.RWMutex
+	unpinVersionArgsForCall []struct {
+	}
+	unpinVersionReturns struct {
+		result1 error
+	}
+	unpinVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -207,6 +261,48 @@ type FakeResource struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeResource) APIPinnedVersion() atc.Version {
+	fake.aPIPinnedVersionMutex.Lock()
+	ret, specificReturn := fake.aPIPinnedVersionReturnsOnCall[len(fake.aPIPinnedVersionArgsForCall)]
+	fake.aPIPinnedVersionArgsForCall = append(fake.aPIPinnedVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APIPinnedVersion", []interface{}{})
+	fake.aPIPinnedVersionMutex.Unlock()
+	if fake.APIPinnedVersionStub != nil {
+		return fake.APIPinnedVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.aPIPinnedVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) APIPinnedVersionCallCount() int {
+	fake.aPIPinnedVersionMutex.RLock()
+	defer fake.aPIPinnedVersionMutex.RUnlock()
+	return len(fake.aPIPinnedVersionArgsForCall)
+}
+
+func (fake *FakeResource) APIPinnedVersionReturns(result1 atc.Version) {
+	fake.APIPinnedVersionStub = nil
+	fake.aPIPinnedVersionReturns = struct {
+		result1 atc.Version
+	}{result1}
+}
+
+func (fake *FakeResource) APIPinnedVersionReturnsOnCall(i int, result1 atc.Version) {
+	fake.APIPinnedVersionStub = nil
+	if fake.aPIPinnedVersionReturnsOnCall == nil {
+		fake.aPIPinnedVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+		})
+	}
+	fake.aPIPinnedVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+	}{result1}
+}
+
 func (fake *FakeResource) CheckError() error {
 	fake.checkErrorMutex.Lock()
 	ret, specificReturn := fake.checkErrorReturnsOnCall[len(fake.checkErrorArgsForCall)]
@@ -333,6 +429,48 @@ func (fake *FakeResource) CheckTimeoutReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) CurrentPinnedVersion() atc.Version {
+	fake.currentPinnedVersionMutex.Lock()
+	ret, specificReturn := fake.currentPinnedVersionReturnsOnCall[len(fake.currentPinnedVersionArgsForCall)]
+	fake.currentPinnedVersionArgsForCall = append(fake.currentPinnedVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentPinnedVersion", []interface{}{})
+	fake.currentPinnedVersionMutex.Unlock()
+	if fake.CurrentPinnedVersionStub != nil {
+		return fake.CurrentPinnedVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.currentPinnedVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CurrentPinnedVersionCallCount() int {
+	fake.currentPinnedVersionMutex.RLock()
+	defer fake.currentPinnedVersionMutex.RUnlock()
+	return len(fake.currentPinnedVersionArgsForCall)
+}
+
+func (fake *FakeResource) CurrentPinnedVersionReturns(result1 atc.Version) {
+	fake.CurrentPinnedVersionStub = nil
+	fake.currentPinnedVersionReturns = struct {
+		result1 atc.Version
+	}{result1}
+}
+
+func (fake *FakeResource) CurrentPinnedVersionReturnsOnCall(i int, result1 atc.Version) {
+	fake.CurrentPinnedVersionStub = nil
+	if fake.currentPinnedVersionReturnsOnCall == nil {
+		fake.currentPinnedVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+		})
+	}
+	fake.currentPinnedVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+	}{result1}
+}
+
 func (fake *FakeResource) FailingToCheck() bool {
 	fake.failingToCheckMutex.Lock()
 	ret, specificReturn := fake.failingToCheckReturnsOnCall[len(fake.failingToCheckArgsForCall)]
@@ -585,6 +723,102 @@ func (fake *FakeResource) PausedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeResource) PinComment() string {
+	fake.pinCommentMutex.Lock()
+	ret, specificReturn := fake.pinCommentReturnsOnCall[len(fake.pinCommentArgsForCall)]
+	fake.pinCommentArgsForCall = append(fake.pinCommentArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinComment", []interface{}{})
+	fake.pinCommentMutex.Unlock()
+	if fake.PinCommentStub != nil {
+		return fake.PinCommentStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinCommentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinCommentCallCount() int {
+	fake.pinCommentMutex.RLock()
+	defer fake.pinCommentMutex.RUnlock()
+	return len(fake.pinCommentArgsForCall)
+}
+
+func (fake *FakeResource) PinCommentReturns(result1 string) {
+	fake.PinCommentStub = nil
+	fake.pinCommentReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinCommentReturnsOnCall(i int, result1 string) {
+	fake.PinCommentStub = nil
+	if fake.pinCommentReturnsOnCall == nil {
+		fake.pinCommentReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pinCommentReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinVersion(arg1 int, arg2 string) (bool, error) {
+	fake.pinVersionMutex.Lock()
+	ret, specificReturn := fake.pinVersionReturnsOnCall[len(fake.pinVersionArgsForCall)]
+	fake.pinVersionArgsForCall = append(fake.pinVersionArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("PinVersion", []interface{}{arg1, arg2})
+	fake.pinVersionMutex.Unlock()
+	if fake.PinVersionStub != nil {
+		return fake.PinVersionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pinVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) PinVersionCallCount() int {
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	return len(fake.pinVersionArgsForCall)
+}
+
+func (fake *FakeResource) PinVersionArgsForCall(i int) (int, string) {
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	argsForCall := fake.pinVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) PinVersionReturns(result1 bool, result2 error) {
+	fake.PinVersionStub = nil
+	fake.pinVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) PinVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.PinVersionStub = nil
+	if fake.pinVersionReturnsOnCall == nil {
+		fake.pinVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pinVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) PinnedVersion() atc.Version {
 	fake.pinnedVersionMutex.Lock()
 	ret, specificReturn := fake.pinnedVersionReturnsOnCall[len(fake.pinnedVersionArgsForCall)]
@@ -974,6 +1208,48 @@ func (fake *FakeResource) UnpauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) UnpinVersion() error {
+	fake.unpinVersionMutex.Lock()
+	ret, specificReturn := fake.unpinVersionReturnsOnCall[len(fake.unpinVersionArgsForCall)]
+	fake.unpinVersionArgsForCall = append(fake.unpinVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UnpinVersion", []interface{}{})
+	fake.unpinVersionMutex.Unlock()
+	if fake.UnpinVersionStub != nil {
+		return fake.UnpinVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unpinVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) UnpinVersionCallCount() int {
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
+	return len(fake.unpinVersionArgsForCall)
+}
+
+func (fake *FakeResource) UnpinVersionReturns(result1 error) {
+	fake.UnpinVersionStub = nil
+	fake.unpinVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) UnpinVersionReturnsOnCall(i int, result1 error) {
+	fake.UnpinVersionStub = nil
+	if fake.unpinVersionReturnsOnCall == nil {
+		fake.unpinVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unpinVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -1019,12 +1295,16 @@ func (fake *FakeResource) WebhookTokenReturnsOnCall(i int, result1 string) {
 func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPIPinnedVersionMutex.RLock()
+	defer fake.aPIPinnedVersionMutex.RUnlock()
 	fake.checkErrorMutex.RLock()
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
 	defer fake.checkTimeoutMutex.RUnlock()
+	fake.currentPinnedVersionMutex.RLock()
+	defer fake.currentPinnedVersionMutex.RUnlock()
 	fake.failingToCheckMutex.RLock()
 	defer fake.failingToCheckMutex.RUnlock()
 	fake.iDMutex.RLock()
@@ -1037,6 +1317,10 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
+	fake.pinCommentMutex.RLock()
+	defer fake.pinCommentMutex.RUnlock()
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
 	fake.pinnedVersionMutex.RLock()
 	defer fake.pinnedVersionMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
@@ -1055,6 +1339,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.typeMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -32,6 +32,16 @@ type FakeTeam struct {
 	authReturnsOnCall map[int]struct {
 		result1 atc.TeamAuth
 	}
+	BuildWeightStub        func() int
+	buildWeightMutex       sync.RWMutex
+	buildWeightArgsForCall []struct {
+	}
+	buildWeightReturns struct {
+		result1 int
+	}
+	buildWeightReturnsOnCall map[int]struct {
+		result1 int
+	}
 	BuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -84,11 +94,11 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(lager.Logger, string, string, creds.VariablesFactory) ([]db.Container, error)
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) ([]db.Container, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.VariablesFactory
 	}
@@ -199,6 +209,16 @@ type FakeTeam struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	MaxConcurrentBuildsStub        func() int
+	maxConcurrentBuildsMutex       sync.RWMutex
+	maxConcurrentBuildsArgsForCall []struct {
+	}
+	maxConcurrentBuildsReturns struct {
+		result1 int
+	}
+	maxConcurrentBuildsReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -220,10 +240,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -285,10 +305,10 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 db.PipelinePausedState
@@ -317,6 +337,18 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	UpdateBuildQuotaStub        func(int, int) error
+	updateBuildQuotaMutex       sync.RWMutex
+	updateBuildQuotaArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	updateBuildQuotaReturns struct {
+		result1 error
+	}
+	updateBuildQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -328,6 +360,21 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UsageStub        func(time.Time, time.Time, db.UsageGrouping) ([]db.Usage, error)
+	usageMutex       sync.RWMutex
+	usageArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+		arg3 db.UsageGrouping
+	}
+	usageReturns struct {
+		result1 []db.Usage
+		result2 error
+	}
+	usageReturnsOnCall map[int]struct {
+		result1 []db.Usage
+		result2 error
+	}
 	VisiblePipelinesStub        func() ([]db.Pipeline, error)
 	visiblePipelinesMutex       sync.RWMutex
 	visiblePipelinesArgsForCall []struct {
@@ -440,6 +487,48 @@ func (fake *FakeTeam) AuthReturnsOnCall(i int, result1 atc.TeamAuth) {
 	}{result1}
 }
 
+func (fake *FakeTeam) BuildWeight() int {
+	fake.buildWeightMutex.Lock()
+	ret, specificReturn := fake.buildWeightReturnsOnCall[len(fake.buildWeightArgsForCall)]
+	fake.buildWeightArgsForCall = append(fake.buildWeightArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BuildWeight", []interface{}{})
+	fake.buildWeightMutex.Unlock()
+	if fake.BuildWeightStub != nil {
+		return fake.BuildWeightStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.buildWeightReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) BuildWeightCallCount() int {
+	fake.buildWeightMutex.RLock()
+	defer fake.buildWeightMutex.RUnlock()
+	return len(fake.buildWeightArgsForCall)
+}
+
+func (fake *FakeTeam) BuildWeightReturns(result1 int) {
+	fake.BuildWeightStub = nil
+	fake.buildWeightReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeam) BuildWeightReturnsOnCall(i int, result1 int) {
+	fake.BuildWeightStub = nil
+	if fake.buildWeightReturnsOnCall == nil {
+		fake.buildWeightReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.buildWeightReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeTeam) Builds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -638,12 +727,12 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.VariablesFactory) ([]db.Container, error) {
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.VariablesFactory) ([]db.Container, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.VariablesFactory
 	}{arg1, arg2, arg3, arg4})
@@ -665,7 +754,7 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, string, string, creds.VariablesFactory) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
@@ -1070,6 +1159,48 @@ func (fake *FakeTeam) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeTeam) MaxConcurrentBuilds() int {
+	fake.maxConcurrentBuildsMutex.Lock()
+	ret, specificReturn := fake.maxConcurrentBuildsReturnsOnCall[len(fake.maxConcurrentBuildsArgsForCall)]
+	fake.maxConcurrentBuildsArgsForCall = append(fake.maxConcurrentBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxConcurrentBuilds", []interface{}{})
+	fake.maxConcurrentBuildsMutex.Unlock()
+	if fake.MaxConcurrentBuildsStub != nil {
+		return fake.MaxConcurrentBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxConcurrentBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) MaxConcurrentBuildsCallCount() int {
+	fake.maxConcurrentBuildsMutex.RLock()
+	defer fake.maxConcurrentBuildsMutex.RUnlock()
+	return len(fake.maxConcurrentBuildsArgsForCall)
+}
+
+func (fake *FakeTeam) MaxConcurrentBuildsReturns(result1 int) {
+	fake.MaxConcurrentBuildsStub = nil
+	fake.maxConcurrentBuildsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeam) MaxConcurrentBuildsReturnsOnCall(i int, result1 int) {
+	fake.MaxConcurrentBuildsStub = nil
+	if fake.maxConcurrentBuildsReturnsOnCall == nil {
+		fake.maxConcurrentBuildsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxConcurrentBuildsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1167,11 +1298,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1191,7 +1322,7 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1419,11 +1550,11 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 db.PipelinePausedState
@@ -1446,7 +1577,7 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, db.PipelinePausedState) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
@@ -1532,6 +1663,57 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UpdateBuildQuota(arg1 int, arg2 int) error {
+	fake.updateBuildQuotaMutex.Lock()
+	ret, specificReturn := fake.updateBuildQuotaReturnsOnCall[len(fake.updateBuildQuotaArgsForCall)]
+	fake.updateBuildQuotaArgsForCall = append(fake.updateBuildQuotaArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateBuildQuota", []interface{}{arg1, arg2})
+	fake.updateBuildQuotaMutex.Unlock()
+	if fake.UpdateBuildQuotaStub != nil {
+		return fake.UpdateBuildQuotaStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateBuildQuotaReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateBuildQuotaCallCount() int {
+	fake.updateBuildQuotaMutex.RLock()
+	defer fake.updateBuildQuotaMutex.RUnlock()
+	return len(fake.updateBuildQuotaArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateBuildQuotaArgsForCall(i int) (int, int) {
+	fake.updateBuildQuotaMutex.RLock()
+	defer fake.updateBuildQuotaMutex.RUnlock()
+	argsForCall := fake.updateBuildQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) UpdateBuildQuotaReturns(result1 error) {
+	fake.UpdateBuildQuotaStub = nil
+	fake.updateBuildQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateBuildQuotaReturnsOnCall(i int, result1 error) {
+	fake.UpdateBuildQuotaStub = nil
+	if fake.updateBuildQuotaReturnsOnCall == nil {
+		fake.updateBuildQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateBuildQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -1582,6 +1764,61 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) Usage(arg1 time.Time, arg2 time.Time, arg3 db.UsageGrouping) ([]db.Usage, error) {
+	fake.usageMutex.Lock()
+	ret, specificReturn := fake.usageReturnsOnCall[len(fake.usageArgsForCall)]
+	fake.usageArgsForCall = append(fake.usageArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+		arg3 db.UsageGrouping
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Usage", []interface{}{arg1, arg2, arg3})
+	fake.usageMutex.Unlock()
+	if fake.UsageStub != nil {
+		return fake.UsageStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.usageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) UsageCallCount() int {
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
+	return len(fake.usageArgsForCall)
+}
+
+func (fake *FakeTeam) UsageArgsForCall(i int) (time.Time, time.Time, db.UsageGrouping) {
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
+	argsForCall := fake.usageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) UsageReturns(result1 []db.Usage, result2 error) {
+	fake.UsageStub = nil
+	fake.usageReturns = struct {
+		result1 []db.Usage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UsageReturnsOnCall(i int, result1 []db.Usage, result2 error) {
+	fake.UsageStub = nil
+	if fake.usageReturnsOnCall == nil {
+		fake.usageReturnsOnCall = make(map[int]struct {
+			result1 []db.Usage
+			result2 error
+		})
+	}
+	fake.usageReturnsOnCall[i] = struct {
+		result1 []db.Usage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) VisiblePipelines() ([]db.Pipeline, error) {
 	fake.visiblePipelinesMutex.Lock()
 	ret, specificReturn := fake.visiblePipelinesReturnsOnCall[len(fake.visiblePipelinesArgsForCall)]
@@ -1679,6 +1916,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.adminMutex.RUnlock()
 	fake.authMutex.RLock()
 	defer fake.authMutex.RUnlock()
+	fake.buildWeightMutex.RLock()
+	defer fake.buildWeightMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.createContainerMutex.RLock()
@@ -1703,6 +1942,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.findWorkerForContainerByOwnerMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.maxConcurrentBuildsMutex.RLock()
+	defer fake.maxConcurrentBuildsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
@@ -1721,8 +1962,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.updateBuildQuotaMutex.RLock()
+	defer fake.updateBuildQuotaMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
 	fake.visiblePipelinesMutex.RLock()
 	defer fake.visiblePipelinesMutex.RUnlock()
 	fake.workersMutex.RLock()
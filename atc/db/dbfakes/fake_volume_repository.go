@@ -227,6 +227,18 @@ type FakeVolumeRepository struct {
 		result1 []db.CreatedVolume
 		result2 error
 	}
+	RecordVolumeSizesStub        func(string, map[string]int64) error
+	recordVolumeSizesMutex       sync.RWMutex
+	recordVolumeSizesArgsForCall []struct {
+		arg1 string
+		arg2 map[string]int64
+	}
+	recordVolumeSizesReturns struct {
+		result1 error
+	}
+	recordVolumeSizesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveDestroyingVolumesStub        func(string, []string) (int, error)
 	removeDestroyingVolumesMutex       sync.RWMutex
 	removeDestroyingVolumesArgsForCall []struct {
@@ -1080,6 +1092,57 @@ func (fake *FakeVolumeRepository) GetTeamVolumesReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeRepository) RecordVolumeSizes(arg1 string, arg2 map[string]int64) error {
+	fake.recordVolumeSizesMutex.Lock()
+	ret, specificReturn := fake.recordVolumeSizesReturnsOnCall[len(fake.recordVolumeSizesArgsForCall)]
+	fake.recordVolumeSizesArgsForCall = append(fake.recordVolumeSizesArgsForCall, struct {
+		arg1 string
+		arg2 map[string]int64
+	}{arg1, arg2})
+	fake.recordInvocation("RecordVolumeSizes", []interface{}{arg1, arg2})
+	fake.recordVolumeSizesMutex.Unlock()
+	if fake.RecordVolumeSizesStub != nil {
+		return fake.RecordVolumeSizesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordVolumeSizesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolumeRepository) RecordVolumeSizesCallCount() int {
+	fake.recordVolumeSizesMutex.RLock()
+	defer fake.recordVolumeSizesMutex.RUnlock()
+	return len(fake.recordVolumeSizesArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) RecordVolumeSizesArgsForCall(i int) (string, map[string]int64) {
+	fake.recordVolumeSizesMutex.RLock()
+	defer fake.recordVolumeSizesMutex.RUnlock()
+	argsForCall := fake.recordVolumeSizesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) RecordVolumeSizesReturns(result1 error) {
+	fake.RecordVolumeSizesStub = nil
+	fake.recordVolumeSizesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVolumeRepository) RecordVolumeSizesReturnsOnCall(i int, result1 error) {
+	fake.RecordVolumeSizesStub = nil
+	if fake.recordVolumeSizesReturnsOnCall == nil {
+		fake.recordVolumeSizesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordVolumeSizesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVolumeRepository) RemoveDestroyingVolumes(arg1 string, arg2 []string) (int, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -1281,6 +1344,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.getOrphanedVolumesMutex.RUnlock()
 	fake.getTeamVolumesMutex.RLock()
 	defer fake.getTeamVolumesMutex.RUnlock()
+	fake.recordVolumeSizesMutex.RLock()
+	defer fake.recordVolumeSizesMutex.RUnlock()
 	fake.removeDestroyingVolumesMutex.RLock()
 	defer fake.removeDestroyingVolumesMutex.RUnlock()
 	fake.removeMissingVolumesMutex.RLock()
@@ -20,6 +20,16 @@ type FakeWorker struct {
 	activeContainersReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ActiveTasksStub        func() int
+	activeTasksMutex       sync.RWMutex
+	activeTasksArgsForCall []struct {
+	}
+	activeTasksReturns struct {
+		result1 int
+	}
+	activeTasksReturnsOnCall map[int]struct {
+		result1 int
+	}
 	BaggageclaimURLStub        func() *string
 	baggageclaimURLMutex       sync.RWMutex
 	baggageclaimURLArgsForCall []struct {
@@ -30,6 +40,26 @@ type FakeWorker struct {
 	baggageclaimURLReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	BuildContainersStub        func() int
+	buildContainersMutex       sync.RWMutex
+	buildContainersArgsForCall []struct {
+	}
+	buildContainersReturns struct {
+		result1 int
+	}
+	buildContainersReturnsOnCall map[int]struct {
+		result1 int
+	}
+	CancelMaintenanceStub        func() error
+	cancelMaintenanceMutex       sync.RWMutex
+	cancelMaintenanceArgsForCall []struct {
+	}
+	cancelMaintenanceReturns struct {
+		result1 error
+	}
+	cancelMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CertsPathStub        func() *string
 	certsPathMutex       sync.RWMutex
 	certsPathArgsForCall []struct {
@@ -40,6 +70,16 @@ type FakeWorker struct {
 	certsPathReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	DecreaseActiveTasksStub        func() error
+	decreaseActiveTasksMutex       sync.RWMutex
+	decreaseActiveTasksArgsForCall []struct {
+	}
+	decreaseActiveTasksReturns struct {
+		result1 error
+	}
+	decreaseActiveTasksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -100,6 +140,29 @@ type FakeWorker struct {
 	hTTPSProxyURLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	IncreaseActiveTasksStub        func(int) (bool, error)
+	increaseActiveTasksMutex       sync.RWMutex
+	increaseActiveTasksArgsForCall []struct {
+		arg1 int
+	}
+	increaseActiveTasksReturns struct {
+		result1 bool
+		result2 error
+	}
+	increaseActiveTasksReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LandStub        func() error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
@@ -110,6 +173,18 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MaintenanceWindowStub        func() (db.MaintenanceWindow, bool)
+	maintenanceWindowMutex       sync.RWMutex
+	maintenanceWindowArgsForCall []struct {
+	}
+	maintenanceWindowReturns struct {
+		result1 db.MaintenanceWindow
+		result2 bool
+	}
+	maintenanceWindowReturnsOnCall map[int]struct {
+		result1 db.MaintenanceWindow
+		result2 bool
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -150,6 +225,41 @@ type FakeWorker struct {
 	pruneReturnsOnCall map[int]struct {
 		result1 error
 	}
+	QuarantineStub        func(string) (bool, error)
+	quarantineMutex       sync.RWMutex
+	quarantineArgsForCall []struct {
+		arg1 string
+	}
+	quarantineReturns struct {
+		result1 bool
+		result2 error
+	}
+	quarantineReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	QuarantineReasonStub        func() string
+	quarantineReasonMutex       sync.RWMutex
+	quarantineReasonArgsForCall []struct {
+	}
+	quarantineReasonReturns struct {
+		result1 string
+	}
+	quarantineReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RecordErrorStub        func() (int, error)
+	recordErrorMutex       sync.RWMutex
+	recordErrorArgsForCall []struct {
+	}
+	recordErrorReturns struct {
+		result1 int
+		result2 error
+	}
+	recordErrorReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -162,6 +272,16 @@ type FakeWorker struct {
 		result1 bool
 		result2 error
 	}
+	ResetErrorsStub        func() error
+	resetErrorsMutex       sync.RWMutex
+	resetErrorsArgsForCall []struct {
+	}
+	resetErrorsReturns struct {
+		result1 error
+	}
+	resetErrorsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResourceCertsStub        func() (*db.UsedWorkerResourceCerts, bool, error)
 	resourceCertsMutex       sync.RWMutex
 	resourceCertsArgsForCall []struct {
@@ -196,6 +316,17 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScheduleMaintenanceStub        func(db.MaintenanceWindow) error
+	scheduleMaintenanceMutex       sync.RWMutex
+	scheduleMaintenanceArgsForCall []struct {
+		arg1 db.MaintenanceWindow
+	}
+	scheduleMaintenanceReturns struct {
+		result1 error
+	}
+	scheduleMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartTimeStub        func() int64
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -246,6 +377,16 @@ type FakeWorker struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnquarantineStub        func() error
+	unquarantineMutex       sync.RWMutex
+	unquarantineArgsForCall []struct {
+	}
+	unquarantineReturns struct {
+		result1 error
+	}
+	unquarantineReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VersionStub        func() *string
 	versionMutex       sync.RWMutex
 	versionArgsForCall []struct {
@@ -302,6 +443,48 @@ func (fake *FakeWorker) ActiveContainersReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ActiveTasks() int {
+	fake.activeTasksMutex.Lock()
+	ret, specificReturn := fake.activeTasksReturnsOnCall[len(fake.activeTasksArgsForCall)]
+	fake.activeTasksArgsForCall = append(fake.activeTasksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ActiveTasks", []interface{}{})
+	fake.activeTasksMutex.Unlock()
+	if fake.ActiveTasksStub != nil {
+		return fake.ActiveTasksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.activeTasksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ActiveTasksCallCount() int {
+	fake.activeTasksMutex.RLock()
+	defer fake.activeTasksMutex.RUnlock()
+	return len(fake.activeTasksArgsForCall)
+}
+
+func (fake *FakeWorker) ActiveTasksReturns(result1 int) {
+	fake.ActiveTasksStub = nil
+	fake.activeTasksReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWorker) ActiveTasksReturnsOnCall(i int, result1 int) {
+	fake.ActiveTasksStub = nil
+	if fake.activeTasksReturnsOnCall == nil {
+		fake.activeTasksReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.activeTasksReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeWorker) BaggageclaimURL() *string {
 	fake.baggageclaimURLMutex.Lock()
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
@@ -344,6 +527,90 @@ func (fake *FakeWorker) BaggageclaimURLReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) BuildContainers() int {
+	fake.buildContainersMutex.Lock()
+	ret, specificReturn := fake.buildContainersReturnsOnCall[len(fake.buildContainersArgsForCall)]
+	fake.buildContainersArgsForCall = append(fake.buildContainersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BuildContainers", []interface{}{})
+	fake.buildContainersMutex.Unlock()
+	if fake.BuildContainersStub != nil {
+		return fake.BuildContainersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.buildContainersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) BuildContainersCallCount() int {
+	fake.buildContainersMutex.RLock()
+	defer fake.buildContainersMutex.RUnlock()
+	return len(fake.buildContainersArgsForCall)
+}
+
+func (fake *FakeWorker) BuildContainersReturns(result1 int) {
+	fake.BuildContainersStub = nil
+	fake.buildContainersReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWorker) BuildContainersReturnsOnCall(i int, result1 int) {
+	fake.BuildContainersStub = nil
+	if fake.buildContainersReturnsOnCall == nil {
+		fake.buildContainersReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.buildContainersReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWorker) CancelMaintenance() error {
+	fake.cancelMaintenanceMutex.Lock()
+	ret, specificReturn := fake.cancelMaintenanceReturnsOnCall[len(fake.cancelMaintenanceArgsForCall)]
+	fake.cancelMaintenanceArgsForCall = append(fake.cancelMaintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CancelMaintenance", []interface{}{})
+	fake.cancelMaintenanceMutex.Unlock()
+	if fake.CancelMaintenanceStub != nil {
+		return fake.CancelMaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cancelMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CancelMaintenanceCallCount() int {
+	fake.cancelMaintenanceMutex.RLock()
+	defer fake.cancelMaintenanceMutex.RUnlock()
+	return len(fake.cancelMaintenanceArgsForCall)
+}
+
+func (fake *FakeWorker) CancelMaintenanceReturns(result1 error) {
+	fake.CancelMaintenanceStub = nil
+	fake.cancelMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) CancelMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.CancelMaintenanceStub = nil
+	if fake.cancelMaintenanceReturnsOnCall == nil {
+		fake.cancelMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) CertsPath() *string {
 	fake.certsPathMutex.Lock()
 	ret, specificReturn := fake.certsPathReturnsOnCall[len(fake.certsPathArgsForCall)]
@@ -386,6 +653,48 @@ func (fake *FakeWorker) CertsPathReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) DecreaseActiveTasks() error {
+	fake.decreaseActiveTasksMutex.Lock()
+	ret, specificReturn := fake.decreaseActiveTasksReturnsOnCall[len(fake.decreaseActiveTasksArgsForCall)]
+	fake.decreaseActiveTasksArgsForCall = append(fake.decreaseActiveTasksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DecreaseActiveTasks", []interface{}{})
+	fake.decreaseActiveTasksMutex.Unlock()
+	if fake.DecreaseActiveTasksStub != nil {
+		return fake.DecreaseActiveTasksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.decreaseActiveTasksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DecreaseActiveTasksCallCount() int {
+	fake.decreaseActiveTasksMutex.RLock()
+	defer fake.decreaseActiveTasksMutex.RUnlock()
+	return len(fake.decreaseActiveTasksArgsForCall)
+}
+
+func (fake *FakeWorker) DecreaseActiveTasksReturns(result1 error) {
+	fake.DecreaseActiveTasksStub = nil
+	fake.decreaseActiveTasksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) DecreaseActiveTasksReturnsOnCall(i int, result1 error) {
+	fake.DecreaseActiveTasksStub = nil
+	if fake.decreaseActiveTasksReturnsOnCall == nil {
+		fake.decreaseActiveTasksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decreaseActiveTasksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -638,6 +947,101 @@ func (fake *FakeWorker) HTTPSProxyURLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) IncreaseActiveTasks(arg1 int) (bool, error) {
+	fake.increaseActiveTasksMutex.Lock()
+	ret, specificReturn := fake.increaseActiveTasksReturnsOnCall[len(fake.increaseActiveTasksArgsForCall)]
+	fake.increaseActiveTasksArgsForCall = append(fake.increaseActiveTasksArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("IncreaseActiveTasks", []interface{}{arg1})
+	fake.increaseActiveTasksMutex.Unlock()
+	if fake.IncreaseActiveTasksStub != nil {
+		return fake.IncreaseActiveTasksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.increaseActiveTasksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) IncreaseActiveTasksCallCount() int {
+	fake.increaseActiveTasksMutex.RLock()
+	defer fake.increaseActiveTasksMutex.RUnlock()
+	return len(fake.increaseActiveTasksArgsForCall)
+}
+
+func (fake *FakeWorker) IncreaseActiveTasksArgsForCall(i int) int {
+	fake.increaseActiveTasksMutex.RLock()
+	defer fake.increaseActiveTasksMutex.RUnlock()
+	argsForCall := fake.increaseActiveTasksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) IncreaseActiveTasksReturns(result1 bool, result2 error) {
+	fake.IncreaseActiveTasksStub = nil
+	fake.increaseActiveTasksReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.IncreaseActiveTasksStub = nil
+	if fake.increaseActiveTasksReturnsOnCall == nil {
+		fake.increaseActiveTasksReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.increaseActiveTasksReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeWorker) Land() error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
@@ -680,6 +1084,51 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) MaintenanceWindow() (db.MaintenanceWindow, bool) {
+	fake.maintenanceWindowMutex.Lock()
+	ret, specificReturn := fake.maintenanceWindowReturnsOnCall[len(fake.maintenanceWindowArgsForCall)]
+	fake.maintenanceWindowArgsForCall = append(fake.maintenanceWindowArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaintenanceWindow", []interface{}{})
+	fake.maintenanceWindowMutex.Unlock()
+	if fake.MaintenanceWindowStub != nil {
+		return fake.MaintenanceWindowStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.maintenanceWindowReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) MaintenanceWindowCallCount() int {
+	fake.maintenanceWindowMutex.RLock()
+	defer fake.maintenanceWindowMutex.RUnlock()
+	return len(fake.maintenanceWindowArgsForCall)
+}
+
+func (fake *FakeWorker) MaintenanceWindowReturns(result1 db.MaintenanceWindow, result2 bool) {
+	fake.MaintenanceWindowStub = nil
+	fake.maintenanceWindowReturns = struct {
+		result1 db.MaintenanceWindow
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) MaintenanceWindowReturnsOnCall(i int, result1 db.MaintenanceWindow, result2 bool) {
+	fake.MaintenanceWindowStub = nil
+	if fake.maintenanceWindowReturnsOnCall == nil {
+		fake.maintenanceWindowReturnsOnCall = make(map[int]struct {
+			result1 db.MaintenanceWindow
+			result2 bool
+		})
+	}
+	fake.maintenanceWindowReturnsOnCall[i] = struct {
+		result1 db.MaintenanceWindow
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -848,6 +1297,146 @@ func (fake *FakeWorker) PruneReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Quarantine(arg1 string) (bool, error) {
+	fake.quarantineMutex.Lock()
+	ret, specificReturn := fake.quarantineReturnsOnCall[len(fake.quarantineArgsForCall)]
+	fake.quarantineArgsForCall = append(fake.quarantineArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Quarantine", []interface{}{arg1})
+	fake.quarantineMutex.Unlock()
+	if fake.QuarantineStub != nil {
+		return fake.QuarantineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.quarantineReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) QuarantineCallCount() int {
+	fake.quarantineMutex.RLock()
+	defer fake.quarantineMutex.RUnlock()
+	return len(fake.quarantineArgsForCall)
+}
+
+func (fake *FakeWorker) QuarantineArgsForCall(i int) string {
+	fake.quarantineMutex.RLock()
+	defer fake.quarantineMutex.RUnlock()
+	argsForCall := fake.quarantineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) QuarantineReturns(result1 bool, result2 error) {
+	fake.QuarantineStub = nil
+	fake.quarantineReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) QuarantineReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.QuarantineStub = nil
+	if fake.quarantineReturnsOnCall == nil {
+		fake.quarantineReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.quarantineReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) QuarantineReason() string {
+	fake.quarantineReasonMutex.Lock()
+	ret, specificReturn := fake.quarantineReasonReturnsOnCall[len(fake.quarantineReasonArgsForCall)]
+	fake.quarantineReasonArgsForCall = append(fake.quarantineReasonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("QuarantineReason", []interface{}{})
+	fake.quarantineReasonMutex.Unlock()
+	if fake.QuarantineReasonStub != nil {
+		return fake.QuarantineReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.quarantineReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) QuarantineReasonCallCount() int {
+	fake.quarantineReasonMutex.RLock()
+	defer fake.quarantineReasonMutex.RUnlock()
+	return len(fake.quarantineReasonArgsForCall)
+}
+
+func (fake *FakeWorker) QuarantineReasonReturns(result1 string) {
+	fake.QuarantineReasonStub = nil
+	fake.quarantineReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) QuarantineReasonReturnsOnCall(i int, result1 string) {
+	fake.QuarantineReasonStub = nil
+	if fake.quarantineReasonReturnsOnCall == nil {
+		fake.quarantineReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.quarantineReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) RecordError() (int, error) {
+	fake.recordErrorMutex.Lock()
+	ret, specificReturn := fake.recordErrorReturnsOnCall[len(fake.recordErrorArgsForCall)]
+	fake.recordErrorArgsForCall = append(fake.recordErrorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RecordError", []interface{}{})
+	fake.recordErrorMutex.Unlock()
+	if fake.RecordErrorStub != nil {
+		return fake.RecordErrorStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.recordErrorReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) RecordErrorCallCount() int {
+	fake.recordErrorMutex.RLock()
+	defer fake.recordErrorMutex.RUnlock()
+	return len(fake.recordErrorArgsForCall)
+}
+
+func (fake *FakeWorker) RecordErrorReturns(result1 int, result2 error) {
+	fake.RecordErrorStub = nil
+	fake.recordErrorReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) RecordErrorReturnsOnCall(i int, result1 int, result2 error) {
+	fake.RecordErrorStub = nil
+	if fake.recordErrorReturnsOnCall == nil {
+		fake.recordErrorReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.recordErrorReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -893,6 +1482,48 @@ func (fake *FakeWorker) ReloadReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) ResetErrors() error {
+	fake.resetErrorsMutex.Lock()
+	ret, specificReturn := fake.resetErrorsReturnsOnCall[len(fake.resetErrorsArgsForCall)]
+	fake.resetErrorsArgsForCall = append(fake.resetErrorsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResetErrors", []interface{}{})
+	fake.resetErrorsMutex.Unlock()
+	if fake.ResetErrorsStub != nil {
+		return fake.ResetErrorsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resetErrorsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResetErrorsCallCount() int {
+	fake.resetErrorsMutex.RLock()
+	defer fake.resetErrorsMutex.RUnlock()
+	return len(fake.resetErrorsArgsForCall)
+}
+
+func (fake *FakeWorker) ResetErrorsReturns(result1 error) {
+	fake.ResetErrorsStub = nil
+	fake.resetErrorsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ResetErrorsReturnsOnCall(i int, result1 error) {
+	fake.ResetErrorsStub = nil
+	if fake.resetErrorsReturnsOnCall == nil {
+		fake.resetErrorsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetErrorsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) ResourceCerts() (*db.UsedWorkerResourceCerts, bool, error) {
 	fake.resourceCertsMutex.Lock()
 	ret, specificReturn := fake.resourceCertsReturnsOnCall[len(fake.resourceCertsArgsForCall)]
@@ -1025,6 +1656,56 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ScheduleMaintenance(arg1 db.MaintenanceWindow) error {
+	fake.scheduleMaintenanceMutex.Lock()
+	ret, specificReturn := fake.scheduleMaintenanceReturnsOnCall[len(fake.scheduleMaintenanceArgsForCall)]
+	fake.scheduleMaintenanceArgsForCall = append(fake.scheduleMaintenanceArgsForCall, struct {
+		arg1 db.MaintenanceWindow
+	}{arg1})
+	fake.recordInvocation("ScheduleMaintenance", []interface{}{arg1})
+	fake.scheduleMaintenanceMutex.Unlock()
+	if fake.ScheduleMaintenanceStub != nil {
+		return fake.ScheduleMaintenanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceCallCount() int {
+	fake.scheduleMaintenanceMutex.RLock()
+	defer fake.scheduleMaintenanceMutex.RUnlock()
+	return len(fake.scheduleMaintenanceArgsForCall)
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceArgsForCall(i int) db.MaintenanceWindow {
+	fake.scheduleMaintenanceMutex.RLock()
+	defer fake.scheduleMaintenanceMutex.RUnlock()
+	argsForCall := fake.scheduleMaintenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceReturns(result1 error) {
+	fake.ScheduleMaintenanceStub = nil
+	fake.scheduleMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.ScheduleMaintenanceStub = nil
+	if fake.scheduleMaintenanceReturnsOnCall == nil {
+		fake.scheduleMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scheduleMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) StartTime() int64 {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -1235,6 +1916,48 @@ func (fake *FakeWorker) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Unquarantine() error {
+	fake.unquarantineMutex.Lock()
+	ret, specificReturn := fake.unquarantineReturnsOnCall[len(fake.unquarantineArgsForCall)]
+	fake.unquarantineArgsForCall = append(fake.unquarantineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Unquarantine", []interface{}{})
+	fake.unquarantineMutex.Unlock()
+	if fake.UnquarantineStub != nil {
+		return fake.UnquarantineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unquarantineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) UnquarantineCallCount() int {
+	fake.unquarantineMutex.RLock()
+	defer fake.unquarantineMutex.RUnlock()
+	return len(fake.unquarantineArgsForCall)
+}
+
+func (fake *FakeWorker) UnquarantineReturns(result1 error) {
+	fake.UnquarantineStub = nil
+	fake.unquarantineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) UnquarantineReturnsOnCall(i int, result1 error) {
+	fake.UnquarantineStub = nil
+	if fake.unquarantineReturnsOnCall == nil {
+		fake.unquarantineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unquarantineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Version() *string {
 	fake.versionMutex.Lock()
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
@@ -1282,10 +2005,18 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.activeContainersMutex.RLock()
 	defer fake.activeContainersMutex.RUnlock()
+	fake.activeTasksMutex.RLock()
+	defer fake.activeTasksMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
+	fake.buildContainersMutex.RLock()
+	defer fake.buildContainersMutex.RUnlock()
+	fake.cancelMaintenanceMutex.RLock()
+	defer fake.cancelMaintenanceMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
+	fake.decreaseActiveTasksMutex.RLock()
+	defer fake.decreaseActiveTasksMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
@@ -1298,8 +2029,14 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPProxyURLMutex.RUnlock()
 	fake.hTTPSProxyURLMutex.RLock()
 	defer fake.hTTPSProxyURLMutex.RUnlock()
+	fake.increaseActiveTasksMutex.RLock()
+	defer fake.increaseActiveTasksMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.maintenanceWindowMutex.RLock()
+	defer fake.maintenanceWindowMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
@@ -1308,14 +2045,24 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.platformMutex.RUnlock()
 	fake.pruneMutex.RLock()
 	defer fake.pruneMutex.RUnlock()
+	fake.quarantineMutex.RLock()
+	defer fake.quarantineMutex.RUnlock()
+	fake.quarantineReasonMutex.RLock()
+	defer fake.quarantineReasonMutex.RUnlock()
+	fake.recordErrorMutex.RLock()
+	defer fake.recordErrorMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.resetErrorsMutex.RLock()
+	defer fake.resetErrorsMutex.RUnlock()
 	fake.resourceCertsMutex.RLock()
 	defer fake.resourceCertsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.scheduleMaintenanceMutex.RLock()
+	defer fake.scheduleMaintenanceMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
@@ -1326,6 +2073,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.unquarantineMutex.RLock()
+	defer fake.unquarantineMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -3,12 +3,15 @@ package db
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . Job
@@ -24,6 +27,7 @@ type Job interface {
 	TeamName() string
 	Config() atc.JobConfig
 	Tags() []string
+	LastScheduled() time.Time
 
 	Reload() (bool, error)
 
@@ -31,11 +35,16 @@ type Job interface {
 	Unpause() error
 
 	CreateBuild() (Build, error)
+	CreateBuildWithInputOverrides(atc.InputOverrides) (Build, error)
+	RerunBuild(Build) (Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
+	LatestSucceededBuilds(limit int) ([]Build, error)
 	FinishedAndNextBuild() (Build, Build, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists() error
+	InitializeLastScheduled(time.Time) error
+	CreateScheduledBuild(from time.Time, to time.Time) (Build, bool, error)
 	GetPendingBuilds() ([]Build, error)
 
 	GetIndependentBuildInputs() ([]BuildInput, error)
@@ -43,15 +52,18 @@ type Job interface {
 	SaveNextInputMapping(inputMapping algorithm.InputMapping) error
 	SaveIndependentInputMapping(inputMapping algorithm.InputMapping) error
 	DeleteNextInputMapping() error
+	SaveUnsatisfiedInputs(unsatisfiedInputs algorithm.UnsatisfiedInputs) error
 
 	SetMaxInFlightReached(bool) error
 	GetRunningBuildsBySerialGroup(serialGroups []string) ([]Build, error)
 	GetNextPendingBuildBySerialGroup(serialGroups []string) (Build, bool, error)
 
+	SchedulingStatus() (JobSchedulingStatus, error)
+
 	ClearTaskCache(string, string) (int64, error)
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)", "j.last_scheduled").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -77,6 +89,7 @@ type job struct {
 	teamName           string
 	config             atc.JobConfig
 	tags               []string
+	lastScheduled      time.Time
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -105,6 +118,8 @@ func (j *job) TeamName() string        { return j.teamName }
 func (j *job) Config() atc.JobConfig   { return j.config }
 func (j *job) Tags() []string          { return j.tags }
 
+func (j *job) LastScheduled() time.Time { return j.lastScheduled }
+
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
 		RunWith(j.conn).
@@ -292,6 +307,39 @@ func (j *job) Build(name string) (Build, bool, error) {
 	return build, true, nil
 }
 
+// LatestSucceededBuilds returns up to limit of the job's most recent
+// succeeded builds, newest first.
+func (j *job) LatestSucceededBuilds(limit int) ([]Build, error) {
+	rows, err := buildsQuery.
+		Where(sq.Eq{
+			"b.job_id": j.id,
+			"b.status": BuildStatusSucceeded,
+		}).
+		OrderBy("b.id DESC").
+		Limit(uint64(limit)).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := []Build{}
+
+	for rows.Next() {
+		build := &build{conn: j.conn, lockFactory: j.lockFactory}
+		err = scanBuild(build, rows, j.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
 func (j *job) GetNextPendingBuildBySerialGroup(serialGroups []string) (Build, bool, error) {
 	err := j.updateSerialGroups(serialGroups)
 	if err != nil {
@@ -453,6 +501,141 @@ func (j *job) DeleteNextInputMapping() error {
 	return tx.Commit()
 }
 
+func (j *job) SaveUnsatisfiedInputs(unsatisfiedInputs algorithm.UnsatisfiedInputs) error {
+	var unsatisfiedInputsJSON interface{}
+	if len(unsatisfiedInputs) > 0 {
+		payload, err := json.Marshal(unsatisfiedInputs)
+		if err != nil {
+			return err
+		}
+
+		unsatisfiedInputsJSON = string(payload)
+	}
+
+	// the inputs are mapped on every scheduler tick, so skip the write when
+	// nothing has changed
+	_, err := psql.Update("jobs").
+		Set("unsatisfied_inputs", unsatisfiedInputsJSON).
+		Where(sq.And{
+			sq.Eq{"id": j.id},
+			sq.Expr("unsatisfied_inputs IS DISTINCT FROM ?::jsonb", unsatisfiedInputsJSON),
+		}).
+		RunWith(j.conn).
+		Exec()
+	return err
+}
+
+// SchedulingStatus explains why the job's pending builds are not being
+// started, or why no pending build is being created in the first place.
+func (j *job) SchedulingStatus() (JobSchedulingStatus, error) {
+	var (
+		pausedPipeline        bool
+		pausedJob             bool
+		maxInFlightReached    bool
+		unsatisfiedInputsJSON []byte
+	)
+	err := psql.Select("p.paused, j.paused, j.max_in_flight_reached, j.unsatisfied_inputs").
+		From("jobs j").
+		Join("pipelines p ON j.pipeline_id = p.id").
+		Where(sq.Eq{"j.id": j.id}).
+		RunWith(j.conn).
+		QueryRow().
+		Scan(&pausedPipeline, &pausedJob, &maxInFlightReached, &unsatisfiedInputsJSON)
+	if err != nil {
+		return JobSchedulingStatus{}, err
+	}
+
+	status := JobSchedulingStatus{
+		JobName:  j.name,
+		Blockers: []SchedulingBlocker{},
+	}
+
+	pendingBuilds, err := j.GetPendingBuilds()
+	if err != nil {
+		return JobSchedulingStatus{}, err
+	}
+
+	if len(pendingBuilds) > 0 {
+		status.PendingBuildID = pendingBuilds[0].ID()
+		status.PendingBuildName = pendingBuilds[0].Name()
+	}
+
+	if pausedPipeline {
+		status.Blockers = append(status.Blockers, SchedulingBlocker{Reason: SchedulingBlockerPipelinePaused})
+	}
+
+	if pausedJob {
+		status.Blockers = append(status.Blockers, SchedulingBlocker{Reason: SchedulingBlockerJobPaused})
+	}
+
+	if maxInFlightReached {
+		blockers, err := j.maxInFlightBlockers(status.PendingBuildID)
+		if err != nil {
+			return JobSchedulingStatus{}, err
+		}
+
+		status.Blockers = append(status.Blockers, blockers...)
+	}
+
+	if status.PendingBuildID != 0 {
+		blocker, found, err := j.teamQuotaBlocker()
+		if err != nil {
+			return JobSchedulingStatus{}, err
+		}
+
+		if found {
+			status.Blockers = append(status.Blockers, blocker)
+		}
+	}
+
+	unsatisfiedInputs := algorithm.UnsatisfiedInputs{}
+	if unsatisfiedInputsJSON != nil {
+		err = json.Unmarshal(unsatisfiedInputsJSON, &unsatisfiedInputs)
+		if err != nil {
+			return JobSchedulingStatus{}, err
+		}
+	}
+
+	inputResources := []string{}
+	for _, input := range j.config.Inputs() {
+		inputResources = append(inputResources, input.Resource)
+
+		unsatisfied, found := unsatisfiedInputs[input.Name]
+		if !found {
+			continue
+		}
+
+		status.Blockers = append(status.Blockers, SchedulingBlocker{
+			Reason:      SchedulingBlockerInputUnsatisfied,
+			Input:       input.Name,
+			Resource:    input.Resource,
+			InputReason: unsatisfied.Reason,
+			PassedJobs:  unsatisfied.PassedJobs,
+		})
+	}
+
+	checkErrors, err := j.inputCheckErrors(inputResources)
+	if err != nil {
+		return JobSchedulingStatus{}, err
+	}
+
+	for _, input := range j.config.Inputs() {
+		checkError, found := checkErrors[input.Resource]
+		if !found {
+			continue
+		}
+
+		status.Blockers = append(status.Blockers, SchedulingBlocker{
+			Reason:     SchedulingBlockerCheckErrored,
+			Input:      input.Name,
+			Resource:   input.Resource,
+			CheckError: checkError,
+		})
+	}
+
+	return status, nil
+}
+
 func (j *job) EnsurePendingBuildExists() error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -467,12 +650,12 @@ func (j *job) EnsurePendingBuildExists() error {
 	}
 
 	rows, err := tx.Query(`
-		INSERT INTO builds (name, job_id, pipeline_id, team_id, status)
-		SELECT $1, $2, $3, $4, 'pending'
+		INSERT INTO builds (name, job_id, pipeline_id, team_id, status, priority)
+		SELECT $1, $2, $3, $4, 'pending', $5
 		WHERE NOT EXISTS
 			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
 		RETURNING id
-	`, buildName, j.id, j.pipelineID, j.teamID)
+	`, buildName, j.id, j.pipelineID, j.teamID, j.config.Priority)
 	if err != nil {
 		return err
 	}
@@ -502,6 +685,73 @@ func (j *job) EnsurePendingBuildExists() error {
 	return nil
 }
 
+// InitializeLastScheduled records the time from which a job's schedule is
+// evaluated, unless one has already been recorded.
+func (j *job) InitializeLastScheduled(lastScheduled time.Time) error {
+	_, err := psql.Update("jobs").
+		Set("last_scheduled", lastScheduled).
+		Where(sq.Eq{
+			"id":             j.id,
+			"last_scheduled": nil,
+		}).
+		RunWith(j.conn).
+		Exec()
+	return err
+}
+
+// CreateScheduledBuild advances the job's last scheduled time from 'from' to
+// 'to' and creates a build, which is started with the job's next inputs like
+// any other scheduler-triggered build. If another ATC has already advanced
+// it, no build is created and false is returned.
+func (j *job) CreateScheduledBuild(from time.Time, to time.Time) (Build, bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("jobs").
+		Set("last_scheduled", to).
+		Where(sq.Eq{
+			"id":             j.id,
+			"last_scheduled": from,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	build, err := j.createPendingBuild(tx, buildName, false, atc.InputOverrides{}, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	j.lastScheduled = to
+
+	return build, true, nil
+}
+
 func (j *job) GetPendingBuilds() ([]Build, error) {
 	builds := []Build{}
 
@@ -545,6 +795,10 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 }
 
 func (j *job) CreateBuild() (Build, error) {
+	return j.CreateBuildWithInputOverrides(atc.InputOverrides{})
+}
+
+func (j *job) CreateBuildWithInputOverrides(overrides atc.InputOverrides) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -557,25 +811,143 @@ func (j *job) CreateBuild() (Build, error) {
 		return nil, err
 	}
 
-	build := &build{conn: j.conn, lockFactory: j.lockFactory}
-	err = createBuild(tx, build, map[string]interface{}{
-		"name":               buildName,
+	build, err := j.createPendingBuild(tx, buildName, true, overrides, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+// ErrBuildHasNoRecordedInputs is returned when rerunning a build which
+// finished before its inputs were determined, as its rerun would otherwise
+// use the latest versions.
+var ErrBuildHasNoRecordedInputs = errors.New("build has no recorded inputs")
+
+// RerunBuild creates a build which uses the same input versions as the given
+// build, regardless of any 'passed' constraints. Reruns are named and
+// numbered after the original build, e.g. rerunning either #42 or #42.1
+// creates #42.2.
+func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+	inputs, _, err := buildToRerun.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(inputs) == 0 && len(j.Config().Inputs()) > 0 {
+		return nil, ErrBuildHasNoRecordedInputs
+	}
+
+	overrides := atc.InputOverrides{
+		Inputs:       map[string]atc.Version{},
+		IgnorePassed: true,
+	}
+
+	for _, input := range inputs {
+		overrides.Inputs[input.Name] = atc.Version(input.Version)
+	}
+
+	originalID := buildToRerun.ID()
+	if buildToRerun.RerunOf() != 0 {
+		originalID = buildToRerun.RerunOf()
+	}
+
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	// lock the original build so that concurrent reruns get distinct numbers
+	var originalName string
+	err = psql.Select("name").
+		From("builds").
+		Where(sq.Eq{
+			"id":     originalID,
+			"job_id": j.id,
+		}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&originalName)
+	if err != nil {
+		return nil, err
+	}
+
+	var rerunNumber int
+	err = psql.Select("COALESCE(MAX(rerun_number), 0) + 1").
+		From("builds").
+		Where(sq.Eq{"rerun_of": originalID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&rerunNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	build, err := j.createPendingBuild(
+		tx,
+		fmt.Sprintf("%s.%d", originalName, rerunNumber),
+		true,
+		overrides,
+		map[string]interface{}{
+			"rerun_of":     originalID,
+			"rerun_number": rerunNumber,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+func (j *job) createPendingBuild(tx Tx, name string, manuallyTriggered bool, overrides atc.InputOverrides, extraVals map[string]interface{}) (Build, error) {
+	vals := map[string]interface{}{
+		"name":               name,
 		"job_id":             j.id,
 		"pipeline_id":        j.pipelineID,
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
-		"manually_triggered": true,
-	})
-	if err != nil {
-		return nil, err
+		"manually_triggered": manuallyTriggered,
+		"priority":           j.config.Priority,
 	}
 
-	err = updateNextBuildForJob(tx, j.id)
+	if overrides.Priority != nil {
+		vals["priority"] = *overrides.Priority
+	}
+
+	for k, v := range extraVals {
+		vals[k] = v
+	}
+
+	if len(overrides.Inputs) > 0 {
+		overridesJSON, err := json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+
+		vals["input_overrides"] = string(overridesJSON)
+	}
+
+	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	err := createBuild(tx, build, vals)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	err = updateNextBuildForJob(tx, j.id)
 	if err != nil {
 		return nil, err
 	}
@@ -619,6 +991,111 @@ func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	return rowsDeleted, tx.Commit()
 }
 
+func (j *job) maxInFlightBlockers(pendingBuildID int) ([]SchedulingBlocker, error) {
+	reason := SchedulingBlockerMaxInFlightReached
+	if j.config.Serial || len(j.config.SerialGroups) > 0 {
+		reason = SchedulingBlockerSerialGroupBlocked
+	}
+
+	serialGroups := j.config.GetSerialGroups()
+
+	runningBuilds, err := j.GetRunningBuildsBySerialGroup(serialGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	blockers := []SchedulingBlocker{}
+
+	if len(runningBuilds) >= j.config.MaxInFlight() {
+		for _, build := range runningBuilds {
+			blockers = append(blockers, SchedulingBlocker{
+				Reason:    reason,
+				BuildID:   build.ID(),
+				BuildName: build.Name(),
+				JobName:   build.JobName(),
+			})
+		}
+
+		return blockers, nil
+	}
+
+	// the job is waiting behind an older pending build of its serial groups
+	nextPendingBuild, found, err := j.GetNextPendingBuildBySerialGroup(serialGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	if found && nextPendingBuild.ID() != pendingBuildID {
+		blockers = append(blockers, SchedulingBlocker{
+			Reason:    SchedulingBlockerSerialGroupBlocked,
+			BuildID:   nextPendingBuild.ID(),
+			BuildName: nextPendingBuild.Name(),
+			JobName:   nextPendingBuild.JobName(),
+		})
+	}
+
+	return blockers, nil
+}
+
+func (j *job) teamQuotaBlocker() (SchedulingBlocker, bool, error) {
+	var maxConcurrentBuilds, active int
+	err := psql.Select("u.max_concurrent_builds", "u.active").
+		From(teamUsageTable).
+		Where(sq.Eq{"u.team_id": j.teamID}).
+		RunWith(j.conn).
+		QueryRow().
+		Scan(&maxConcurrentBuilds, &active)
+	if err != nil {
+		return SchedulingBlocker{}, false, err
+	}
+
+	if maxConcurrentBuilds == 0 || active < maxConcurrentBuilds {
+		return SchedulingBlocker{}, false, nil
+	}
+
+	return SchedulingBlocker{
+		Reason:              SchedulingBlockerTeamQuotaReached,
+		ActiveBuilds:        active,
+		MaxConcurrentBuilds: maxConcurrentBuilds,
+	}, true, nil
+}
+
+func (j *job) inputCheckErrors(resourceNames []string) (map[string]string, error) {
+	checkErrors := map[string]string{}
+
+	if len(resourceNames) == 0 {
+		return checkErrors, nil
+	}
+
+	rows, err := psql.Select("name, check_error").
+		From("resources").
+		Where(sq.Eq{
+			"pipeline_id": j.pipelineID,
+			"name":        resourceNames,
+			"active":      true,
+		}).
+		Where(sq.NotEq{"check_error": nil}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var name, checkError string
+		err = rows.Scan(&name, &checkError)
+		if err != nil {
+			return nil, err
+		}
+
+		checkErrors[name] = checkError
+	}
+
+	return checkErrors, nil
+}
+
 func (j *job) updateSerialGroups(serialGroups []string) error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -870,13 +1347,17 @@ func scanJob(j *job, row scannable) error {
 		nonce      sql.NullString
 		tagsBlob   []byte
 		tags       []string
+
+		lastScheduled pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob)
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob, &lastScheduled)
 	if err != nil {
 		return err
 	}
 
+	j.lastScheduled = lastScheduled.Time
+
 	es := j.conn.EncryptionStrategy()
 
 	var noncense *string
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"errors"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -23,7 +24,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -127,7 +128,7 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
@@ -380,6 +381,46 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("LatestSucceededBuilds", func() {
+		var succeededBuilds []db.Build
+
+		BeforeEach(func() {
+			succeededBuilds = nil
+
+			for _, status := range []db.BuildStatus{
+				db.BuildStatusSucceeded,
+				db.BuildStatusSucceeded,
+				db.BuildStatusFailed,
+				db.BuildStatusSucceeded,
+				db.BuildStatusErrored,
+			} {
+				build, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.Finish(status)
+				Expect(err).NotTo(HaveOccurred())
+
+				if status == db.BuildStatusSucceeded {
+					succeededBuilds = append([]db.Build{build}, succeededBuilds...)
+				}
+			}
+		})
+
+		It("returns the most recent succeeded builds, newest first", func() {
+			builds, err := job.LatestSucceededBuilds(2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(2))
+			Expect(builds[0].ID()).To(Equal(succeededBuilds[0].ID()))
+			Expect(builds[1].ID()).To(Equal(succeededBuilds[1].ID()))
+		})
+
+		It("returns fewer builds if there are not enough", func() {
+			builds, err := job.LatestSucceededBuilds(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(3))
+		})
+	})
+
 	Describe("GetRunningBuildsBySerialGroup", func() {
 		Describe("same job", func() {
 			var startedBuild, scheduledBuild db.Build
@@ -637,7 +678,7 @@ var _ = Describe("Job", func() {
 				Resources: atc.ResourceConfigs{resourceConfig},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			job2, found, err = pipeline2.Job("some-job")
@@ -991,7 +1032,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -1146,6 +1187,319 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateBuildWithInputOverrides", func() {
+		It("creates a manually triggered build with the overrides", func() {
+			overrides := atc.InputOverrides{
+				Inputs:       map[string]atc.Version{"some-input": {"ref": "v1"}},
+				IgnorePassed: true,
+			}
+
+			build, err := job.CreateBuildWithInputOverrides(overrides)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build.IsManuallyTriggered()).To(BeTrue())
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+			Expect(build.InputOverrides()).To(Equal(overrides))
+
+			found, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.InputOverrides()).To(Equal(overrides))
+		})
+
+		It("has no overrides for builds created without any", func() {
+			build, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build.InputOverrides()).To(BeZero())
+		})
+	})
+
+	Describe("RerunBuild", func() {
+		var originalBuild db.Build
+
+		BeforeEach(func() {
+			err := pipeline.SaveResourceVersions(
+				atc.ResourceConfig{Name: "some-resource", Type: "some-type"},
+				[]atc.Version{{"version": "v1"}},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			originalBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = originalBuild.SaveInput(db.BuildInput{
+				Name: "some-input",
+				VersionedResource: db.VersionedResource{
+					Resource: "some-resource",
+					Type:     "some-type",
+					Version:  db.ResourceVersion{"version": "v1"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = originalBuild.Finish(db.BuildStatusFailed)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a manually triggered build pinned to the inputs of the original", func() {
+			rerunBuild, err := job.RerunBuild(originalBuild)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rerunBuild.Name()).To(Equal(originalBuild.Name() + ".1"))
+			Expect(rerunBuild.IsManuallyTriggered()).To(BeTrue())
+			Expect(rerunBuild.Status()).To(Equal(db.BuildStatusPending))
+			Expect(rerunBuild.RerunOf()).To(Equal(originalBuild.ID()))
+			Expect(rerunBuild.RerunNumber()).To(Equal(1))
+			Expect(rerunBuild.InputOverrides()).To(Equal(atc.InputOverrides{
+				Inputs:       map[string]atc.Version{"some-input": {"version": "v1"}},
+				IgnorePassed: true,
+			}))
+
+			foundBuild, found, err := job.Build(rerunBuild.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(foundBuild.ID()).To(Equal(rerunBuild.ID()))
+			Expect(foundBuild.RerunOf()).To(Equal(originalBuild.ID()))
+		})
+
+		Context("when the build finished before its inputs were determined", func() {
+			BeforeEach(func() {
+				var err error
+				originalBuild, err = job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = originalBuild.Finish(db.BuildStatusErrored)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not rerun it with the latest versions", func() {
+				_, err := job.RerunBuild(originalBuild)
+				Expect(err).To(Equal(db.ErrBuildHasNoRecordedInputs))
+			})
+		})
+
+		Context("when the job has no inputs", func() {
+			var otherJob db.Job
+
+			BeforeEach(func() {
+				var found bool
+				var err error
+				otherJob, found, err = pipeline.Job("some-other-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				originalBuild, err = otherJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = originalBuild.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("reruns the build", func() {
+				rerunBuild, err := otherJob.RerunBuild(originalBuild)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rerunBuild.RerunOf()).To(Equal(originalBuild.ID()))
+			})
+		})
+
+		It("numbers reruns of a rerun after the original build", func() {
+			firstRerun, err := job.RerunBuild(originalBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			secondRerun, err := job.RerunBuild(firstRerun)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondRerun.Name()).To(Equal(originalBuild.Name() + ".2"))
+			Expect(secondRerun.RerunOf()).To(Equal(originalBuild.ID()))
+			Expect(secondRerun.RerunNumber()).To(Equal(2))
+		})
+
+		It("does not affect the name of the next build", func() {
+			_, err := job.RerunBuild(originalBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			nextBuild, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextBuild.Name()).To(Equal("2"))
+		})
+	})
+
+	Describe("SchedulingStatus", func() {
+		var pendingBuild db.Build
+
+		BeforeEach(func() {
+			var err error
+			pendingBuild, err = job.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("names the next pending build without any blockers", func() {
+			status, err := job.SchedulingStatus()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.JobName).To(Equal("some-job"))
+			Expect(status.PendingBuildID).To(Equal(pendingBuild.ID()))
+			Expect(status.PendingBuildName).To(Equal(pendingBuild.Name()))
+			Expect(status.Blockers).To(BeEmpty())
+		})
+
+		Context("when the pipeline and job are paused", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Pause()).To(Succeed())
+				Expect(job.Pause()).To(Succeed())
+			})
+
+			It("reports both", func() {
+				status, err := job.SchedulingStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Blockers).To(Equal([]db.SchedulingBlocker{
+					{Reason: db.SchedulingBlockerPipelinePaused},
+					{Reason: db.SchedulingBlockerJobPaused},
+				}))
+			})
+		})
+
+		Context("when a build of the serial group is running", func() {
+			var startedBuild db.Build
+
+			BeforeEach(func() {
+				otherJob, found, err := pipeline.Job("other-serial-group-job")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				startedBuild, err = otherJob.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				started, err := startedBuild.Start("", "{}", atc.Plan{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				Expect(job.SetMaxInFlightReached(true)).To(Succeed())
+			})
+
+			It("reports the running build", func() {
+				status, err := job.SchedulingStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Blockers).To(Equal([]db.SchedulingBlocker{
+					{
+						Reason:    db.SchedulingBlockerSerialGroupBlocked,
+						BuildID:   startedBuild.ID(),
+						BuildName: startedBuild.Name(),
+						JobName:   "other-serial-group-job",
+					},
+				}))
+			})
+		})
+
+		Context("when the team has as many builds running as its quota allows", func() {
+			BeforeEach(func() {
+				Expect(team.UpdateBuildQuota(1, 1)).To(Succeed())
+
+				otherJob, found, err := pipeline.Job("some-other-job")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				startedBuild, err := otherJob.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				started, err := startedBuild.Start("", "{}", atc.Plan{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+			})
+
+			It("reports the team quota", func() {
+				status, err := job.SchedulingStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Blockers).To(Equal([]db.SchedulingBlocker{
+					{
+						Reason:              db.SchedulingBlockerTeamQuotaReached,
+						ActiveBuilds:        1,
+						MaxConcurrentBuilds: 1,
+					},
+				}))
+			})
+		})
+
+		Context("when inputs are unsatisfied", func() {
+			BeforeEach(func() {
+				err := job.SaveUnsatisfiedInputs(algorithm.UnsatisfiedInputs{
+					"some-input": {
+						Reason:     algorithm.UnsatisfiedPassedConstraints,
+						PassedJobs: []string{"job-1", "job-2"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("reports the input", func() {
+				status, err := job.SchedulingStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Blockers).To(Equal([]db.SchedulingBlocker{
+					{
+						Reason:      db.SchedulingBlockerInputUnsatisfied,
+						Input:       "some-input",
+						Resource:    "some-resource",
+						InputReason: algorithm.UnsatisfiedPassedConstraints,
+						PassedJobs:  []string{"job-1", "job-2"},
+					},
+				}))
+			})
+
+			Context("when they are saved again unchanged", func() {
+				It("does not update the job", func() {
+					var xminBefore, xminAfter string
+					err := dbConn.QueryRow("SELECT xmin::text FROM jobs WHERE id = $1", job.ID()).Scan(&xminBefore)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = job.SaveUnsatisfiedInputs(algorithm.UnsatisfiedInputs{
+						"some-input": {
+							Reason:     algorithm.UnsatisfiedPassedConstraints,
+							PassedJobs: []string{"job-1", "job-2"},
+						},
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = dbConn.QueryRow("SELECT xmin::text FROM jobs WHERE id = $1", job.ID()).Scan(&xminAfter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(xminAfter).To(Equal(xminBefore))
+				})
+			})
+
+			Context("when they are cleared", func() {
+				BeforeEach(func() {
+					err := job.SaveUnsatisfiedInputs(algorithm.UnsatisfiedInputs{})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("no longer reports the input", func() {
+					status, err := job.SchedulingStatus()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(status.Blockers).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when an input resource is failing to check", func() {
+			BeforeEach(func() {
+				resource, found, err := pipeline.Resource("some-resource")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				err = pipeline.SetResourceCheckError(resource, errors.New("oh no"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("reports the check error", func() {
+				status, err := job.SchedulingStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Blockers).To(Equal([]db.SchedulingBlocker{
+					{
+						Reason:     db.SchedulingBlockerCheckErrored,
+						Input:      "some-input",
+						Resource:   "some-resource",
+						CheckError: "oh no",
+					},
+				}))
+			})
+		})
+	})
+
 	Describe("EnsurePendingBuildExists", func() {
 		Context("when only a started build exists", func() {
 			BeforeEach(func() {
@@ -1188,6 +1542,89 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateScheduledBuild", func() {
+		var (
+			initialized time.Time
+			next        time.Time
+		)
+
+		BeforeEach(func() {
+			initialized = time.Date(2018, 1, 1, 9, 30, 0, 0, time.UTC)
+			next = time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC)
+		})
+
+		It("has no last scheduled time until it is initialized", func() {
+			Expect(job.LastScheduled().IsZero()).To(BeTrue())
+
+			err := job.InitializeLastScheduled(initialized)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.LastScheduled()).To(BeTemporally("==", initialized))
+		})
+
+		It("does not initialize the last scheduled time twice", func() {
+			err := job.InitializeLastScheduled(initialized)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = job.InitializeLastScheduled(next)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.LastScheduled()).To(BeTemporally("==", initialized))
+		})
+
+		Context("when the last scheduled time matches", func() {
+			BeforeEach(func() {
+				err := job.InitializeLastScheduled(initialized)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("creates a pending build and advances the last scheduled time", func() {
+				build, created, err := job.CreateScheduledBuild(initialized, next)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(build.Status()).To(Equal(db.BuildStatusPending))
+				Expect(build.IsManuallyTriggered()).To(BeFalse())
+
+				found, err := job.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.LastScheduled()).To(BeTemporally("==", next))
+			})
+
+			It("creates only one build when called twice for the same time", func() {
+				_, created, err := job.CreateScheduledBuild(initialized, next)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				_, created, err = job.CreateScheduledBuild(initialized, next)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+
+				pendingBuilds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pendingBuilds).To(HaveLen(1))
+			})
+		})
+
+		Context("when the job has never been scheduled", func() {
+			It("does not create a build", func() {
+				_, created, err := job.CreateScheduledBuild(initialized, next)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+
+				pendingBuilds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pendingBuilds).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Clear worker task cache", func() {
 		Context("when worker task cache exists", func() {
 			var (
@@ -48,7 +48,7 @@ var _ = Describe("Locks", func() {
 		team, err = teamFactory.CreateTeam(atc.Team{Name: "team-name"})
 		Expect(err).NotTo(HaveOccurred())
 
-		pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+		pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE jobs ADD COLUMN unsatisfied_inputs jsonb;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE jobs ADD COLUMN last_scheduled timestamp with time zone;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX builds_queue_idx;
+
+  ALTER TABLE builds
+    DROP COLUMN priority,
+    DROP COLUMN queued;
+COMMIT;
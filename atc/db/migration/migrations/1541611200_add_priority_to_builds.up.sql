@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN priority integer NOT NULL DEFAULT 0,
+    ADD COLUMN queued boolean NOT NULL DEFAULT false;
+
+  CREATE INDEX builds_queue_idx ON builds (priority DESC, id ASC) WHERE status = 'pending' AND NOT scheduled;
+COMMIT;
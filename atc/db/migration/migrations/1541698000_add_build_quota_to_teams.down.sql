@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams
+    DROP COLUMN max_concurrent_builds,
+    DROP COLUMN build_weight;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams
+    ADD COLUMN max_concurrent_builds integer NOT NULL DEFAULT 0,
+    ADD COLUMN build_weight integer NOT NULL DEFAULT 1;
+COMMIT;
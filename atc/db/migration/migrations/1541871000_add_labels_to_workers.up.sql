@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN labels text NOT NULL DEFAULT '{}';
+COMMIT;
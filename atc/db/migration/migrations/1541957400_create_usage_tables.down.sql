@@ -0,0 +1,4 @@
+BEGIN;
+  DROP TABLE volume_usage;
+  DROP TABLE container_usage;
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+  CREATE TABLE container_usage (
+    handle text PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    worker_name text NOT NULL,
+    type text NOT NULL DEFAULT '',
+    pipeline_id integer,
+    pipeline_name text NOT NULL DEFAULT '',
+    job_id integer,
+    job_name text NOT NULL DEFAULT '',
+    build_id integer,
+    build_name text NOT NULL DEFAULT '',
+    cpu_limit bigint NOT NULL DEFAULT 0,
+    memory_limit bigint NOT NULL DEFAULT 0,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    destroyed_at timestamp with time zone
+  );
+
+  CREATE INDEX container_usage_team_id_created_at_idx ON container_usage (team_id, created_at);
+
+  CREATE TABLE volume_usage (
+    handle text PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    worker_name text NOT NULL,
+    container_handle text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    destroyed_at timestamp with time zone
+  );
+
+  CREATE INDEX volume_usage_team_id_created_at_idx ON volume_usage (team_id, created_at);
+COMMIT;
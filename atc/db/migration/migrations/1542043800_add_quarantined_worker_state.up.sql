@@ -0,0 +1,6 @@
+-- NO_TRANSACTION
+ALTER TYPE worker_state ADD VALUE IF NOT EXISTS 'quarantined';
+
+ALTER TABLE workers
+  ADD COLUMN consecutive_errors integer NOT NULL DEFAULT 0,
+  ADD COLUMN quarantine_reason text;
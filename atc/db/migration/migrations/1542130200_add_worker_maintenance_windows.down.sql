@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN maintenance_start,
+    DROP COLUMN maintenance_end,
+    DROP COLUMN maintenance_behaviour;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN maintenance_start timestamp with time zone,
+    ADD COLUMN maintenance_end timestamp with time zone,
+    ADD COLUMN maintenance_behaviour text;
+COMMIT;
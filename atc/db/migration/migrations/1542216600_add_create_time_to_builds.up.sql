@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN create_time timestamp with time zone NOT NULL DEFAULT now();
+COMMIT;
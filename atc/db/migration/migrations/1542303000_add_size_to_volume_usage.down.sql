@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE volume_usage DROP COLUMN size_bytes;
+COMMIT;
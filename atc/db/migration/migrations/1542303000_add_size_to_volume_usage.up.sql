@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE volume_usage ADD COLUMN size_bytes bigint NOT NULL DEFAULT 0;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN logs_archived boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -34,12 +34,15 @@ type Cause struct {
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
+	VarSources() atc.VarSourceConfigs
 	ConfigVersion() ConfigVersion
 	Public() bool
 	Paused() bool
+	Archived() bool
 	ScopedName(string) string
 
 	CheckPaused() (bool, error)
@@ -101,6 +104,8 @@ type Pipeline interface {
 	Pause() error
 	Unpause() error
 
+	Archive() error
+
 	Destroy() error
 	Rename(string) error
 
@@ -110,12 +115,15 @@ type Pipeline interface {
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
+	varSources    atc.VarSourceConfigs
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
+	archived      bool
 
 	cacheIndex int
 	versionsDB *algorithm.VersionsDB
@@ -132,12 +140,16 @@ type PipelinePausedState string
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
+		p.var_sources,
+		p.nonce,
 		p.version,
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.archived
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -164,6 +176,36 @@ func (state PipelinePausedState) Bool() *bool {
 	}
 }
 
+// marshalInstanceVars returns the value of the instance_vars column for the
+// given vars, which is NULL for a pipeline that is not instanced.
+func marshalInstanceVars(instanceVars atc.InstanceVars) (interface{}, error) {
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(payload), nil
+}
+
+// instanceVarsCondition matches the pipeline with the given instance vars,
+// comparing them as jsonb so that the order of the keys does not matter.
+func instanceVarsCondition(column string, instanceVars atc.InstanceVars) (sq.Sqlizer, error) {
+	payload, err := marshalInstanceVars(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil {
+		return sq.Eq{column: nil}, nil
+	}
+
+	return sq.Expr(column+" = ?::jsonb", payload), nil
+}
+
 func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	return &pipeline{
 		conn:        conn,
@@ -171,14 +213,17 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                      { return p.id }
-func (p *pipeline) Name() string                 { return p.name }
-func (p *pipeline) TeamID() int                  { return p.teamID }
-func (p *pipeline) TeamName() string             { return p.teamName }
-func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
-func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
-func (p *pipeline) Public() bool                 { return p.public }
-func (p *pipeline) Paused() bool                 { return p.paused }
+func (p *pipeline) ID() int                          { return p.id }
+func (p *pipeline) Name() string                     { return p.name }
+func (p *pipeline) InstanceVars() atc.InstanceVars   { return p.instanceVars }
+func (p *pipeline) TeamID() int                      { return p.teamID }
+func (p *pipeline) TeamName() string                 { return p.teamName }
+func (p *pipeline) Groups() atc.GroupConfigs         { return p.groups }
+func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
+func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
+func (p *pipeline) Public() bool                     { return p.public }
+func (p *pipeline) Paused() bool                     { return p.paused }
+func (p *pipeline) Archived() bool                   { return p.archived }
 
 func (p *pipeline) ScopedName(n string) string {
 	return p.name + ":" + n
@@ -951,6 +996,56 @@ func (p *pipeline) Unpause() error {
 	return err
 }
 
+// Archive pauses the pipeline and releases its resources' and resource types'
+// resource configs so that they may be garbage collected. The pipeline's
+// builds and its resources' versions are kept, and saving the pipeline's
+// config again will unarchive it. Once unarchived, its resources and resource
+// types find their resource configs again on their next check.
+func (p *pipeline) Archive() error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("pipelines").
+		Set("archived", true).
+		Set("paused", true).
+		Where(sq.Eq{
+			"id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resources").
+		Set("resource_config_id", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resource_types").
+		Set("resource_config_id", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (p *pipeline) Hide() error {
 	_, err := psql.Update("pipelines").
 		Set("public", false).
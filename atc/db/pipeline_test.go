@@ -1,10 +1,13 @@
 package db_test
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/event"
@@ -106,7 +109,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), db.PipelineUnpaused)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), db.PipelineUnpaused)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -185,13 +188,110 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("Archive", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			resource, found, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			session, err := resourceConfigCheckSessionFactory.FindOrCreateResourceConfigCheckSession(logger,
+				resource.Type(),
+				resource.Source(),
+				creds.VersionedResourceTypes{},
+				db.ContainerOwnerExpiries{Min: time.Minute, Max: time.Minute},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resource.SetResourceConfig(session.ResourceConfig().ID())).To(Succeed())
+
+			err = pipeline.SaveResourceVersions(atc.ResourceConfig{
+				Name:   resource.Name(),
+				Type:   resource.Type(),
+				Source: resource.Source(),
+			}, []atc.Version{{"version": "1"}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			Expect(pipeline.Archive()).To(Succeed())
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("archives and pauses the pipeline", func() {
+			Expect(pipeline.Archived()).To(BeTrue())
+			Expect(pipeline.Paused()).To(BeTrue())
+		})
+
+		It("keeps the pipeline's builds", func() {
+			found, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("releases the resource configs of the pipeline's resources", func() {
+			var referenced int
+			err := dbConn.QueryRow(`
+				SELECT COUNT(*) FROM resources
+				WHERE pipeline_id = $1 AND resource_config_id IS NOT NULL
+			`, pipeline.ID()).Scan(&referenced)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(referenced).To(BeZero())
+		})
+
+		It("keeps the versions of the pipeline's resources", func() {
+			versions, _, found, err := pipeline.GetResourceVersions("some-resource", db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(versions).To(HaveLen(1))
+			Expect(versions[0].Version).To(Equal(db.ResourceVersion{"version": "1"}))
+		})
+
+		Context("when the pipeline is saved again", func() {
+			JustBeforeEach(func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipeline.Name()}, pipelineConfig, pipeline.ConfigVersion(), db.PipelineNoChange)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("unarchives the pipeline, leaving it paused", func() {
+				Expect(pipeline.Archived()).To(BeFalse())
+				Expect(pipeline.Paused()).To(BeTrue())
+			})
+
+			It("leaves the resources to find their resource configs on their next check", func() {
+				resource, found, err := pipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				var resourceConfigID sql.NullInt64
+				err = dbConn.QueryRow(`
+					SELECT resource_config_id FROM resources WHERE id = $1
+				`, resource.ID()).Scan(&resourceConfigID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resourceConfigID.Valid).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Rename", func() {
 		JustBeforeEach(func() {
 			Expect(pipeline.Rename("oopsies")).To(Succeed())
 		})
 
 		It("renames the pipeline", func() {
-			pipeline, found, err := team.Pipeline("oopsies")
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "oopsies"})
 			Expect(pipeline.Name()).To(Equal("oopsies"))
 			Expect(found).To(BeTrue())
 			Expect(err).ToNot(HaveOccurred())
@@ -220,7 +320,7 @@ var _ = Describe("Pipeline", func() {
 						},
 					},
 				}
-				pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, err := pipeline.Resource("some-resource")
@@ -556,7 +656,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = pipeline.Resource("some-resource")
@@ -646,7 +746,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			err = pipeline.SaveResourceVersions(
@@ -908,10 +1008,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, db.PipelineUnpaused)
+			dbPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name"}, pipelineConfig, 0, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused)
+			otherDBPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbPipeline.Resource(resourceName)
@@ -1585,7 +1685,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipelineDB, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -1785,7 +1885,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 
-			_, found, err = team.Pipeline(pipeline.Name())
+			_, found, err = team.Pipeline(atc.PipelineRef{Name: pipeline.Name()})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -1831,7 +1931,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
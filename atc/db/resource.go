@@ -29,17 +29,26 @@ type Resource interface {
 	Paused() bool
 	WebhookToken() string
 	PinnedVersion() atc.Version
+	APIPinnedVersion() atc.Version
+	PinComment() string
 	FailingToCheck() bool
 
+	// CurrentPinnedVersion returns the version pinned in the pipeline's
+	// config or, failing that, the version pinned through the API.
+	CurrentPinnedVersion() atc.Version
+
 	SetResourceConfig(int) error
 
 	Pause() error
 	Unpause() error
 
+	PinVersion(versionedResourceID int, comment string) (bool, error)
+	UnpinVersion() error
+
 	Reload() (bool, error)
 }
 
-var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, r.paused, r.last_checked, r.pipeline_id, r.nonce, p.name, t.name").
+var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, r.paused, r.last_checked, r.pipeline_id, r.nonce, r.api_pinned_version, r.pin_comment, p.name, t.name").
 	From("resources r").
 	Join("pipelines p ON p.id = r.pipeline_id").
 	Join("teams t ON t.id = p.team_id").
@@ -62,6 +71,9 @@ type resource struct {
 	webhookToken  string
 	pinnedVersion atc.Version
 
+	apiPinnedVersion atc.Version
+	pinComment       string
+
 	conn Conn
 }
 
@@ -130,6 +142,17 @@ func (r *resource) FailingToCheck() bool {
 	return r.checkError != nil
 }
 
+func (r *resource) APIPinnedVersion() atc.Version { return r.apiPinnedVersion }
+func (r *resource) PinComment() string            { return r.pinComment }
+
+func (r *resource) CurrentPinnedVersion() atc.Version {
+	if r.pinnedVersion != nil {
+		return r.pinnedVersion
+	}
+
+	return r.apiPinnedVersion
+}
+
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
 		RunWith(r.conn).
@@ -170,6 +193,42 @@ func (r *resource) Unpause() error {
 	return err
 }
 
+// PinVersion pins the resource to the version with the given ID, returning
+// false if the version does not belong to the resource.
+func (r *resource) PinVersion(versionedResourceID int, comment string) (bool, error) {
+	result, err := r.conn.Exec(`
+		UPDATE resources
+		SET api_pinned_version = vr.version::jsonb, pin_comment = $3
+		FROM versioned_resources vr
+		WHERE resources.id = $1
+		AND vr.id = $2
+		AND vr.resource_id = resources.id
+	`, r.id, versionedResourceID, comment)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (r *resource) UnpinVersion() error {
+	_, err := psql.Update("resources").
+		Set("api_pinned_version", nil).
+		Set("pin_comment", nil).
+		Where(sq.Eq{
+			"id": r.id,
+		}).
+		RunWith(r.conn).
+		Exec()
+
+	return err
+}
+
 func (r *resource) SetResourceConfig(resourceConfigID int) error {
 	_, err := psql.Update("resources").
 		Set("resource_config_id", resourceConfigID).
@@ -186,16 +245,27 @@ func (r *resource) SetResourceConfig(resourceConfigID int) error {
 
 func scanResource(r *resource, row scannable) error {
 	var (
-		configBlob      []byte
-		checkErr, nonce sql.NullString
-		lastChecked     pq.NullTime
+		configBlob                  []byte
+		checkErr, nonce, pinComment sql.NullString
+		apiPinnedVersion            []byte
+		lastChecked                 pq.NullTime
 	)
 
-	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &r.paused, &lastChecked, &r.pipelineID, &nonce, &r.pipelineName, &r.teamName)
+	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &r.paused, &lastChecked, &r.pipelineID, &nonce, &apiPinnedVersion, &pinComment, &r.pipelineName, &r.teamName)
 	if err != nil {
 		return err
 	}
 
+	r.apiPinnedVersion = nil
+	if apiPinnedVersion != nil {
+		err = json.Unmarshal(apiPinnedVersion, &r.apiPinnedVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.pinComment = pinComment.String
+
 	r.lastChecked = lastChecked.Time
 
 	es := r.conn.EncryptionStrategy()
@@ -111,7 +111,7 @@ var _ = Describe("ResourceCacheFactory", func() {
 		}
 
 		pipelineWithTypes, _, err := defaultTeam.SavePipeline(
-			"pipeline-with-types",
+			atc.PipelineRef{Name: "pipeline-with-types"},
 			atc.Config{
 				ResourceTypes: atc.ResourceTypes{
 					resourceType1.ResourceType,
@@ -17,7 +17,7 @@ var _ = Describe("Resource", func() {
 		)
 
 		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-resources",
+			atc.PipelineRef{Name: "pipeline-with-resources"},
 			atc.Config{
 				Resources: atc.ResourceConfigs{
 					{
@@ -181,4 +181,118 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("PinVersion", func() {
+		var (
+			resource db.Resource
+			versions []db.SavedVersionedResource
+			err      error
+			found    bool
+		)
+
+		BeforeEach(func() {
+			err = pipeline.SaveResourceVersions(
+				atc.ResourceConfig{
+					Name: "some-other-resource",
+					Type: "git",
+				},
+				[]atc.Version{
+					{"ref": "v1"},
+					{"ref": "v2"},
+				},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			versions, _, found, err = pipeline.GetResourceVersions("some-other-resource", db.Page{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(versions).To(HaveLen(2))
+
+			resource, found, err = pipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.APIPinnedVersion()).To(BeNil())
+		})
+
+		It("pins the resource to the version", func() {
+			found, err = resource.PinVersion(versions[1].ID, "v2 is broken")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			found, err = resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"ref": "v1"}))
+			Expect(resource.PinComment()).To(Equal("v2 is broken"))
+			Expect(resource.CurrentPinnedVersion()).To(Equal(atc.Version{"ref": "v1"}))
+		})
+
+		Context("when the version belongs to another resource", func() {
+			It("does not pin the resource", func() {
+				otherResource, found, err := pipeline.Resource("some-secret-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				found, err = otherResource.PinVersion(versions[0].ID, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				found, err = otherResource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(otherResource.APIPinnedVersion()).To(BeNil())
+			})
+		})
+
+		Context("when the resource is pinned in the pipeline's config", func() {
+			It("prefers the version in the config", func() {
+				configPinnedResource, found, err := pipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(configPinnedResource.CurrentPinnedVersion()).To(Equal(atc.Version{"ref": "abcdef"}))
+			})
+		})
+	})
+
+	Describe("UnpinVersion", func() {
+		var (
+			resource db.Resource
+			err      error
+			found    bool
+		)
+
+		BeforeEach(func() {
+			err = pipeline.SaveResourceVersions(
+				atc.ResourceConfig{
+					Name: "some-other-resource",
+					Type: "git",
+				},
+				[]atc.Version{{"ref": "v1"}},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			versions, _, found, err := pipeline.GetResourceVersions("some-other-resource", db.Page{Limit: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resource, found, err = pipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			found, err = resource.PinVersion(versions[0].ID, "some comment")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("unpins the resource", func() {
+			err = resource.UnpinVersion()
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err = resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.APIPinnedVersion()).To(BeNil())
+			Expect(resource.PinComment()).To(BeEmpty())
+		})
+	})
 })
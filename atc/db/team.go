@@ -29,17 +29,20 @@ type Team interface {
 
 	Auth() atc.TeamAuth
 
+	MaxConcurrentBuilds() int
+	BuildWeight() int
+
 	Delete() error
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		pausedState PipelinePausedState,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	VisiblePipelines() ([]Pipeline, error)
@@ -54,7 +57,7 @@ type Team interface {
 
 	FindContainerByHandle(string) (Container, bool, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
-	FindCheckContainers(lager.Logger, string, string, creds.VariablesFactory) ([]Container, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) ([]Container, error)
 
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 
@@ -65,6 +68,9 @@ type Team interface {
 	CreateContainer(workerName string, owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateBuildQuota(maxConcurrentBuilds int, buildWeight int) error
+
+	Usage(from time.Time, to time.Time, grouping UsageGrouping) ([]Usage, error)
 }
 
 type team struct {
@@ -76,6 +82,9 @@ type team struct {
 	admin bool
 
 	auth atc.TeamAuth
+
+	maxConcurrentBuilds int
+	buildWeight         int
 }
 
 func (t *team) ID() int      { return t.id }
@@ -84,6 +93,9 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) MaxConcurrentBuilds() int { return t.maxConcurrentBuilds }
+func (t *team) BuildWeight() int         { return t.buildWeight }
+
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
 		Where(sq.Eq{
@@ -205,6 +217,11 @@ func (t *team) CreateContainer(workerName string, owner ContainerOwner, meta Con
 		return nil, err
 	}
 
+	err = recordContainerUsage(tx, handle.String(), t.id, workerName, meta)
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -275,8 +292,8 @@ func (t *team) FindContainersByMetadata(metadata ContainerMetadata) ([]Container
 	return containers, nil
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +314,7 @@ func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, res
 		return nil, err
 	}
 
-	variables := variablesFactory.NewVariables(t.name, pipeline.Name())
+	variables := creds.NewPipelineVariables(logger, variablesFactory, t.name, pipeline.Name(), pipeline.VarSources())
 
 	versionedResourceTypes := pipelineResourceTypes.Deserialize()
 
@@ -374,7 +391,7 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	pausedState PipelinePausedState,
@@ -384,6 +401,26 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	instanceVarsPayload, err := marshalInstanceVars(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
+	varSourcesPayload, err := json.Marshal(config.VarSources)
+	if err != nil {
+		return nil, false, err
+	}
+
+	encryptedVarSources, nonce, err := t.conn.EncryptionStrategy().Encrypt(varSourcesPayload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	instanceVarsEq, err := instanceVarsCondition("instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	jobGroups := make(map[string][]string)
 	for _, group := range config.Groups {
 		for _, job := range group.Jobs {
@@ -401,12 +438,16 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
-	err = tx.QueryRow(`
-		SELECT COUNT(1)
-		FROM pipelines
-		WHERE name = $1
-	  AND team_id = $2
-	`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("COUNT(1)").
+		From("pipelines").
+		Where(sq.Eq{
+			"name":    pipelineRef.Name,
+			"team_id": t.id,
+		}).
+		Where(instanceVarsEq).
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -417,14 +458,23 @@ func (t *team) SavePipeline(
 			pausedState = PipelinePaused
 		}
 
+		// keep the instances of a pipeline next to each other
+		ordering := sq.Expr(`COALESCE(
+			(SELECT MIN(ordering) FROM pipelines WHERE name = ? AND team_id = ?),
+			currval('pipelines_id_seq')
+		)`, pipelineRef.Name, t.id)
+
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   pausedState.Bool(),
-				"team_id":  t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVarsPayload,
+				"groups":        groupsPayload,
+				"var_sources":   encryptedVarSources,
+				"nonce":         nonce,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				"ordering":      ordering,
+				"paused":        pausedState.Bool(),
+				"team_id":       t.id,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -437,12 +487,16 @@ func (t *team) SavePipeline(
 	} else {
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
+			Set("var_sources", encryptedVarSources).
+			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
+			Set("archived", false).
 			Where(sq.Eq{
-				"name":    pipelineName,
+				"name":    pipelineRef.Name,
 				"version": from,
 				"team_id": t.id,
 			}).
+			Where(instanceVarsEq).
 			Suffix("RETURNING id")
 
 		if pausedState != PipelineNoChange {
@@ -552,16 +606,22 @@ func (t *team) SavePipeline(
 	return pipeline, created, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVarsEq, err := instanceVarsCondition("p.instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.name":    pipelineRef.Name,
 			}).
+			Where(instanceVarsEq).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -765,6 +825,29 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+// UpdateBuildQuota limits how many of the team's builds may run at once and
+// sets the team's share of the build queue. A weight below 1 is treated as 1.
+func (t *team) UpdateBuildQuota(maxConcurrentBuilds int, buildWeight int) error {
+	if buildWeight < 1 {
+		buildWeight = 1
+	}
+
+	_, err := psql.Update("teams").
+		Set("max_concurrent_builds", maxConcurrentBuilds).
+		Set("build_weight", buildWeight).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.maxConcurrentBuilds = maxConcurrentBuilds
+	t.buildWeight = buildWeight
+
+	return nil
+}
+
 func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) error {
 	configPayload, err := json.Marshal(job)
 	if err != nil {
@@ -936,12 +1019,36 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 }
 
 func scanPipeline(p *pipeline, scan scannable) error {
-	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var groups, instanceVars, varSources, nonce sql.NullString
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived)
 	if err != nil {
 		return err
 	}
 
+	if varSources.Valid {
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedVarSources, err := p.conn.EncryptionStrategy().Decrypt(varSources.String, noncense)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(decryptedVarSources, &p.varSources)
+		if err != nil {
+			return err
+		}
+	}
+
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
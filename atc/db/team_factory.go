@@ -50,10 +50,20 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	var maxConcurrentBuilds int
+	if t.MaxConcurrentBuilds != nil {
+		maxConcurrentBuilds = *t.MaxConcurrentBuilds
+	}
+
+	buildWeight := 1
+	if t.BuildWeight != nil && *t.BuildWeight > 1 {
+		buildWeight = *t.BuildWeight
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, max_concurrent_builds, build_weight").
+		Values(t.Name, auth, admin, maxConcurrentBuilds, buildWeight).
+		Suffix("RETURNING id, name, admin, auth, max_concurrent_builds, build_weight").
 		RunWith(tx).
 		QueryRow()
 
@@ -89,7 +99,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, max_concurrent_builds, build_weight").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -108,7 +118,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, max_concurrent_builds, build_weight").
 		From("teams").
 		OrderBy("id ASC").
 		RunWith(factory.conn).
@@ -173,6 +183,8 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&t.maxConcurrentBuilds,
+		&t.buildWeight,
 	)
 
 	if providerAuth.Valid {
@@ -0,0 +1,413 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// UsageGrouping determines how a team's resource usage is broken down.
+type UsageGrouping string
+
+const (
+	UsageGroupingNone     UsageGrouping = ""
+	UsageGroupingPipeline UsageGrouping = "pipeline"
+	UsageGroupingJob      UsageGrouping = "job"
+)
+
+func UsageGroupingFromString(grouping string) (UsageGrouping, error) {
+	switch grouping {
+	case "":
+		return UsageGroupingNone, nil
+	case "pipeline":
+		return UsageGroupingPipeline, nil
+	case "job":
+		return UsageGroupingJob, nil
+	default:
+		return "", fmt.Errorf("Unrecognized usage grouping: %s", grouping)
+	}
+}
+
+// Usage is the amount of worker resources consumed by a team, or by one of
+// its pipelines or jobs, over a period of time. Every duration only counts
+// the part of a container's or volume's lifetime which falls in the period.
+type Usage struct {
+	PipelineName string
+	JobName      string
+
+	Containers       int
+	ContainerSeconds float64
+
+	// CPUShareSeconds and MemoryByteSeconds weigh each container's lifetime by
+	// its limits. Containers without limits do not count towards them.
+	CPUShareSeconds   float64
+	MemoryByteSeconds float64
+
+	Volumes       int
+	VolumeSeconds float64
+
+	// VolumeBytes is the peak concurrent size of the volumes, i.e. the most
+	// disk space they took up at any one time in the period, and
+	// VolumeByteSeconds weighs each volume's lifetime by its size. Both go by
+	// the last reported size of each volume; volumes whose worker has not
+	// reported their size yet, and copy-on-write volumes, whose data mostly
+	// belongs to their parent, do not count towards them.
+	VolumeBytes       int64
+	VolumeByteSeconds float64
+}
+
+//go:generate counterfeiter . UsageRepository
+
+type UsageRepository interface {
+	CloseOrphanedUsage() (int, error)
+}
+
+type usageRepository struct {
+	conn Conn
+}
+
+func NewUsageRepository(conn Conn) UsageRepository {
+	return &usageRepository{
+		conn: conn,
+	}
+}
+
+// CloseOrphanedUsage ends the usage of containers and volumes which went
+// away without going through their destroying state, e.g. because they went
+// missing or their worker was pruned.
+func (repository *usageRepository) CloseOrphanedUsage() (int, error) {
+	containers, err := psql.Update("container_usage").
+		Set("destroyed_at", sq.Expr("now()")).
+		Where(sq.Eq{"destroyed_at": nil}).
+		Where("NOT EXISTS (SELECT 1 FROM containers c WHERE c.handle = container_usage.handle AND c.state IN ('creating', 'created'))").
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	closedContainers, err := containers.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	volumes, err := psql.Update("volume_usage").
+		Set("destroyed_at", sq.Expr("now()")).
+		Where(sq.Eq{"destroyed_at": nil}).
+		Where("NOT EXISTS (SELECT 1 FROM volumes v WHERE v.handle = volume_usage.handle AND v.state IN ('creating', 'created'))").
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	closedVolumes, err := volumes.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(closedContainers + closedVolumes), nil
+}
+
+func (t *team) Usage(from time.Time, to time.Time, grouping UsageGrouping) ([]Usage, error) {
+	var groupColumns []string
+	switch grouping {
+	case UsageGroupingPipeline:
+		groupColumns = []string{"pipeline_name"}
+	case UsageGroupingJob:
+		groupColumns = []string{"pipeline_name", "job_name"}
+	}
+
+	usages := map[[2]string]*Usage{}
+	keys := [][2]string{}
+
+	usageFor := func(pipelineName string, jobName string) *Usage {
+		key := [2]string{pipelineName, jobName}
+
+		usage, found := usages[key]
+		if !found {
+			usage = &Usage{PipelineName: pipelineName, JobName: jobName}
+			usages[key] = usage
+			keys = append(keys, key)
+		}
+
+		return usage
+	}
+
+	columns := groupedUsageColumns("cu", groupColumns)
+	groupBy := columns[:len(groupColumns)]
+
+	containerSeconds := usageSeconds("cu", from, to)
+
+	rows, err := psql.Select(columns...).
+		Columns(
+			"COUNT(*)",
+			"COALESCE(SUM("+containerSeconds+"), 0)",
+			"COALESCE(SUM(cu.cpu_limit * "+containerSeconds+"), 0)",
+			"COALESCE(SUM(cu.memory_limit * "+containerSeconds+"), 0)",
+		).
+		From("container_usage cu").
+		Where(sq.Eq{"cu.team_id": t.id}).
+		Where(usageOverlaps("cu", from, to)).
+		GroupBy(groupBy...).
+		OrderBy(groupBy...).
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var pipelineName, jobName string
+		var containers int
+		var seconds, cpuShareSeconds, memoryByteSeconds float64
+
+		err = rows.Scan(&pipelineName, &jobName, &containers, &seconds, &cpuShareSeconds, &memoryByteSeconds)
+		if err != nil {
+			return nil, err
+		}
+
+		usage := usageFor(pipelineName, jobName)
+		usage.Containers = containers
+		usage.ContainerSeconds = seconds
+		usage.CPUShareSeconds = cpuShareSeconds
+		usage.MemoryByteSeconds = memoryByteSeconds
+	}
+
+	// volumes count towards the pipeline and job of the container they were
+	// created for, if any
+	volumeSeconds := usageSeconds("vu", from, to)
+
+	rows, err = psql.Select(columns...).
+		Columns(
+			"COUNT(*)",
+			"COALESCE(SUM("+volumeSeconds+"), 0)",
+			"COALESCE(SUM(vu.size_bytes * "+volumeSeconds+"), 0)",
+		).
+		From("volume_usage vu").
+		LeftJoin("container_usage cu ON cu.handle = vu.container_handle").
+		Where(sq.Eq{"vu.team_id": t.id}).
+		Where(usageOverlaps("vu", from, to)).
+		GroupBy(groupBy...).
+		OrderBy(groupBy...).
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var pipelineName, jobName string
+		var volumes int
+		var seconds, byteSeconds float64
+
+		err = rows.Scan(&pipelineName, &jobName, &volumes, &seconds, &byteSeconds)
+		if err != nil {
+			return nil, err
+		}
+
+		usage := usageFor(pipelineName, jobName)
+		usage.Volumes = volumes
+		usage.VolumeSeconds = seconds
+		usage.VolumeByteSeconds = byteSeconds
+	}
+
+	// the peak concurrent size is the highest running total of the volumes'
+	// sizes, adding each volume's size when it is created and subtracting it
+	// again when it is destroyed; at the same point in time destroyed volumes
+	// are subtracted first
+	window := "ORDER BY e.at, e.delta"
+	if len(groupBy) > 0 {
+		window = "PARTITION BY " + strings.Join(groupBy, ", ") + " " + window
+	}
+
+	concurrentSizes := psql.Select(
+		columns[0]+" AS pipeline_name",
+		columns[1]+" AS job_name",
+		"SUM(e.delta) OVER ("+window+") AS concurrent_bytes",
+	).
+		From("volume_usage vu").
+		LeftJoin("container_usage cu ON cu.handle = vu.container_handle").
+		JoinClause(fmt.Sprintf(
+			"CROSS JOIN LATERAL (VALUES (%s, vu.size_bytes), (%s, -vu.size_bytes)) AS e(at, delta)",
+			usageStart("vu", from),
+			usageEnd("vu", to),
+		)).
+		Where(sq.Eq{"vu.team_id": t.id}).
+		Where(sq.Gt{"vu.size_bytes": 0}).
+		Where(usageOverlaps("vu", from, to))
+
+	rows, err = psql.Select("pipeline_name", "job_name", "MAX(concurrent_bytes)").
+		FromSelect(concurrentSizes, "c").
+		GroupBy("pipeline_name", "job_name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var pipelineName, jobName string
+		var bytes int64
+
+		err = rows.Scan(&pipelineName, &jobName, &bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		usageFor(pipelineName, jobName).VolumeBytes = bytes
+	}
+
+	result := []Usage{}
+	for _, key := range keys {
+		result = append(result, *usages[key])
+	}
+
+	return result, nil
+}
+
+// groupedUsageColumns selects the pipeline and job names, blanking out the
+// ones which are not grouped by.
+func groupedUsageColumns(table string, groupColumns []string) []string {
+	columns := []string{}
+	for _, column := range groupColumns {
+		columns = append(columns, "COALESCE("+table+"."+column+", '')")
+	}
+
+	for len(columns) < 2 {
+		columns = append(columns, "''")
+	}
+
+	return columns
+}
+
+// usageSeconds is the number of seconds of a usage row's lifetime between
+// from and to. Rows which have not been destroyed yet are still being used.
+func usageSeconds(table string, from time.Time, to time.Time) string {
+	return fmt.Sprintf("EXTRACT(EPOCH FROM %s - %s)", usageEnd(table, to), usageStart(table, from))
+}
+
+// usageStart is when a usage row's lifetime starts within the period.
+func usageStart(table string, from time.Time) string {
+	return fmt.Sprintf("GREATEST(%s.created_at, to_timestamp(%d))", table, from.Unix())
+}
+
+// usageEnd is when a usage row's lifetime ends within the period.
+func usageEnd(table string, to time.Time) string {
+	return fmt.Sprintf("LEAST(COALESCE(%s.destroyed_at, now()), to_timestamp(%d))", table, to.Unix())
+}
+
+func usageOverlaps(table string, from time.Time, to time.Time) sq.Sqlizer {
+	return sq.And{
+		sq.Lt{table + ".created_at": to},
+		sq.Or{
+			sq.Eq{table + ".destroyed_at": nil},
+			sq.Gt{table + ".destroyed_at": from},
+		},
+	}
+}
+
+func recordContainerUsage(runner sq.BaseRunner, handle string, teamID int, workerName string, meta ContainerMetadata) error {
+	usage := map[string]interface{}{
+		"handle":        handle,
+		"team_id":       teamID,
+		"worker_name":   workerName,
+		"type":          string(meta.Type),
+		"pipeline_name": meta.PipelineName,
+		"job_name":      meta.JobName,
+		"build_name":    meta.BuildName,
+		"cpu_limit":     meta.CPULimit,
+		"memory_limit":  meta.MemoryLimit,
+	}
+
+	if meta.PipelineID != 0 {
+		usage["pipeline_id"] = meta.PipelineID
+	}
+
+	if meta.JobID != 0 {
+		usage["job_id"] = meta.JobID
+	}
+
+	if meta.BuildID != 0 {
+		usage["build_id"] = meta.BuildID
+	}
+
+	_, err := psql.Insert("container_usage").
+		SetMap(usage).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+func closeContainerUsage(runner sq.BaseRunner, handle string) error {
+	_, err := psql.Update("container_usage").
+		Set("destroyed_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"handle":       handle,
+			"destroyed_at": nil,
+		}).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+// recordVolumeUsage only accounts for volumes which belong to a team; shared
+// volumes like resource caches are not charged to anyone.
+func recordVolumeUsage(runner sq.BaseRunner, handle string, teamID int, workerName string, containerID interface{}) error {
+	if teamID == 0 {
+		return nil
+	}
+
+	var containerHandle interface{}
+	if containerID != nil {
+		containerHandle = sq.Expr("(SELECT handle FROM containers WHERE id = ?)", containerID)
+	}
+
+	_, err := psql.Insert("volume_usage").
+		Columns("handle", "team_id", "worker_name", "container_handle").
+		Values(handle, teamID, workerName, containerHandle).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+// recordVolumeSize ignores copy-on-write volumes. They're measured as if they
+// held all of their parent's data, which is already accounted for.
+func recordVolumeSize(runner sq.BaseRunner, workerName string, handle string, size int64) error {
+	_, err := psql.Update("volume_usage").
+		Set("size_bytes", size).
+		Where(sq.Eq{
+			"handle":       handle,
+			"worker_name":  workerName,
+			"destroyed_at": nil,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM volumes v WHERE v.handle = volume_usage.handle AND v.parent_id IS NOT NULL)").
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+func closeVolumeUsage(runner sq.BaseRunner, handle string) error {
+	_, err := psql.Update("volume_usage").
+		Set("destroyed_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"handle":       handle,
+			"destroyed_at": nil,
+		}).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
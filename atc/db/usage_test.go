@@ -0,0 +1,206 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage", func() {
+	var (
+		build     db.Build
+		container db.CreatingContainer
+	)
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultJob.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		container, err = defaultTeam.CreateContainer(
+			defaultWorker.Name(),
+			db.NewBuildStepContainerOwner(build.ID(), "some-plan"),
+			db.ContainerMetadata{
+				Type:         db.ContainerTypeTask,
+				PipelineID:   defaultPipeline.ID(),
+				PipelineName: defaultPipeline.Name(),
+				JobID:        defaultJob.ID(),
+				JobName:      defaultJob.Name(),
+				BuildID:      build.ID(),
+				BuildName:    build.Name(),
+				CPULimit:     512,
+				MemoryLimit:  1024,
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "some-path")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Usage", func() {
+		It("accounts for the containers and volumes of every job", func() {
+			usage, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingJob)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(HaveLen(1))
+			Expect(usage[0].PipelineName).To(Equal(defaultPipeline.Name()))
+			Expect(usage[0].JobName).To(Equal(defaultJob.Name()))
+			Expect(usage[0].Containers).To(Equal(1))
+			Expect(usage[0].Volumes).To(Equal(1))
+		})
+
+		It("does not break down the usage without a grouping", func() {
+			usage, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(HaveLen(1))
+			Expect(usage[0].PipelineName).To(BeEmpty())
+			Expect(usage[0].JobName).To(BeEmpty())
+			Expect(usage[0].Containers).To(Equal(1))
+		})
+
+		It("weighs the container lifetime by its limits", func() {
+			time.Sleep(time.Second)
+
+			usage, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage[0].ContainerSeconds).To(BeNumerically(">", 0))
+			Expect(usage[0].CPUShareSeconds).To(BeNumerically("~", 512*usage[0].ContainerSeconds, 1))
+			Expect(usage[0].MemoryByteSeconds).To(BeNumerically("~", 1024*usage[0].ContainerSeconds, 1))
+		})
+
+		Context("when the size of the volume has been reported", func() {
+			BeforeEach(func() {
+				reportVolumeSizes(2048)
+			})
+
+			It("weighs the volume lifetime by its size", func() {
+				time.Sleep(time.Second)
+
+				usage, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage[0].VolumeBytes).To(Equal(int64(2048)))
+				Expect(usage[0].VolumeSeconds).To(BeNumerically(">", 0))
+				Expect(usage[0].VolumeByteSeconds).To(BeNumerically("~", 2048*usage[0].VolumeSeconds, 1))
+			})
+		})
+
+		Context("when volumes have been used at the same time", func() {
+			BeforeEach(func() {
+				creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "other-path")
+				Expect(err).NotTo(HaveOccurred())
+
+				createdVolume, err := creatingVolume.Created()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = createdVolume.CreateChildForContainer(container, "child-path")
+				Expect(err).NotTo(HaveOccurred())
+
+				reportVolumeSizes(1024)
+			})
+
+			It("counts their peak concurrent size, leaving out copy-on-write volumes", func() {
+				usage, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage[0].Volumes).To(Equal(3))
+				Expect(usage[0].VolumeBytes).To(Equal(int64(2048)))
+			})
+		})
+
+		Context("when volumes have been used one after the other", func() {
+			BeforeEach(func() {
+				reportVolumeSizes(1024)
+
+				creatingVolume, _, err := volumeRepository.FindContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "some-path")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = creatingVolume.Failed()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "other-path")
+				Expect(err).NotTo(HaveOccurred())
+
+				reportVolumeSizes(1024)
+			})
+
+			It("counts the size of only one of them", func() {
+				usage, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage[0].Volumes).To(Equal(2))
+				Expect(usage[0].VolumeBytes).To(Equal(int64(1024)))
+			})
+		})
+
+		It("ignores usage outside of the time range", func() {
+			usage, err := defaultTeam.Usage(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), db.UsageGroupingNone)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(BeEmpty())
+		})
+
+		Context("when the container has been destroyed", func() {
+			BeforeEach(func() {
+				createdContainer, err := container.Created()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = createdContainer.Destroying()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("stops counting its lifetime", func() {
+				before, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+				Expect(err).NotTo(HaveOccurred())
+
+				time.Sleep(time.Second)
+
+				after, err := defaultTeam.Usage(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), db.UsageGroupingNone)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(after[0].ContainerSeconds).To(Equal(before[0].ContainerSeconds))
+			})
+		})
+	})
+
+	Describe("CloseOrphanedUsage", func() {
+		var usageRepository db.UsageRepository
+
+		BeforeEach(func() {
+			usageRepository = db.NewUsageRepository(dbConn)
+		})
+
+		It("leaves the usage of existing containers and volumes open", func() {
+			closed, err := usageRepository.CloseOrphanedUsage()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(closed).To(BeZero())
+		})
+
+		Context("when the worker has been pruned", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec("DELETE FROM workers WHERE name = $1", defaultWorker.Name())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("closes the usage of its containers and volumes", func() {
+				closed, err := usageRepository.CloseOrphanedUsage()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(closed).To(Equal(2))
+			})
+		})
+	})
+})
+
+// reportVolumeSizes reports the same size for every volume in use.
+func reportVolumeSizes(size int64) {
+	volumes, err := dbConn.Query("SELECT handle FROM volume_usage WHERE destroyed_at IS NULL")
+	Expect(err).NotTo(HaveOccurred())
+
+	sizes := map[string]int64{}
+	for volumes.Next() {
+		var handle string
+		Expect(volumes.Scan(&handle)).To(Succeed())
+		sizes[handle] = size
+	}
+	Expect(volumes.Close()).To(Succeed())
+
+	err = volumeRepository.RecordVolumeSizes(defaultWorker.Name(), sizes)
+	Expect(err).NotTo(HaveOccurred())
+}
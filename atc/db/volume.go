@@ -130,6 +130,11 @@ func (volume *creatingVolume) Failed() (FailedVolume, error) {
 		return nil, err
 	}
 
+	err = closeVolumeUsage(volume.conn, volume.handle)
+	if err != nil {
+		return nil, err
+	}
+
 	return &failedVolume{
 		id:         volume.id,
 		workerName: volume.workerName,
@@ -492,6 +497,11 @@ func (volume *createdVolume) CreateChildForContainer(container CreatingContainer
 		return nil, err
 	}
 
+	err = recordVolumeUsage(tx, handle.String(), volume.teamID, volume.workerName, container.ID())
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -532,6 +542,11 @@ func (volume *createdVolume) Destroying() (DestroyingVolume, error) {
 		return nil, err
 	}
 
+	err = closeVolumeUsage(volume.conn, volume.handle)
+	if err != nil {
+		return nil, err
+	}
+
 	return &destroyingVolume{
 		id:         volume.id,
 		workerName: volume.workerName,
@@ -42,6 +42,8 @@ type VolumeRepository interface {
 
 	UpdateVolumesMissingSince(workerName string, handles []string) error
 	RemoveMissingVolumes(time.Duration) (int, error)
+
+	RecordVolumeSizes(workerName string, sizes map[string]int64) error
 }
 
 type volumeRepository struct {
@@ -83,6 +85,28 @@ func (repository *volumeRepository) queryVolumeHandles(cond sq.Eq) ([]string, er
 	return handles, nil
 }
 
+// RecordVolumeSizes notes how many bytes each of the worker's volumes takes
+// up. Only the latest size of a volume is kept, and volumes which have since
+// been destroyed keep the size they had last. Copy-on-write volumes are
+// ignored, as their parent's data is counted already.
+func (repository *volumeRepository) RecordVolumeSizes(workerName string, sizes map[string]int64) error {
+	tx, err := repository.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for handle, size := range sizes {
+		err = recordVolumeSize(tx, workerName, handle, size)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (repository *volumeRepository) UpdateVolumesMissingSince(workerName string, reportedHandles []string) error {
 	// clear out missing_since for reported volumes
 	query, args, err := psql.Update("volumes").
@@ -542,17 +566,34 @@ func (repository *volumeRepository) createVolume(
 		columnValues = append(columnValues, teamID)
 	}
 
+	tx, err := repository.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
 	err = psql.Insert("volumes").
 		Columns(columnNames...). // hey, replace this with SetMap plz
 		Values(columnValues...).
 		Suffix("RETURNING id").
-		RunWith(repository.conn).
+		RunWith(tx).
 		QueryRow().
 		Scan(&volumeID)
 	if err != nil {
 		return nil, err
 	}
 
+	err = recordVolumeUsage(tx, handle.String(), teamID, workerName, columns["container_id"])
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return &creatingVolume{
 		workerName: workerName,
 
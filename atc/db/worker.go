@@ -12,6 +12,7 @@ import (
 var (
 	ErrWorkerNotPresent         = errors.New("worker-not-present-in-db")
 	ErrCannotPruneRunningWorker = errors.New("worker-not-stalled-for-pruning")
+	ErrWorkerNotQuarantined     = errors.New("worker-not-quarantined")
 )
 
 type WorkerState string
@@ -22,8 +23,31 @@ const (
 	WorkerStateLanding  = WorkerState("landing")
 	WorkerStateLanded   = WorkerState("landed")
 	WorkerStateRetiring = WorkerState("retiring")
+
+	// WorkerStateQuarantined is the state of a worker which was taken out of
+	// rotation because creating containers on it kept failing.
+	WorkerStateQuarantined = WorkerState("quarantined")
+)
+
+type MaintenanceBehaviour string
+
+const (
+	MaintenanceBehaviourLand   = MaintenanceBehaviour("land")
+	MaintenanceBehaviourRetire = MaintenanceBehaviour("retire")
 )
 
+// A MaintenanceWindow is a period of time during which a worker is taken out
+// of rotation, e.g. to patch its host.
+type MaintenanceWindow struct {
+	Start     time.Time
+	Duration  time.Duration
+	Behaviour MaintenanceBehaviour
+}
+
+func (window MaintenanceWindow) End() time.Time {
+	return window.Start.Add(window.Duration)
+}
+
 //go:generate counterfeiter . Worker
 
 type Worker interface {
@@ -38,14 +62,19 @@ type Worker interface {
 	HTTPSProxyURL() string
 	NoProxy() string
 	ActiveContainers() int
+	ActiveTasks() int
+	BuildContainers() int
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
+	Labels() map[string]string
 	TeamID() int
 	TeamName() string
 	StartTime() int64
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	QuarantineReason() string
+	MaintenanceWindow() (MaintenanceWindow, bool)
 
 	Reload() (bool, error)
 
@@ -53,6 +82,18 @@ type Worker interface {
 	Retire() error
 	Prune() error
 	Delete() error
+
+	Quarantine(reason string) (bool, error)
+	Unquarantine() error
+
+	RecordError() (int, error)
+	ResetErrors() error
+
+	ScheduleMaintenance(MaintenanceWindow) error
+	CancelMaintenance() error
+
+	IncreaseActiveTasks(limit int) (bool, error)
+	DecreaseActiveTasks() error
 }
 
 type worker struct {
@@ -67,15 +108,21 @@ type worker struct {
 	httpsProxyURL    string
 	noProxy          string
 	activeContainers int
+	activeTasks      int
+	buildContainers  int
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
+	labels           map[string]string
 	teamID           int
 	teamName         string
 	startTime        int64
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+	quarantineReason string
+
+	maintenanceWindow *MaintenanceWindow
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -89,12 +136,24 @@ func (worker *worker) HTTPProxyURL() string                    { return worker.h
 func (worker *worker) HTTPSProxyURL() string                   { return worker.httpsProxyURL }
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
+func (worker *worker) ActiveTasks() int                        { return worker.activeTasks }
+func (worker *worker) BuildContainers() int                    { return worker.buildContainers }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) Labels() map[string]string               { return worker.labels }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
+func (worker *worker) QuarantineReason() string                { return worker.quarantineReason }
+
+func (worker *worker) MaintenanceWindow() (MaintenanceWindow, bool) {
+	if worker.maintenanceWindow == nil {
+		return MaintenanceWindow{}, false
+	}
+
+	return *worker.maintenanceWindow, true
+}
 
 // TODO: normalize time values
 func (worker *worker) StartTime() int64     { return worker.startTime }
@@ -224,6 +283,201 @@ func (worker *worker) Delete() error {
 	return err
 }
 
+// Quarantine takes a running worker out of rotation until it is
+// unquarantined, and returns whether it did. Workers in any other state,
+// including ones which are already quarantined, are left alone.
+func (worker *worker) Quarantine(reason string) (bool, error) {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"state":             string(WorkerStateQuarantined),
+			"quarantine_reason": reason,
+		}).
+		Where(sq.Eq{
+			"name":  worker.name,
+			"state": string(WorkerStateRunning),
+		}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (worker *worker) Unquarantine() error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"state":              string(WorkerStateRunning),
+			"quarantine_reason":  nil,
+			"consecutive_errors": 0,
+		}).
+		Where(sq.Eq{
+			"name":  worker.name,
+			"state": string(WorkerStateQuarantined),
+		}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		var one int
+		err := psql.Select("1").From("workers").Where(sq.Eq{"name": worker.name}).
+			RunWith(worker.conn).
+			QueryRow().
+			Scan(&one)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrWorkerNotPresent
+			}
+			return err
+		}
+
+		return ErrWorkerNotQuarantined
+	}
+
+	return nil
+}
+
+// RecordError counts a failure to create a container on the worker and
+// returns how many have happened in a row.
+func (worker *worker) RecordError() (int, error) {
+	var consecutiveErrors int
+	err := psql.Update("workers").
+		Set("consecutive_errors", sq.Expr("consecutive_errors + 1")).
+		Where(sq.Eq{"name": worker.name}).
+		Suffix("RETURNING consecutive_errors").
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&consecutiveErrors)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrWorkerNotPresent
+		}
+		return 0, err
+	}
+
+	return consecutiveErrors, nil
+}
+
+// ResetErrors records that a container was created on the worker
+// successfully.
+func (worker *worker) ResetErrors() error {
+	_, err := psql.Update("workers").
+		Set("consecutive_errors", 0).
+		Where(sq.Eq{"name": worker.name}).
+		Where(sq.Gt{"consecutive_errors": 0}).
+		RunWith(worker.conn).
+		Exec()
+	return err
+}
+
+// ScheduleMaintenance replaces the worker's maintenance window, if any.
+func (worker *worker) ScheduleMaintenance(window MaintenanceWindow) error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"maintenance_start":     window.Start,
+			"maintenance_end":       window.End(),
+			"maintenance_behaviour": string(window.Behaviour),
+		}).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.maintenanceWindow = &window
+
+	return nil
+}
+
+func (worker *worker) CancelMaintenance() error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"maintenance_start":     nil,
+			"maintenance_end":       nil,
+			"maintenance_behaviour": nil,
+		}).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.maintenanceWindow = nil
+
+	return nil
+}
+
+// IncreaseActiveTasks records that a task has started running on the worker,
+// unless it is already running the given limit of them, in which case false
+// is returned. A limit of 0 means no limit.
+func (worker *worker) IncreaseActiveTasks(limit int) (bool, error) {
+	query := psql.Update("workers").
+		Set("active_tasks", sq.Expr("active_tasks + 1")).
+		Where(sq.Eq{"name": worker.name})
+
+	if limit > 0 {
+		query = query.Where(sq.Lt{"active_tasks": limit})
+	}
+
+	result, err := query.
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// DecreaseActiveTasks records that a task running on the worker has finished.
+func (worker *worker) DecreaseActiveTasks() error {
+	_, err := psql.Update("workers").
+		Set("active_tasks", sq.Expr("GREATEST(active_tasks - 1, 0)")).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	return err
+}
+
 func (worker *worker) ResourceCerts() (*UsedWorkerResourceCerts, bool, error) {
 	if worker.certsPath != nil {
 		wrc := &WorkerResourceCerts{
@@ -45,11 +45,18 @@ var workersQuery = psql.Select(`
 		w.resource_types,
 		w.platform,
 		w.tags,
+		w.labels,
 		t.name,
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.active_tasks,
+		w.quarantine_reason,
+		w.maintenance_start,
+		w.maintenance_end,
+		w.maintenance_behaviour,
+		(SELECT COUNT(*) FROM containers c WHERE c.worker_name = w.name AND c.build_id IS NOT NULL)
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -137,11 +144,18 @@ func scanWorker(worker *worker, row scannable) error {
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
+		labels        []byte
 		teamName      sql.NullString
 		teamID        sql.NullInt64
 		startTime     sql.NullInt64
 		expiresAt     *time.Time
 		ephemeral     sql.NullBool
+
+		quarantineReason sql.NullString
+
+		maintenanceStart     *time.Time
+		maintenanceEnd       *time.Time
+		maintenanceBehaviour sql.NullString
 	)
 
 	err := row.Scan(
@@ -158,11 +172,18 @@ func scanWorker(worker *worker, row scannable) error {
 		&resourceTypes,
 		&platform,
 		&tags,
+		&labels,
 		&teamName,
 		&teamID,
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&worker.activeTasks,
+		&quarantineReason,
+		&maintenanceStart,
+		&maintenanceEnd,
+		&maintenanceBehaviour,
+		&worker.buildContainers,
 	)
 	if err != nil {
 		return err
@@ -185,6 +206,16 @@ func scanWorker(worker *worker, row scannable) error {
 	}
 
 	worker.state = WorkerState(state)
+	worker.quarantineReason = quarantineReason.String
+
+	worker.maintenanceWindow = nil
+	if maintenanceStart != nil && maintenanceEnd != nil {
+		worker.maintenanceWindow = &MaintenanceWindow{
+			Start:     *maintenanceStart,
+			Duration:  maintenanceEnd.Sub(*maintenanceStart),
+			Behaviour: MaintenanceBehaviour(maintenanceBehaviour.String),
+		}
+	}
 
 	if startTime.Valid {
 		worker.startTime = startTime.Int64
@@ -227,7 +258,12 @@ func scanWorker(worker *worker, row scannable) error {
 		return err
 	}
 
-	return json.Unmarshal(tags, &worker.tags)
+	err = json.Unmarshal(tags, &worker.tags)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(labels, &worker.labels)
 }
 
 func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
@@ -252,6 +288,7 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		When("'landing'::worker_state", "'landing'::worker_state").
 		When("'landed'::worker_state", "'landed'::worker_state").
 		When("'retiring'::worker_state", "'retiring'::worker_state").
+		When("'quarantined'::worker_state", "'quarantined'::worker_state").
 		Else("'running'::worker_state").
 		ToSql()
 
@@ -326,6 +363,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	labels, err := json.Marshal(atcWorker.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -341,10 +383,22 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 
 	currWorker, found, err := getWorker(tx, workersQuery.Where(sq.Eq{"w.name": atcWorker.Name}))
 
+	var quarantineReason string
+	var maintenanceWindow *MaintenanceWindow
 	if found {
+		if window, scheduled := currWorker.MaintenanceWindow(); scheduled {
+			maintenanceWindow = &window
+		}
+
 		if (currWorker.State() == WorkerStateLanding || currWorker.State() == WorkerStateRetiring) && atcWorker.State == "" {
 			workerState = currWorker.State()
 		}
+
+		// re-registering does not fix whatever got the worker quarantined
+		if currWorker.State() == WorkerStateQuarantined && atcWorker.State == "" {
+			workerState = currWorker.State()
+			quarantineReason = currWorker.QuarantineReason()
+		}
 	}
 
 	var workerVersion *string
@@ -357,6 +411,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.ActiveContainers,
 		resourceTypes,
 		tags,
+		labels,
 		atcWorker.Platform,
 		atcWorker.BaggageclaimURL,
 		atcWorker.CertsPath,
@@ -380,6 +435,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		conflictValues = append(conflictValues, *teamID)
 	}
 
+	// a worker which has restarted is no longer running any tasks, so its
+	// count of active tasks starts over
 	rows, err := psql.Insert("workers").
 		Columns(
 			"expires",
@@ -387,6 +444,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"active_containers",
 			"resource_types",
 			"tags",
+			"labels",
 			"platform",
 			"baggageclaim_url",
 			"certs_path",
@@ -408,6 +466,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				active_containers = ?,
 				resource_types = ?,
 				tags = ?,
+				labels = ?,
 				platform = ?,
 				baggageclaim_url = ?,
 				certs_path = ?,
@@ -419,7 +478,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				start_time = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				active_tasks = CASE
+					WHEN workers.start_time IS DISTINCT FROM EXCLUDED.start_time THEN 0
+					ELSE workers.active_tasks
+				END
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -457,11 +520,15 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
+		labels:           atcWorker.Labels,
 		teamName:         atcWorker.Team,
 		teamID:           workerTeamID,
 		startTime:        atcWorker.StartTime,
 		ephemeral:        atcWorker.Ephemeral,
+		quarantineReason: quarantineReason,
 		conn:             conn,
+
+		maintenanceWindow: maintenanceWindow,
 	}
 
 	workerBaseResourceTypeIDs := []int{}
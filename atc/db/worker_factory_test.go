@@ -42,6 +42,7 @@ var _ = Describe("WorkerFactory", func() {
 			},
 			Platform:  "some-platform",
 			Tags:      atc.Tags{"some", "tags"},
+			Labels:    map[string]string{"os": "linux"},
 			Name:      "some-name",
 			StartTime: 55,
 		}
@@ -272,6 +273,7 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.Labels()).To(Equal(map[string]string{"os": "linux"}))
 				Expect(foundWorker.StartTime()).To(Equal(int64(55)))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 			})
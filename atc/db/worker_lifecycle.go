@@ -13,6 +13,7 @@ type WorkerLifecycle interface {
 	StallUnresponsiveWorkers() ([]string, error)
 	LandFinishedLandingWorkers() ([]string, error)
 	DeleteFinishedRetiringWorkers() ([]string, error)
+	ReconcileActiveTasks() ([]string, error)
 }
 
 type workerLifecycle struct {
@@ -180,6 +181,44 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	return workersAffected(rows)
 }
 
+// ReconcileActiveTasks lowers the count of active tasks of workers which
+// cannot be running that many, i.e. which have fewer task containers for
+// running builds. Counts can otherwise be left behind by an ATC which went
+// away while its tasks were running.
+func (lifecycle *workerLifecycle) ReconcileActiveTasks() ([]string, error) {
+	subQ, subQArgs, err := sq.Select("COUNT(*)").
+		From("containers c").
+		Join("builds b ON b.id = c.build_id").
+		Where("c.worker_name = w.name").
+		Where(sq.Eq{
+			"c.meta_type": string(ContainerTypeTask),
+			"b.status": []string{
+				string(BuildStatusStarted),
+				string(BuildStatusPending),
+			},
+		}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := sq.Update("workers w").
+		Set("active_tasks", sq.Expr("("+subQ+")", subQArgs...)).
+		Where("w.active_tasks > ("+subQ+")", subQArgs...).
+		PlaceholderFormat(sq.Dollar).
+		Suffix("RETURNING w.name").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
 func workersAffected(rows *sql.Rows) ([]string, error) {
 	var (
 		err         error
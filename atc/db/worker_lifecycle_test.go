@@ -224,7 +224,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -256,7 +256,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -471,7 +471,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -503,7 +503,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -552,4 +552,96 @@ var _ = Describe("Worker Lifecycle", func() {
 			})
 		})
 	})
+
+	Describe("ReconcileActiveTasks", func() {
+		var dbWorker db.Worker
+
+		BeforeEach(func() {
+			var err error
+			dbWorker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				reserved, err := dbWorker.IncreaseActiveTasks(0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reserved).To(BeTrue())
+			}
+		})
+
+		activeTasks := func() int {
+			foundWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			return foundWorker.ActiveTasks()
+		}
+
+		Context("when the worker has no task containers for running builds", func() {
+			BeforeEach(func() {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = defaultTeam.CreateContainer(dbWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("4")), db.ContainerMetadata{
+					Type: db.ContainerTypeTask,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("resets the count", func() {
+				affected, err := workerLifecycle.ReconcileActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(affected).To(ConsistOf(atcWorker.Name))
+
+				Expect(activeTasks()).To(Equal(0))
+			})
+		})
+
+		Context("when the worker has task containers for running builds", func() {
+			BeforeEach(func() {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = build.Start("exec.v2", "{}", atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, planID := range []atc.PlanID{"4", "5"} {
+					_, err = defaultTeam.CreateContainer(dbWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), planID), db.ContainerMetadata{
+						Type: db.ContainerTypeTask,
+					})
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				_, err = defaultTeam.CreateContainer(dbWorker.Name(), db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("6")), db.ContainerMetadata{
+					Type: db.ContainerTypeGet,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("lowers the count to the number of them", func() {
+				_, err := workerLifecycle.ReconcileActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(activeTasks()).To(Equal(2))
+			})
+
+			Context("when the count is already below that", func() {
+				BeforeEach(func() {
+					err := dbWorker.DecreaseActiveTasks()
+					Expect(err).ToNot(HaveOccurred())
+					err = dbWorker.DecreaseActiveTasks()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("leaves it alone", func() {
+					affected, err := workerLifecycle.ReconcileActiveTasks()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(affected).To(BeEmpty())
+
+					Expect(activeTasks()).To(Equal(1))
+				})
+			})
+		})
+	})
 })
@@ -148,6 +148,270 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("IncreaseActiveTasks/DecreaseActiveTasks", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("counts the tasks running on the worker", func() {
+			Expect(worker.ActiveTasks()).To(Equal(0))
+
+			reserved, err := worker.IncreaseActiveTasks(0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reserved).To(BeTrue())
+			reserved, err = worker.IncreaseActiveTasks(0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reserved).To(BeTrue())
+			err = worker.DecreaseActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.ActiveTasks()).To(Equal(1))
+		})
+
+		It("does not go beyond the limit", func() {
+			reserved, err := worker.IncreaseActiveTasks(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reserved).To(BeTrue())
+
+			reserved, err = worker.IncreaseActiveTasks(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reserved).To(BeFalse())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.ActiveTasks()).To(Equal(1))
+		})
+
+		Context("when the worker registers again", func() {
+			BeforeEach(func() {
+				_, err := worker.IncreaseActiveTasks(0)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("keeps the count", func() {
+				_, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.ActiveTasks()).To(Equal(1))
+			})
+
+			Context("after restarting", func() {
+				It("resets the count", func() {
+					atcWorker.StartTime++
+
+					_, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = worker.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(worker.ActiveTasks()).To(Equal(0))
+				})
+			})
+		})
+
+		It("never goes below zero", func() {
+			err := worker.DecreaseActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.ActiveTasks()).To(Equal(0))
+		})
+	})
+
+	Describe("RecordError/ResetErrors", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("counts the errors in a row", func() {
+			errors, err := worker.RecordError()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors).To(Equal(1))
+
+			errors, err = worker.RecordError()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors).To(Equal(2))
+
+			err = worker.ResetErrors()
+			Expect(err).NotTo(HaveOccurred())
+
+			errors, err = worker.RecordError()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors).To(Equal(1))
+		})
+	})
+
+	Describe("Quarantine/Unquarantine", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("takes the worker out of rotation with a reason", func() {
+			quarantined, err := worker.Quarantine("too many errors")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quarantined).To(BeTrue())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateQuarantined))
+			Expect(worker.QuarantineReason()).To(Equal("too many errors"))
+		})
+
+		It("stays quarantined when the worker heartbeats or registers again", func() {
+			_, err := worker.Quarantine("too many errors")
+			Expect(err).NotTo(HaveOccurred())
+
+			heartbeated, err := workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(heartbeated.State()).To(Equal(WorkerStateQuarantined))
+
+			registered, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registered.State()).To(Equal(WorkerStateQuarantined))
+			Expect(registered.QuarantineReason()).To(Equal("too many errors"))
+		})
+
+		It("does not quarantine a worker which is not running", func() {
+			err := worker.Land()
+			Expect(err).NotTo(HaveOccurred())
+
+			quarantined, err := worker.Quarantine("too many errors")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quarantined).To(BeFalse())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateLanding))
+		})
+
+		It("does not quarantine a worker again", func() {
+			_, err := worker.Quarantine("too many errors")
+			Expect(err).NotTo(HaveOccurred())
+
+			quarantined, err := worker.Quarantine("even more errors")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quarantined).To(BeFalse())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.QuarantineReason()).To(Equal("too many errors"))
+		})
+
+		It("puts the worker back into rotation and forgets its errors", func() {
+			_, err := worker.RecordError()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Quarantine("too many errors")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = worker.Unquarantine()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+			Expect(worker.QuarantineReason()).To(BeEmpty())
+
+			errors, err := worker.RecordError()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors).To(Equal(1))
+		})
+
+		It("does not unquarantine a worker which is not quarantined", func() {
+			err := worker.Unquarantine()
+			Expect(err).To(Equal(ErrWorkerNotQuarantined))
+		})
+	})
+
+	Describe("ScheduleMaintenance/CancelMaintenance", func() {
+		var window MaintenanceWindow
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			window = MaintenanceWindow{
+				Start:     time.Unix(1542200000, 0),
+				Duration:  time.Hour,
+				Behaviour: MaintenanceBehaviourRetire,
+			}
+		})
+
+		It("does not have a maintenance window by default", func() {
+			_, scheduled := worker.MaintenanceWindow()
+			Expect(scheduled).To(BeFalse())
+		})
+
+		It("schedules the maintenance window", func() {
+			err := worker.ScheduleMaintenance(window)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			scheduledWindow, scheduled := worker.MaintenanceWindow()
+			Expect(scheduled).To(BeTrue())
+			Expect(scheduledWindow.Start.Unix()).To(Equal(window.Start.Unix()))
+			Expect(scheduledWindow.Duration).To(Equal(time.Hour))
+			Expect(scheduledWindow.Behaviour).To(Equal(MaintenanceBehaviourRetire))
+		})
+
+		It("keeps the maintenance window when the worker registers again", func() {
+			err := worker.ScheduleMaintenance(window)
+			Expect(err).NotTo(HaveOccurred())
+
+			registered, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, scheduled := registered.MaintenanceWindow()
+			Expect(scheduled).To(BeTrue())
+
+			_, err = registered.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, scheduled = registered.MaintenanceWindow()
+			Expect(scheduled).To(BeTrue())
+		})
+
+		It("cancels the maintenance window", func() {
+			err := worker.ScheduleMaintenance(window)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = worker.CancelMaintenance()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, scheduled := worker.MaintenanceWindow()
+			Expect(scheduled).To(BeFalse())
+		})
+
+		Context("when the worker has gone away", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns ErrWorkerNotPresent", func() {
+				Expect(worker.ScheduleMaintenance(window)).To(Equal(ErrWorkerNotPresent))
+				Expect(worker.CancelMaintenance()).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Prune", func() {
 		Context("when worker exists", func() {
 			DescribeTable("worker in state",
@@ -51,6 +51,45 @@ var VersionConfigDecodeHook = func(
 	return data, nil
 }
 
+var InParallelConfigDecodeHook = func(
+	srcType reflect.Type,
+	dstType reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if dstType != reflect.TypeOf(InParallelConfig{}) {
+		return data, nil
+	}
+
+	// a plain list of steps is shorthand for the steps with no limit
+	if srcType.Kind() == reflect.Slice {
+		return map[string]interface{}{
+			"steps": data,
+		}, nil
+	}
+
+	return data, nil
+}
+
+var BuildLogRetentionDecodeHook = func(
+	srcType reflect.Type,
+	dstType reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if dstType != reflect.TypeOf(BuildLogRetention{}) {
+		return data, nil
+	}
+
+	// a plain number is shorthand for the number of builds to retain
+	switch srcType.Kind() {
+	case reflect.Int, reflect.Int64, reflect.Uint64, reflect.Float64:
+		return map[string]interface{}{
+			"builds": data,
+		}, nil
+	}
+
+	return data, nil
+}
+
 var ContainerLimitsDecodeHook = func(
 	srcType reflect.Type,
 	dstType reflect.Type,
@@ -75,7 +114,7 @@ var SanitizeDecodeHook = func(
 ) (interface{}, error) {
 	if valKind == reflect.Map {
 		if dataKind == reflect.Map {
-			return sanitize(data)
+			return SanitizeYAML(data)
 		}
 	}
 
@@ -168,7 +207,10 @@ var ContainerLimitsParser = func(data interface{}) (ContainerLimits, error) {
 	return c, nil
 }
 
-func sanitize(root interface{}) (interface{}, error) {
+// SanitizeYAML converts the map[interface{}]interface{} values produced by the
+// yaml package into map[string]interface{} so that they can be marshalled to
+// JSON.
+func SanitizeYAML(root interface{}) (interface{}, error) {
 	switch rootVal := root.(type) {
 	case map[interface{}]interface{}:
 		sanitized := map[string]interface{}{}
@@ -179,7 +221,7 @@ func sanitize(root interface{}) (interface{}, error) {
 				return nil, errors.New("non-string key")
 			}
 
-			sub, err := sanitize(val)
+			sub, err := SanitizeYAML(val)
 			if err != nil {
 				return nil, err
 			}
@@ -192,7 +234,7 @@ func sanitize(root interface{}) (interface{}, error) {
 	case []interface{}:
 		sanitized := make([]interface{}, len(rootVal))
 		for i, val := range rootVal {
-			sub, err := sanitize(val)
+			sub, err := SanitizeYAML(val)
 			if err != nil {
 				return nil, err
 			}
@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"context"
+	"io"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -22,6 +25,39 @@ func (build *execBuild) buildAggregateStep(logger lager.Logger, plan atc.Plan) e
 	return agg
 }
 
+func (build *execBuild) buildInParallelStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("in-parallel")
+
+	steps := []exec.Step{}
+
+	for _, innerPlan := range plan.InParallel.Steps {
+		innerPlan.Attempts = plan.Attempts
+		steps = append(steps, build.buildStep(logger, innerPlan))
+	}
+
+	return exec.InParallel(steps, plan.InParallel.Limit, plan.InParallel.FailFast)
+}
+
+func (build *execBuild) buildAcrossStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("across", lager.Data{"var": plan.Across.Var})
+
+	steps := []exec.Step{}
+
+	for _, scopedPlan := range plan.Across.Steps {
+		scope := build.delegate.Variables().NewLocalScope()
+		scope.AddLocalVar(plan.Across.Var, scopedPlan.Value, plan.Across.Sensitive)
+
+		scopedBuild := *build
+		scopedBuild.delegate = build.delegate.WithVariables(scope)
+
+		innerPlan := scopedPlan.Step
+		innerPlan.Attempts = plan.Attempts
+		steps = append(steps, scopedBuild.buildStep(logger, innerPlan))
+	}
+
+	return exec.Across(steps, plan.Across.MaxInFlight, plan.Across.FailFast)
+}
+
 func (build *execBuild) buildDoStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	logger = logger.Session("do")
 
@@ -94,13 +130,15 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 		plan.Attempts,
 	)
 
-	return build.factory.Task(
+	delegate := build.delegate.TaskDelegate(plan.ID)
+
+	return closingDelegate(build.factory.Task(
 		logger,
 		plan,
 		build.dbBuild,
 		containerMetadata,
-		build.delegate.TaskDelegate(plan.ID),
-	)
+		delegate,
+	), delegate)
 }
 
 func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.Step {
@@ -114,14 +152,16 @@ func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.St
 		plan.Attempts,
 	)
 
-	return build.factory.Get(
+	delegate := build.delegate.GetDelegate(plan.ID)
+
+	return closingDelegate(build.factory.Get(
 		logger,
 		plan,
 		build.dbBuild,
 		build.stepMetadata,
 		containerMetadata,
-		build.delegate.GetDelegate(plan.ID),
-	)
+		delegate,
+	), delegate)
 }
 
 func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.Step {
@@ -135,14 +175,16 @@ func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.St
 		plan.Attempts,
 	)
 
-	return build.factory.Put(
+	delegate := build.delegate.PutDelegate(plan.ID)
+
+	return closingDelegate(build.factory.Put(
 		logger,
 		plan,
 		build.dbBuild,
 		build.stepMetadata,
 		containerMetadata,
-		build.delegate.PutDelegate(plan.ID),
-	)
+		delegate,
+	), delegate)
 }
 
 func (build *execBuild) buildRetryStep(logger lager.Logger, plan atc.Plan) exec.Step {
@@ -160,10 +202,73 @@ func (build *execBuild) buildRetryStep(logger lager.Logger, plan atc.Plan) exec.
 	return exec.Retry(steps...)
 }
 
+func (build *execBuild) buildSetPipelineStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("set-pipeline", lager.Data{
+		"name": plan.SetPipeline.Name,
+	})
+
+	delegate := build.delegate.BuildStepDelegate(plan.ID)
+
+	return closingDelegate(build.factory.SetPipeline(
+		logger,
+		plan,
+		build.dbBuild,
+		delegate,
+	), delegate)
+}
+
+func (build *execBuild) buildLoadVarStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("load-var", lager.Data{
+		"name": plan.LoadVar.Name,
+	})
+
+	delegate := build.delegate.BuildStepDelegate(plan.ID)
+
+	return closingDelegate(build.factory.LoadVar(
+		logger,
+		plan,
+		delegate,
+	), delegate)
+}
+
 func (build *execBuild) buildUserArtifactStep(logger lager.Logger, plan atc.Plan) exec.Step {
-	return exec.UserArtifact(plan.ID, worker.ArtifactName(plan.UserArtifact.Name), build.delegate.BuildStepDelegate(plan.ID))
+	delegate := build.delegate.BuildStepDelegate(plan.ID)
+	return closingDelegate(exec.UserArtifact(plan.ID, worker.ArtifactName(plan.UserArtifact.Name), delegate), delegate)
 }
 
 func (build *execBuild) buildArtifactOutputStep(logger lager.Logger, plan atc.Plan) exec.Step {
-	return exec.ArtifactOutput(plan.ID, worker.ArtifactName(plan.ArtifactOutput.Name), build.delegate.BuildStepDelegate(plan.ID))
+	delegate := build.delegate.BuildStepDelegate(plan.ID)
+	return closingDelegate(exec.ArtifactOutput(plan.ID, worker.ArtifactName(plan.ArtifactOutput.Name), delegate), delegate)
+}
+
+// closingDelegate closes the step's delegate once the step has run, if the
+// delegate needs closing, so that output held back from its event writers is
+// saved.
+func closingDelegate(step exec.Step, delegate interface{}) exec.Step {
+	closer, ok := delegate.(io.Closer)
+	if !ok {
+		return step
+	}
+
+	return delegateClosingStep{
+		Step:     step,
+		delegate: closer,
+	}
+}
+
+type delegateClosingStep struct {
+	exec.Step
+
+	delegate io.Closer
+}
+
+func (step delegateClosingStep) Run(ctx context.Context, state exec.RunState) error {
+	err := step.Step.Run(ctx, state)
+
+	closeErr := step.delegate.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	return err
 }
@@ -9,26 +9,34 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 )
 
 type BuildStepDelegate struct {
-	build  db.Build
-	planID atc.PlanID
-	clock  clock.Clock
+	build     db.Build
+	planID    atc.PlanID
+	variables *creds.BuildVariables
+	clock     clock.Clock
+
+	writersL sync.Mutex
+	stdout   *dbEventWriter
+	stderr   *dbEventWriter
 }
 
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
+	variables *creds.BuildVariables,
 	clock clock.Clock,
 ) *BuildStepDelegate {
 	return &BuildStepDelegate{
-		build:  build,
-		planID: planID,
-		clock:  clock,
+		build:     build,
+		planID:    planID,
+		variables: variables,
+		clock:     clock,
 	}
 }
 
@@ -37,25 +45,61 @@ func (delegate *BuildStepDelegate) ImageVersionDetermined(resourceCache db.UsedR
 }
 
 func (delegate *BuildStepDelegate) Stdout() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStdout,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	delegate.writersL.Lock()
+	defer delegate.writersL.Unlock()
+
+	if delegate.stdout == nil {
+		delegate.stdout = newDBEventWriter(
+			delegate.build,
+			event.Origin{
+				Source: event.OriginSourceStdout,
+				ID:     event.OriginID(delegate.planID),
+			},
+			delegate.variables,
+			delegate.clock,
+		)
+	}
+
+	return delegate.stdout
 }
 
 func (delegate *BuildStepDelegate) Stderr() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStderr,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	delegate.writersL.Lock()
+	defer delegate.writersL.Unlock()
+
+	if delegate.stderr == nil {
+		delegate.stderr = newDBEventWriter(
+			delegate.build,
+			event.Origin{
+				Source: event.OriginSourceStderr,
+				ID:     event.OriginID(delegate.planID),
+			},
+			delegate.variables,
+			delegate.clock,
+		)
+	}
+
+	return delegate.stderr
+}
+
+// Close saves any output still held back by the step's stdout and stderr
+// writers. It is called once the step has finished running.
+func (delegate *BuildStepDelegate) Close() error {
+	delegate.writersL.Lock()
+	defer delegate.writersL.Unlock()
+
+	for _, writer := range []*dbEventWriter{delegate.stdout, delegate.stderr} {
+		if writer == nil {
+			continue
+		}
+
+		err := writer.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string) {
@@ -70,11 +114,20 @@ func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
-func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.Writer {
+func (delegate *BuildStepDelegate) SelectedWorker(logger lager.Logger, workerName string) {
+	logger.Debug("selected-worker", lager.Data{"worker": workerName})
+}
+
+func (delegate *BuildStepDelegate) Variables() *creds.BuildVariables {
+	return delegate.variables
+}
+
+func newDBEventWriter(build db.Build, origin event.Origin, variables *creds.BuildVariables, clock clock.Clock) *dbEventWriter {
 	return &dbEventWriter{
-		build:  build,
-		origin: origin,
-		clock:  clock,
+		build:     build,
+		origin:    origin,
+		variables: variables,
+		clock:     clock,
 	}
 }
 
@@ -83,12 +136,18 @@ type dbEventWriter struct {
 
 	origin event.Origin
 
-	dangling []byte
+	variables *creds.BuildVariables
+
+	danglingL sync.Mutex
+	dangling  []byte
 
 	clock clock.Clock
 }
 
 func (writer *dbEventWriter) Write(data []byte) (int, error) {
+	writer.danglingL.Lock()
+	defer writer.danglingL.Unlock()
+
 	text := append(writer.dangling, data...)
 
 	checkEncoding, _ := utf8.DecodeLastRune(text)
@@ -97,13 +156,20 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 		return len(data), nil
 	}
 
+	// a sensitive var's value may be split across writes, so hold back
+	// anything that may be the start of one until the next write
+	payload, held := writer.variables.RedactChunk(string(text))
+
 	writer.dangling = nil
+	if held != "" {
+		writer.dangling = []byte(held)
+	}
 
-	err := writer.build.SaveEvent(event.Log{
-		Time:    writer.clock.Now().Unix(),
-		Payload: string(text),
-		Origin:  writer.origin,
-	})
+	if payload == "" {
+		return len(data), nil
+	}
+
+	err := writer.saveLog(payload)
 	if err != nil {
 		return 0, err
 	}
@@ -111,6 +177,30 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 	return len(data), nil
 }
 
+// Close saves whatever was held back from previous writes, i.e. a trailing
+// partial rune or the start of a sensitive value which never completed.
+func (writer *dbEventWriter) Close() error {
+	writer.danglingL.Lock()
+	defer writer.danglingL.Unlock()
+
+	if len(writer.dangling) == 0 {
+		return nil
+	}
+
+	payload := writer.variables.Redact(string(writer.dangling))
+	writer.dangling = nil
+
+	return writer.saveLog(payload)
+}
+
+func (writer *dbEventWriter) saveLog(payload string) error {
+	return writer.build.SaveEvent(event.Log{
+		Time:    writer.clock.Now().Unix(),
+		Payload: payload,
+		Origin:  writer.origin,
+	})
+}
+
 type implicitOutput struct {
 	resourceType string
 	info         exec.VersionInfo
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/clock/fakeclock"
 
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/event"
@@ -16,8 +17,9 @@ import (
 
 var _ = Describe("BuildStepDelegate", func() {
 	var (
-		fakeBuild *dbfakes.FakeBuild
-		fakeClock *fakeclock.FakeClock
+		fakeBuild      *dbfakes.FakeBuild
+		fakeClock      *fakeclock.FakeClock
+		buildVariables *creds.BuildVariables
 
 		delegate *engine.BuildStepDelegate
 	)
@@ -25,7 +27,8 @@ var _ = Describe("BuildStepDelegate", func() {
 	BeforeEach(func() {
 		fakeBuild = new(dbfakes.FakeBuild)
 		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
-		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", fakeClock)
+		buildVariables = creds.NewBuildVariables()
+		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", buildVariables, fakeClock)
 	})
 
 	Describe("ImageVersionDetermined", func() {
@@ -84,6 +87,86 @@ var _ = Describe("BuildStepDelegate", func() {
 				})
 			})
 
+			Context("when a sensitive build-local var is written", func() {
+				BeforeEach(func() {
+					buildVariables.AddLocalVar("greeting", "ell", true)
+					buildVariables.AddLocalVar("unredacted", "llo", false)
+				})
+
+				It("saves a log event with the value redacted", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "h((redacted))o",
+						Origin: event.Origin{
+							Source: event.OriginSourceStdout,
+							ID:     "some-plan-id",
+						},
+					}))
+				})
+			})
+
+			Context("when a sensitive build-local var is split across writes", func() {
+				BeforeEach(func() {
+					buildVariables.AddLocalVar("secret", "llo world", true)
+				})
+
+				It("holds back the start of the value until it is redacted", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("he"))
+
+					_, err := writer.Write([]byte(" wor"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+					_, err = writer.Write([]byte("ld!\n"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+					Expect(fakeBuild.SaveEventArgsForCall(1).(event.Log).Payload).To(Equal("((redacted))!\n"))
+				})
+			})
+
+			Context("when the writer still holds back output when the step finishes", func() {
+				BeforeEach(func() {
+					buildVariables.AddLocalVar("secret", "llo world", true)
+				})
+
+				It("saves the held back output when the delegate is closed", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("he"))
+
+					Expect(delegate.Close()).To(Succeed())
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+					Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "llo",
+						Origin: event.Origin{
+							Source: event.OriginSourceStdout,
+							ID:     "some-plan-id",
+						},
+					}))
+				})
+			})
+
+			Context("when the output ends with a partial rune", func() {
+				It("saves it when the delegate is closed", func() {
+					_, err := writer.Write([]byte("\xe2\x82"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+					Expect(delegate.Close()).To(Succeed())
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+					Expect(fakeBuild.SaveEventArgsForCall(1).(event.Log).Payload).To(Equal("\xe2\x82"))
+				})
+			})
+
+			Context("when nothing is held back when the step finishes", func() {
+				It("does not save another event when the delegate is closed", func() {
+					Expect(delegate.Close()).To(Succeed())
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when saving the event succeeds", func() {
 				disaster := errors.New("nope")
 
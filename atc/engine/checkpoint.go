@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/concourse/concourse/atc/exec"
+)
+
+// errHandedOff is returned by steps which were held back when their build was
+// handed off to another ATC.
+var errHandedOff = errors.New("build handed off")
+
+// checkpoint keeps track of the steps of a build which are in flight, so that
+// when the ATC is drained the build can be handed off in between steps rather
+// than in the middle of one.
+type checkpoint struct {
+	lock     sync.Mutex
+	running  int
+	draining bool
+	idle     chan struct{}
+	released chan struct{}
+}
+
+func newCheckpoint() *checkpoint {
+	return &checkpoint{
+		idle:     make(chan struct{}),
+		released: make(chan struct{}),
+	}
+}
+
+// enter registers a step as running. It returns false if the build is being
+// drained, in which case the step must not be started.
+func (c *checkpoint) enter() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.draining {
+		return false
+	}
+
+	select {
+	case <-c.released:
+		return false
+	default:
+	}
+
+	c.running++
+
+	return true
+}
+
+func (c *checkpoint) leave() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.running--
+
+	if c.draining && c.running == 0 {
+		close(c.idle)
+	}
+}
+
+// drain prevents any more steps from starting, and returns a channel which is
+// closed once the steps that are already running have finished.
+func (c *checkpoint) drain() <-chan struct{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.draining {
+		c.draining = true
+
+		if c.running == 0 {
+			close(c.idle)
+		}
+	}
+
+	return c.idle
+}
+
+// release is called once the build has been handed off or released. It
+// prevents any more steps from starting, and lets the steps that were held
+// back by drain return.
+func (c *checkpoint) release() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	select {
+	case <-c.released:
+	default:
+		close(c.released)
+	}
+}
+
+type checkpointedStep struct {
+	exec.Step
+
+	checkpoint *checkpoint
+}
+
+func (step checkpointedStep) Run(ctx context.Context, state exec.RunState) error {
+	if !step.checkpoint.enter() {
+		// the build is being handed off; whichever ATC picks it up will run
+		// this step instead
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-step.checkpoint.released:
+			return errHandedOff
+		}
+	}
+
+	defer step.checkpoint.leave()
+
+	return step.Step.Run(ctx, state)
+}
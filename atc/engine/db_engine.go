@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/metric"
 )
 
@@ -17,10 +18,14 @@ const trackLockDuration = time.Minute
 
 func NewDBEngine(engines Engines, peerURL string) Engine {
 	return &dbEngine{
-		engines:   engines,
-		peerURL:   peerURL,
-		releaseCh: make(chan struct{}),
-		waitGroup: new(sync.WaitGroup),
+		engines:     engines,
+		peerURL:     peerURL,
+		releaseCh:   make(chan struct{}),
+		releaseOnce: new(sync.Once),
+		drainCh:     make(chan struct{}),
+		drainOnce:   new(sync.Once),
+		waitGroup:   new(sync.WaitGroup),
+		trackingL:   new(sync.Mutex),
 	}
 }
 
@@ -33,10 +38,18 @@ func (err UnknownEngineError) Error() string {
 }
 
 type dbEngine struct {
-	engines   Engines
-	peerURL   string
-	releaseCh chan struct{}
-	waitGroup *sync.WaitGroup
+	engines     Engines
+	peerURL     string
+	releaseCh   chan struct{}
+	releaseOnce *sync.Once
+	drainCh     chan struct{}
+	drainOnce   *sync.Once
+	waitGroup   *sync.WaitGroup
+
+	// trackingL is held while builds start being tracked and while the engine
+	// is drained or released, so that no build is added to the wait group
+	// once it is being waited on.
+	trackingL *sync.Mutex
 }
 
 func (*dbEngine) Name() string {
@@ -64,7 +77,9 @@ func (engine *dbEngine) CreateBuild(logger lager.Logger, build db.Build, plan at
 		engines:   engine.engines,
 		peerURL:   engine.peerURL,
 		releaseCh: engine.releaseCh,
+		drainCh:   engine.drainCh,
 		waitGroup: engine.waitGroup,
+		trackingL: engine.trackingL,
 		build:     build,
 	}, nil
 }
@@ -74,7 +89,9 @@ func (engine *dbEngine) LookupBuild(logger lager.Logger, build db.Build) (Build,
 		engines:   engine.engines,
 		peerURL:   engine.peerURL,
 		releaseCh: engine.releaseCh,
+		drainCh:   engine.drainCh,
 		waitGroup: engine.waitGroup,
+		trackingL: engine.trackingL,
 		build:     build,
 	}, nil
 }
@@ -82,7 +99,11 @@ func (engine *dbEngine) LookupBuild(logger lager.Logger, build db.Build) (Build,
 func (engine *dbEngine) ReleaseAll(logger lager.Logger) {
 	logger.Info("calling-release-on-builds")
 
-	close(engine.releaseCh)
+	engine.trackingL.Lock()
+	engine.releaseOnce.Do(func() {
+		close(engine.releaseCh)
+	})
+	engine.trackingL.Unlock()
 
 	logger.Info("waiting-on-builds")
 
@@ -95,12 +116,34 @@ func (engine *dbEngine) ReleaseAll(logger lager.Logger) {
 	logger.Info("finished-waiting-on-builds")
 }
 
+func (engine *dbEngine) Drain(logger lager.Logger) {
+	logger.Info("calling-drain-on-builds")
+
+	engine.trackingL.Lock()
+	engine.drainOnce.Do(func() {
+		close(engine.drainCh)
+	})
+	engine.trackingL.Unlock()
+
+	for _, e := range engine.engines {
+		e.Drain(logger)
+	}
+
+	logger.Info("waiting-on-builds")
+
+	engine.waitGroup.Wait()
+
+	logger.Info("finished-waiting-on-builds")
+}
+
 type dbBuild struct {
 	engines   Engines
 	peerURL   string
 	releaseCh chan struct{}
+	drainCh   chan struct{}
 	build     db.Build
 	waitGroup *sync.WaitGroup
+	trackingL *sync.Mutex
 }
 
 func (build *dbBuild) Metadata() string {
@@ -177,7 +220,10 @@ func (build *dbBuild) Abort(logger lager.Logger) error {
 }
 
 func (build *dbBuild) Resume(logger lager.Logger) {
-	build.waitGroup.Add(1)
+	if !build.startTracking(logger) {
+		return
+	}
+
 	defer build.waitGroup.Done()
 
 	lock, acquired, err := build.build.AcquireTrackingLock(logger, trackLockDuration)
@@ -292,16 +338,63 @@ func (build *dbBuild) Resume(logger lager.Logger) {
 		return
 	}
 
-	if !build.build.IsRunning() {
-		metric.BuildFinished{
-			PipelineName:  build.build.PipelineName(),
-			JobName:       build.build.JobName(),
-			BuildName:     build.build.Name(),
-			BuildID:       build.build.ID(),
-			BuildStatus:   build.build.Status(),
-			BuildDuration: build.build.EndTime().Sub(build.build.StartTime()),
-			TeamName:      build.build.TeamName(),
-		}.Emit(logger)
+	if build.build.IsRunning() {
+		build.handOff(logger)
+		return
+	}
+
+	queueDuration := build.build.StartTime().Sub(build.build.CreateTime())
+	if queueDuration < 0 {
+		// builds created before their creation time was being recorded
+		queueDuration = 0
+	}
+
+	metric.BuildFinished{
+		PipelineName:  build.build.PipelineName(),
+		JobName:       build.build.JobName(),
+		BuildName:     build.build.Name(),
+		BuildID:       build.build.ID(),
+		BuildStatus:   build.build.Status(),
+		BuildDuration: build.build.EndTime().Sub(build.build.StartTime()),
+		TeamName:      build.build.TeamName(),
+		QueueDuration: queueDuration,
+	}.Emit(logger)
+}
+
+// startTracking adds the build to the engine's wait group, unless the engine
+// is being drained or released, in which case it returns false.
+func (build *dbBuild) startTracking(logger lager.Logger) bool {
+	build.trackingL.Lock()
+	defer build.trackingL.Unlock()
+
+	select {
+	case <-build.drainCh:
+		logger.Debug("draining")
+		return false
+	case <-build.releaseCh:
+		logger.Debug("releasing")
+		return false
+	default:
+	}
+
+	build.waitGroup.Add(1)
+
+	return true
+}
+
+// handOff is called when the engine stopped running a build without it having
+// finished, i.e. because the engine has been drained or released. The tracking
+// lock is released once Resume returns, after which another ATC will pick the
+// build up.
+func (build *dbBuild) handOff(logger lager.Logger) {
+	logger.Info("handing-off")
+
+	err := build.build.SaveEvent(event.Handoff{
+		Time: time.Now().Unix(),
+		From: build.peerURL,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-handoff-event", err)
 	}
 }
 
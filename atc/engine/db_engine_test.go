@@ -18,6 +18,9 @@ import (
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	. "github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
 )
 
 var _ = Describe("DBEngine", func() {
@@ -452,6 +455,26 @@ var _ = Describe("DBEngine", func() {
 				build.Resume(logger)
 			})
 
+			Context("when the engine has been drained", func() {
+				BeforeEach(func() {
+					dbEngine.Drain(logger)
+				})
+
+				It("does not try to track the build", func() {
+					Expect(dbBuild.AcquireTrackingLockCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the engine has been released", func() {
+				BeforeEach(func() {
+					dbEngine.ReleaseAll(logger)
+				})
+
+				It("does not try to track the build", func() {
+					Expect(dbBuild.AcquireTrackingLockCallCount()).To(BeZero())
+				})
+			})
+
 			Context("when acquiring the lock succeeds", func() {
 				var fakeLock *lockfakes.FakeLock
 
@@ -537,6 +560,64 @@ var _ = Describe("DBEngine", func() {
 							It("releases the lock", func() {
 								Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
 							})
+
+							It("notes the handoff in the build's events", func() {
+								Expect(dbBuild.SaveEventCallCount()).To(Equal(1))
+
+								handoff, ok := dbBuild.SaveEventArgsForCall(0).(event.Handoff)
+								Expect(ok).To(BeTrue())
+								Expect(handoff.From).To(Equal("http://10.2.3.4:8080"))
+							})
+						})
+
+						Context("when builds are drained", func() {
+							var drained chan struct{}
+
+							BeforeEach(func() {
+								readyToDrain := make(chan struct{})
+								finishedDraining := make(chan struct{})
+								drained = finishedDraining
+
+								go func() {
+									<-readyToDrain
+									dbEngine.Drain(logger)
+									close(finishedDraining)
+								}()
+
+								handedOff := make(chan struct{})
+
+								realBuild.ResumeStub = func(lager.Logger) {
+									close(readyToDrain)
+									<-handedOff
+								}
+
+								fakeEngineB.DrainStub = func(lager.Logger) {
+									close(handedOff)
+								}
+
+								aborts := make(chan struct{})
+								notifier := new(dbfakes.FakeNotifier)
+								notifier.NotifyReturns(aborts)
+
+								dbBuild.AbortNotifierReturns(notifier, nil)
+							})
+
+							It("drains build engine builds", func() {
+								Expect(fakeEngineB.DrainCallCount()).To(Equal(1))
+							})
+
+							It("finishes draining once the build has been handed off", func() {
+								Eventually(drained).Should(BeClosed())
+								Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
+							})
+
+							It("notes the handoff in the build's events", func() {
+								Expect(dbBuild.SaveEventCallCount()).To(Equal(1))
+
+								handoff, ok := dbBuild.SaveEventArgsForCall(0).(event.Handoff)
+								Expect(ok).To(BeTrue())
+								Expect(handoff.From).To(Equal("http://10.2.3.4:8080"))
+							})
 						})
 
 						Context("when listening for aborts succeeds", func() {
@@ -571,6 +652,49 @@ var _ = Describe("DBEngine", func() {
 								Expect(notifier.CloseCallCount()).To(Equal(1))
 							})
 
+							Context("when the build finishes", func() {
+								var fakeEmitter *metricfakes.FakeEmitter
+
+								BeforeEach(func() {
+									fakeEmitterFactory := new(metricfakes.FakeEmitterFactory)
+									fakeEmitter = new(metricfakes.FakeEmitter)
+									fakeEmitterFactory.IsConfiguredReturns(true)
+									fakeEmitterFactory.NewEmitterReturns(fakeEmitter, nil)
+
+									metric.RegisterEmitter(fakeEmitterFactory)
+									err := metric.Initialize(logger, "test", map[string]string{}, metric.Dimensions{})
+									Expect(err).ToNot(HaveOccurred())
+
+									createTime := time.Unix(123456789, 0)
+									dbBuild.StatusReturns(db.BuildStatusSucceeded)
+									dbBuild.CreateTimeReturns(createTime)
+									dbBuild.StartTimeReturns(createTime.Add(30 * time.Second))
+									dbBuild.EndTimeReturns(createTime.Add(90 * time.Second))
+
+									realBuild.ResumeStub = func(lager.Logger) {
+										dbBuild.IsRunningReturns(false)
+									}
+								})
+
+								It("emits the build's duration and how long it was queued for", func() {
+									Eventually(fakeEmitter.EmitCallCount).Should(Equal(4))
+
+									events := map[string]metric.Event{}
+									for i := 0; i < fakeEmitter.EmitCallCount(); i++ {
+										_, event := fakeEmitter.EmitArgsForCall(i)
+										events[event.Name] = event
+									}
+
+									Expect(events).To(HaveKey("build started"))
+									Expect(events).To(HaveKey("build finished"))
+									Expect(events["build duration"].Value).To(Equal(float64(60000)))
+									Expect(events["build queue duration"].Value).To(Equal(float64(30000)))
+
+									By("not handing off the build")
+									Expect(dbBuild.SaveEventCallCount()).To(BeZero())
+								})
+							})
+
 							Context("when the build is aborted", func() {
 								var errAborted = errors.New("aborted")
 
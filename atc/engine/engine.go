@@ -16,6 +16,10 @@ type Engine interface {
 	CreateBuild(lager.Logger, db.Build, atc.Plan) (Build, error)
 	LookupBuild(lager.Logger, db.Build) (Build, error)
 	ReleaseAll(lager.Logger)
+
+	// Drain stops builds from being tracked by this engine, and hands off
+	// the ones in flight once their running steps have finished.
+	Drain(lager.Logger)
 }
 
 //go:generate counterfeiter . Build
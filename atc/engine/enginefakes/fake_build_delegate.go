@@ -6,6 +6,7 @@ import (
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
+	creds "github.com/concourse/concourse/atc/creds"
 	engine "github.com/concourse/concourse/atc/engine"
 	exec "github.com/concourse/concourse/atc/exec"
 )
@@ -62,6 +63,27 @@ type FakeBuildDelegate struct {
 	taskDelegateReturnsOnCall map[int]struct {
 		result1 exec.TaskDelegate
 	}
+	VariablesStub        func() *creds.BuildVariables
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct {
+	}
+	variablesReturns struct {
+		result1 *creds.BuildVariables
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 *creds.BuildVariables
+	}
+	WithVariablesStub        func(*creds.BuildVariables) engine.BuildDelegate
+	withVariablesMutex       sync.RWMutex
+	withVariablesArgsForCall []struct {
+		arg1 *creds.BuildVariables
+	}
+	withVariablesReturns struct {
+		result1 engine.BuildDelegate
+	}
+	withVariablesReturnsOnCall map[int]struct {
+		result1 engine.BuildDelegate
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -293,6 +315,98 @@ func (fake *FakeBuildDelegate) TaskDelegateReturnsOnCall(i int, result1 exec.Tas
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) Variables() *creds.BuildVariables {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Variables", []interface{}{})
+	fake.variablesMutex.Unlock()
+	if fake.VariablesStub != nil {
+		return fake.VariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.variablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) VariablesReturns(result1 *creds.BuildVariables) {
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 *creds.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) VariablesReturnsOnCall(i int, result1 *creds.BuildVariables) {
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 *creds.BuildVariables
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 *creds.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) WithVariables(arg1 *creds.BuildVariables) engine.BuildDelegate {
+	fake.withVariablesMutex.Lock()
+	ret, specificReturn := fake.withVariablesReturnsOnCall[len(fake.withVariablesArgsForCall)]
+	fake.withVariablesArgsForCall = append(fake.withVariablesArgsForCall, struct {
+		arg1 *creds.BuildVariables
+	}{arg1})
+	fake.recordInvocation("WithVariables", []interface{}{arg1})
+	fake.withVariablesMutex.Unlock()
+	if fake.WithVariablesStub != nil {
+		return fake.WithVariablesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withVariablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) WithVariablesCallCount() int {
+	fake.withVariablesMutex.RLock()
+	defer fake.withVariablesMutex.RUnlock()
+	return len(fake.withVariablesArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) WithVariablesArgsForCall(i int) *creds.BuildVariables {
+	fake.withVariablesMutex.RLock()
+	defer fake.withVariablesMutex.RUnlock()
+	argsForCall := fake.withVariablesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) WithVariablesReturns(result1 engine.BuildDelegate) {
+	fake.WithVariablesStub = nil
+	fake.withVariablesReturns = struct {
+		result1 engine.BuildDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) WithVariablesReturnsOnCall(i int, result1 engine.BuildDelegate) {
+	fake.WithVariablesStub = nil
+	if fake.withVariablesReturnsOnCall == nil {
+		fake.withVariablesReturnsOnCall = make(map[int]struct {
+			result1 engine.BuildDelegate
+		})
+	}
+	fake.withVariablesReturnsOnCall[i] = struct {
+		result1 engine.BuildDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -306,6 +420,10 @@ func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	defer fake.putDelegateMutex.RUnlock()
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	fake.withVariablesMutex.RLock()
+	defer fake.withVariablesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -26,6 +26,11 @@ type FakeEngine struct {
 		result1 engine.Build
 		result2 error
 	}
+	DrainStub        func(lager.Logger)
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+		arg1 lager.Logger
+	}
 	LookupBuildStub        func(lager.Logger, db.Build) (engine.Build, error)
 	lookupBuildMutex       sync.RWMutex
 	lookupBuildArgsForCall []struct {
@@ -114,6 +119,31 @@ func (fake *FakeEngine) CreateBuildReturnsOnCall(i int, result1 engine.Build, re
 	}{result1, result2}
 }
 
+func (fake *FakeEngine) Drain(arg1 lager.Logger) {
+	fake.drainMutex.Lock()
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("Drain", []interface{}{arg1})
+	fake.drainMutex.Unlock()
+	if fake.DrainStub != nil {
+		fake.DrainStub(arg1)
+	}
+}
+
+func (fake *FakeEngine) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *FakeEngine) DrainArgsForCall(i int) lager.Logger {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	argsForCall := fake.drainArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeEngine) LookupBuild(arg1 lager.Logger, arg2 db.Build) (engine.Build, error) {
 	fake.lookupBuildMutex.Lock()
 	ret, specificReturn := fake.lookupBuildReturnsOnCall[len(fake.lookupBuildArgsForCall)]
@@ -240,6 +270,8 @@ func (fake *FakeEngine) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
 	fake.lookupBuildMutex.RLock()
 	defer fake.lookupBuildMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type execMetadata struct {
@@ -27,6 +28,9 @@ type execEngine struct {
 	externalURL     string
 
 	releaseCh     chan struct{}
+	releaseOnce   *sync.Once
+	drainCh       chan struct{}
+	drainOnce     *sync.Once
 	trackedStates *sync.Map
 }
 
@@ -41,6 +45,9 @@ func NewExecEngine(
 		externalURL:     externalURL,
 
 		releaseCh:     make(chan struct{}),
+		releaseOnce:   new(sync.Once),
+		drainCh:       make(chan struct{}),
+		drainOnce:     new(sync.Once),
 		trackedStates: new(sync.Map),
 	}
 }
@@ -67,7 +74,9 @@ func (engine *execEngine) CreateBuild(logger lager.Logger, build db.Build, plan
 		cancel: cancel,
 
 		releaseCh:     engine.releaseCh,
+		drainCh:       engine.drainCh,
 		trackedStates: engine.trackedStates,
+		checkpoint:    newCheckpoint(),
 	}, nil
 }
 
@@ -94,13 +103,24 @@ func (engine *execEngine) LookupBuild(logger lager.Logger, build db.Build) (Buil
 		cancel: cancel,
 
 		releaseCh:     engine.releaseCh,
+		drainCh:       engine.drainCh,
 		trackedStates: engine.trackedStates,
+		checkpoint:    newCheckpoint(),
 	}, nil
 }
 
 func (engine *execEngine) ReleaseAll(logger lager.Logger) {
 	logger.Info("calling-release-in-exec-engine")
-	close(engine.releaseCh)
+	engine.releaseOnce.Do(func() {
+		close(engine.releaseCh)
+	})
+}
+
+func (engine *execEngine) Drain(logger lager.Logger) {
+	logger.Info("calling-drain-in-exec-engine")
+	engine.drainOnce.Do(func() {
+		close(engine.drainCh)
+	})
 }
 
 func buildMetadata(build db.Build, externalURL string) StepMetadata {
@@ -125,7 +145,9 @@ type execBuild struct {
 	cancel func()
 
 	releaseCh     chan struct{}
+	drainCh       chan struct{}
 	trackedStates *sync.Map
+	checkpoint    *checkpoint
 
 	metadata execMetadata
 }
@@ -147,18 +169,46 @@ func (build *execBuild) Abort(lager.Logger) error {
 func (build *execBuild) Resume(logger lager.Logger) {
 	step := build.buildStep(logger, build.metadata.Plan)
 
-	runCtx := lagerctx.NewContext(build.ctx, logger)
+	runCtx, span := tracing.StartSpan(build.ctx, "build", tracing.Attrs{
+		"team":     build.dbBuild.TeamName(),
+		"pipeline": build.dbBuild.PipelineName(),
+		"job":      build.dbBuild.JobName(),
+		"build":    build.dbBuild.Name(),
+		"build_id": strconv.Itoa(build.dbBuild.ID()),
+	})
+	defer span.End()
+
+	runCtx = lagerctx.NewContext(runCtx, logger)
 
 	state := build.runState()
 	defer build.clearRunState()
 
+	// once this returns, any steps still running belong to whichever ATC
+	// picks the build up next
+	defer build.checkpoint.release()
+
 	done := make(chan error, 1)
 	go func() {
 		done <- step.Run(runCtx, state)
 	}()
 
+	drainCh := build.drainCh
+	var idle <-chan struct{}
+
 	for {
 		select {
+		case <-drainCh:
+			logger.Info("draining")
+			idle = build.checkpoint.drain()
+			drainCh = nil
+		case <-idle:
+			select {
+			case err := <-done:
+				build.delegate.Finish(logger.Session("finish"), err, step.Succeeded())
+			default:
+				logger.Info("handing-off")
+			}
+			return
 		case <-build.releaseCh:
 			logger.Info("releasing")
 			return
@@ -177,6 +227,13 @@ func (build *execBuild) SendOutput(logger lager.Logger, plan atc.PlanID, output
 	build.runState().ReadPlanOutput(plan, output)
 }
 
+func (build *execBuild) checkpointed(step exec.Step) exec.Step {
+	return checkpointedStep{
+		Step:       step,
+		checkpoint: build.checkpoint,
+	}
+}
+
 func (build *execBuild) runState() exec.RunState {
 	existingState, _ := build.trackedStates.LoadOrStore(build.dbBuild.ID(), exec.NewRunState())
 	return existingState.(exec.RunState)
@@ -191,6 +248,14 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildAggregateStep(logger, plan)
 	}
 
+	if plan.InParallel != nil {
+		return build.buildInParallelStep(logger, plan)
+	}
+
+	if plan.Across != nil {
+		return build.buildAcrossStep(logger, plan)
+	}
+
 	if plan.Do != nil {
 		return build.buildDoStep(logger, plan)
 	}
@@ -220,27 +285,35 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 	}
 
 	if plan.Task != nil {
-		return build.buildTaskStep(logger, plan)
+		return build.checkpointed(build.buildTaskStep(logger, plan))
 	}
 
 	if plan.Get != nil {
-		return build.buildGetStep(logger, plan)
+		return build.checkpointed(build.buildGetStep(logger, plan))
 	}
 
 	if plan.Put != nil {
-		return build.buildPutStep(logger, plan)
+		return build.checkpointed(build.buildPutStep(logger, plan))
 	}
 
 	if plan.Retry != nil {
 		return build.buildRetryStep(logger, plan)
 	}
 
+	if plan.SetPipeline != nil {
+		return build.checkpointed(build.buildSetPipelineStep(logger, plan))
+	}
+
+	if plan.LoadVar != nil {
+		return build.checkpointed(build.buildLoadVarStep(logger, plan))
+	}
+
 	if plan.UserArtifact != nil {
-		return build.buildUserArtifactStep(logger, plan)
+		return build.checkpointed(build.buildUserArtifactStep(logger, plan))
 	}
 
 	if plan.ArtifactOutput != nil {
-		return build.buildArtifactOutputStep(logger, plan)
+		return build.checkpointed(build.buildArtifactOutputStep(logger, plan))
 	}
 
 	return exec.IdentityStep{}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
 )
@@ -19,6 +20,9 @@ type BuildDelegate interface {
 
 	BuildStepDelegate(atc.PlanID) exec.BuildStepDelegate
 
+	Variables() *creds.BuildVariables
+	WithVariables(*creds.BuildVariables) BuildDelegate
+
 	Finish(lager.Logger, error, bool)
 }
 
@@ -39,29 +43,43 @@ func (factory buildDelegateFactory) Delegate(build db.Build) BuildDelegate {
 }
 
 type delegate struct {
-	build db.Build
+	build     db.Build
+	variables *creds.BuildVariables
 }
 
 func newBuildDelegate(build db.Build) BuildDelegate {
 	return &delegate{
-		build: build,
+		build:     build,
+		variables: creds.NewBuildVariables(),
 	}
 }
 
 func (delegate *delegate) GetDelegate(planID atc.PlanID) exec.GetDelegate {
-	return NewGetDelegate(delegate.build, planID, clock.NewClock())
+	return NewGetDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
 }
 
 func (delegate *delegate) PutDelegate(planID atc.PlanID) exec.PutDelegate {
-	return NewPutDelegate(delegate.build, planID, clock.NewClock())
+	return NewPutDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
 }
 
 func (delegate *delegate) TaskDelegate(planID atc.PlanID) exec.TaskDelegate {
-	return NewTaskDelegate(delegate.build, planID, clock.NewClock())
+	return NewTaskDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
 }
 
 func (delegate *delegate) BuildStepDelegate(planID atc.PlanID) exec.BuildStepDelegate {
-	return NewBuildStepDelegate(delegate.build, planID, clock.NewClock())
+	return NewBuildStepDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
+}
+
+func (delegate *delegate) Variables() *creds.BuildVariables {
+	return delegate.variables
+}
+
+// WithVariables returns a delegate for the same build whose step delegates
+// use the given build-local vars, e.g. a scope created for an across step.
+func (delegate *delegate) WithVariables(variables *creds.BuildVariables) BuildDelegate {
+	scoped := *delegate
+	scoped.variables = variables
+	return &scoped
 }
 
 func (delegate *delegate) Finish(logger lager.Logger, err error, succeeded bool) {
@@ -1,13 +1,24 @@
 package engine_test
 
 import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracetest"
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/kv/value"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -50,9 +61,11 @@ var _ = Describe("ExecEngine", func() {
 
 			build engine.Build
 
-			inputStep  *execfakes.FakeStep
-			taskStep   *execfakes.FakeStep
-			outputStep *execfakes.FakeStep
+			inputStep       *execfakes.FakeStep
+			taskStep        *execfakes.FakeStep
+			outputStep      *execfakes.FakeStep
+			setPipelineStep *execfakes.FakeStep
+			loadVarStep     *execfakes.FakeStep
 
 			planFactory atc.PlanFactory
 		)
@@ -93,6 +106,14 @@ var _ = Describe("ExecEngine", func() {
 			outputStep = new(execfakes.FakeStep)
 			outputStep.SucceededReturns(true)
 			fakeFactory.PutReturns(outputStep)
+
+			setPipelineStep = new(execfakes.FakeStep)
+			setPipelineStep.SucceededReturns(true)
+			fakeFactory.SetPipelineReturns(setPipelineStep)
+
+			loadVarStep = new(execfakes.FakeStep)
+			loadVarStep.SucceededReturns(true)
+			fakeFactory.LoadVarReturns(loadVarStep)
 		})
 
 		Describe("with a putget in an aggregate", func() {
@@ -470,6 +491,194 @@ var _ = Describe("ExecEngine", func() {
 				})
 			})
 
+			Context("that contains a set_pipeline step", func() {
+				BeforeEach(func() {
+					expectedPlan = planFactory.NewPlan(atc.SetPipelinePlan{
+						Name: "some-pipeline",
+						File: "some-input/pipeline.yml",
+					})
+				})
+
+				It("constructs the set_pipeline step correctly", func() {
+					var err error
+					build, err = execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.SetPipelineCallCount()).To(Equal(1))
+
+					logger, plan, build, _ := fakeFactory.SetPipelineArgsForCall(0)
+					Expect(logger).NotTo(BeNil())
+					Expect(build).To(Equal(dbBuild))
+					Expect(plan).To(Equal(expectedPlan))
+
+					Expect(setPipelineStep.RunCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("that contains a load_var step", func() {
+				BeforeEach(func() {
+					expectedPlan = planFactory.NewPlan(atc.LoadVarPlan{
+						Name: "some-var",
+						File: "some-input/version",
+					})
+				})
+
+				It("constructs the load_var step correctly", func() {
+					var err error
+					build, err = execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.LoadVarCallCount()).To(Equal(1))
+
+					logger, plan, _ := fakeFactory.LoadVarArgsForCall(0)
+					Expect(logger).NotTo(BeNil())
+					Expect(plan).To(Equal(expectedPlan))
+
+					Expect(loadVarStep.RunCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("that contains an in_parallel step", func() {
+				BeforeEach(func() {
+					expectedPlan = planFactory.NewPlan(atc.InParallelPlan{
+						Steps: []atc.Plan{
+							planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+							planFactory.NewPlan(atc.TaskPlan{Name: "some-other-task"}),
+						},
+						Limit:    1,
+						FailFast: true,
+					})
+				})
+
+				It("constructs each step", func() {
+					var err error
+					build, err = execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.TaskCallCount()).To(Equal(2))
+					Expect(taskStep.RunCallCount()).To(Equal(2))
+
+					_, plan, _, _, _ := fakeFactory.TaskArgsForCall(0)
+					Expect(plan).To(Equal(expectedPlan.InParallel.Steps[0]))
+
+					_, plan, _, _, _ = fakeFactory.TaskArgsForCall(1)
+					Expect(plan).To(Equal(expectedPlan.InParallel.Steps[1]))
+				})
+
+				Context("when a step fails", func() {
+					BeforeEach(func() {
+						taskStep.SucceededReturns(false)
+					})
+
+					It("does not run the remaining steps", func() {
+						var err error
+						build, err = execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+						Expect(err).NotTo(HaveOccurred())
+
+						build.Resume(logger)
+						Expect(taskStep.RunCallCount()).To(Equal(1))
+
+						_, _, succeeded := fakeDelegate.FinishArgsForCall(0)
+						Expect(succeeded).To(BeFalse())
+					})
+				})
+			})
+
+			Context("that contains an across step", func() {
+				var buildVariables *creds.BuildVariables
+
+				BeforeEach(func() {
+					expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+						Var: "platform",
+						Steps: []atc.VarScopedPlan{
+							{
+								Step:  planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+								Value: "linux",
+							},
+							{
+								Step:  planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+								Value: "darwin",
+							},
+						},
+						MaxInFlight: 1,
+					})
+
+					buildVariables = creds.NewBuildVariables()
+					buildVariables.AddLocalVar("version", "1.2.3", false)
+					fakeDelegate.VariablesReturns(buildVariables)
+
+					fakeDelegate.WithVariablesStub = func(variables *creds.BuildVariables) engine.BuildDelegate {
+						fakeTaskDelegate := new(execfakes.FakeTaskDelegate)
+						fakeTaskDelegate.VariablesReturns(variables)
+
+						scopedDelegate := new(enginefakes.FakeBuildDelegate)
+						scopedDelegate.VariablesReturns(variables)
+						scopedDelegate.TaskDelegateReturns(fakeTaskDelegate)
+						return scopedDelegate
+					}
+				})
+
+				It("constructs each step with its own scope of build-local vars", func() {
+					var err error
+					build, err = execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.TaskCallCount()).To(Equal(2))
+					Expect(taskStep.RunCallCount()).To(Equal(2))
+
+					for i, platform := range []string{"linux", "darwin"} {
+						_, plan, _, _, delegate := fakeFactory.TaskArgsForCall(i)
+						Expect(plan).To(Equal(expectedPlan.Across.Steps[i].Step))
+
+						val, found := delegate.Variables().Get("platform")
+						Expect(found).To(BeTrue())
+						Expect(val).To(Equal(platform))
+
+						val, found = delegate.Variables().Get("version")
+						Expect(found).To(BeTrue())
+						Expect(val).To(Equal("1.2.3"))
+					}
+
+					_, found := buildVariables.Get("platform")
+					Expect(found).To(BeFalse())
+				})
+
+				Context("when the values are sensitive", func() {
+					BeforeEach(func() {
+						expectedPlan.Across.Sensitive = true
+
+						fakeDelegate.WithVariablesStub = func(variables *creds.BuildVariables) engine.BuildDelegate {
+							scopedDelegate := new(enginefakes.FakeBuildDelegate)
+							scopedDelegate.VariablesReturns(variables)
+							scopedDelegate.TaskDelegateStub = func(planID atc.PlanID) exec.TaskDelegate {
+								return engine.NewTaskDelegate(dbBuild, planID, variables, fakeclock.NewFakeClock(time.Unix(123456789, 0)))
+							}
+							return scopedDelegate
+						}
+					})
+
+					It("redacts them in the steps' output", func() {
+						var err error
+						build, err = execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+						Expect(err).NotTo(HaveOccurred())
+
+						build.Resume(logger)
+						Expect(fakeFactory.TaskCallCount()).To(Equal(2))
+
+						_, _, _, _, delegate := fakeFactory.TaskArgsForCall(0)
+						_, err = delegate.Stdout().Write([]byte("building for linux\n"))
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(dbBuild.SaveEventCallCount()).To(Equal(1))
+						Expect(dbBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("building for ((redacted))\n"))
+					})
+				})
+			})
+
 			Context("that contains outputs", func() {
 				var (
 					expectedPlan     atc.Plan
@@ -553,6 +762,168 @@ var _ = Describe("ExecEngine", func() {
 				})
 			})
 		})
+
+		Context("when tracing is configured", func() {
+			var provider *tracetest.Provider
+
+			BeforeEach(func() {
+				provider = tracetest.NewProvider()
+				tracing.ConfigureTraceProvider(provider)
+			})
+
+			AfterEach(func() {
+				tracing.Configured = false
+			})
+
+			It("runs the build's steps within a span for the build", func() {
+				taskStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+					_, span := tracing.StartSpan(ctx, "task", nil)
+					span.End()
+					return nil
+				}
+
+				build, err := execEngine.CreateBuild(logger, dbBuild, planFactory.NewPlan(atc.TaskPlan{
+					Name:   "some-task",
+					Config: &atc.TaskConfig{},
+				}))
+				Expect(err).NotTo(HaveOccurred())
+
+				build.Resume(logger)
+
+				spans := provider.Spans()
+				Expect(spans).To(HaveLen(2))
+
+				buildSpan, taskSpan := spans[0], spans[1]
+				Expect(buildSpan.Name()).To(Equal("build"))
+				Expect(buildSpan.Ended()).To(BeTrue())
+				Expect(buildSpan.Attributes()).To(HaveKeyWithValue(kv.Key("pipeline"), value.String("some-pipeline")))
+				Expect(buildSpan.Attributes()).To(HaveKeyWithValue(kv.Key("job"), value.String("some-job")))
+				Expect(taskSpan.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID))
+			})
+		})
+
+		Context("when the engine is drained", func() {
+			var (
+				started chan struct{}
+				proceed chan struct{}
+				resumed chan struct{}
+			)
+
+			BeforeEach(func() {
+				started = make(chan struct{})
+				proceed = make(chan struct{})
+				resumed = make(chan struct{})
+
+				inputStep.RunStub = func(context.Context, exec.RunState) error {
+					close(started)
+					<-proceed
+					return nil
+				}
+
+				outputPlan = planFactory.NewPlan(atc.OnSuccessPlan{
+					Step: planFactory.NewPlan(atc.GetPlan{
+						Name:     "some-input",
+						Resource: "some-input-resource",
+						Type:     "get",
+					}),
+					Next: planFactory.NewPlan(atc.TaskPlan{
+						Name:       "some-task",
+						ConfigPath: "some-input/build.yml",
+					}),
+				})
+			})
+
+			JustBeforeEach(func() {
+				var err error
+				build, err = execEngine.CreateBuild(logger, dbBuild, outputPlan)
+				Expect(err).NotTo(HaveOccurred())
+
+				go func() {
+					build.Resume(logger)
+					close(resumed)
+				}()
+
+				Eventually(started).Should(BeClosed())
+				execEngine.Drain(logger)
+			})
+
+			It("waits for the running step to finish before handing off", func() {
+				Consistently(resumed).ShouldNot(BeClosed())
+
+				close(proceed)
+				Eventually(resumed).Should(BeClosed())
+
+				Expect(taskStep.RunCallCount()).To(BeZero())
+				Expect(fakeDelegate.FinishCallCount()).To(BeZero())
+			})
+
+			Context("when the engine is released before the step finishes", func() {
+				It("stops waiting for the step", func() {
+					execEngine.ReleaseAll(logger)
+					Eventually(resumed).Should(BeClosed())
+
+					Expect(fakeDelegate.FinishCallCount()).To(BeZero())
+
+					close(proceed)
+				})
+			})
+		})
+
+		Context("when the engine is released while a step is running", func() {
+			var (
+				started  chan struct{}
+				proceed  chan struct{}
+				finished chan struct{}
+			)
+
+			BeforeEach(func() {
+				started = make(chan struct{})
+				proceed = make(chan struct{})
+				finished = make(chan struct{})
+
+				inputStep.RunStub = func(context.Context, exec.RunState) error {
+					close(started)
+					<-proceed
+					close(finished)
+					return nil
+				}
+
+				outputPlan = planFactory.NewPlan(atc.OnSuccessPlan{
+					Step: planFactory.NewPlan(atc.GetPlan{
+						Name:     "some-input",
+						Resource: "some-input-resource",
+						Type:     "get",
+					}),
+					Next: planFactory.NewPlan(atc.TaskPlan{
+						Name:       "some-task",
+						ConfigPath: "some-input/build.yml",
+					}),
+				})
+			})
+
+			It("does not start any more steps once the step finishes", func() {
+				var err error
+				build, err = execEngine.CreateBuild(logger, dbBuild, outputPlan)
+				Expect(err).NotTo(HaveOccurred())
+
+				resumed := make(chan struct{})
+				go func() {
+					build.Resume(logger)
+					close(resumed)
+				}()
+
+				Eventually(started).Should(BeClosed())
+
+				execEngine.ReleaseAll(logger)
+				Eventually(resumed).Should(BeClosed())
+
+				close(proceed)
+				Eventually(finished).Should(BeClosed())
+
+				Consistently(taskStep.RunCallCount).Should(BeZero())
+				Expect(fakeDelegate.FinishCallCount()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("LookupBuild", func() {
@@ -628,6 +999,65 @@ var _ = Describe("ExecEngine", func() {
 			})
 		})
 
+		Context("when the build has a load_var step", func() {
+			var stepsRun []string
+
+			BeforeEach(func() {
+				dbBuild.EngineMetadataReturns(`{
+							"Plan": {
+								"id": "1",
+								"do": [
+									{
+										"id": "2",
+										"load_var": {
+											"name": "some-var",
+											"file": "some-input/version"
+										}
+									},
+									{
+										"id": "3",
+										"task": {
+											"name": "some-task",
+											"privileged": false,
+											"params": {"VERSION": "((.:some-var))"}
+										}
+									}
+								]
+							}
+						}`,
+				)
+
+				fakeDelegate := new(enginefakes.FakeBuildDelegate)
+				fakeDelegateFactory.DelegateReturns(fakeDelegate)
+
+				stepsRun = nil
+
+				loadVarStep := new(execfakes.FakeStep)
+				loadVarStep.SucceededReturns(true)
+				loadVarStep.RunStub = func(context.Context, exec.RunState) error {
+					stepsRun = append(stepsRun, "load_var")
+					return nil
+				}
+				fakeFactory.LoadVarReturns(loadVarStep)
+
+				taskStep := new(execfakes.FakeStep)
+				taskStep.SucceededReturns(true)
+				taskStep.RunStub = func(context.Context, exec.RunState) error {
+					stepsRun = append(stepsRun, "task")
+					return nil
+				}
+				fakeFactory.TaskReturns(taskStep)
+			})
+
+			It("loads the var again before running the steps that use it", func() {
+				foundBuild, err := execEngine.LookupBuild(logger, dbBuild)
+				Expect(err).NotTo(HaveOccurred())
+
+				foundBuild.Resume(logger)
+				Expect(stepsRun).To(Equal([]string{"load_var", "task"}))
+			})
+		})
+
 		Context("when engine metadata is empty", func() {
 			BeforeEach(func() {
 				dbBuild.EngineMetadataReturns("{}")
@@ -32,6 +32,9 @@ func (execV1DummyEngine) LookupBuild(logger lager.Logger, build db.Build) (Build
 func (execV1DummyEngine) ReleaseAll(lager.Logger) {
 }
 
+func (execV1DummyEngine) Drain(lager.Logger) {
+}
+
 type execV1DummyBuild struct {
 }
 
@@ -10,6 +10,14 @@ type Error struct {
 func (Error) EventType() atc.EventType  { return EventTypeError }
 func (Error) Version() atc.EventVersion { return "4.0" }
 
+type Handoff struct {
+	Time int64  `json:"time"`
+	From string `json:"from"`
+}
+
+func (Handoff) EventType() atc.EventType  { return EventTypeHandoff }
+func (Handoff) Version() atc.EventVersion { return "1.0" }
+
 type FinishTask struct {
 	Time       int64  `json:"time"`
 	ExitStatus int    `json:"exit_status"`
@@ -41,6 +41,7 @@ func init() {
 	registerEvent(Status{})
 	registerEvent(Log{})
 	registerEvent(Error{})
+	registerEvent(Handoff{})
 
 	// deprecated:
 	registerEvent(InitializeV10{})
@@ -29,4 +29,7 @@ const (
 
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// build handed off to another ATC
+	EventTypeHandoff atc.EventType = "handoff"
 )
@@ -0,0 +1,16 @@
+package exec
+
+// AcrossStep runs a step once for each combination of an across step's values.
+// The steps are run as an InParallelStep.
+type AcrossStep struct {
+	*InParallelStep
+}
+
+// Across constructs an AcrossStep. A maxInFlight of 0 runs every step at
+// once.
+func Across(steps []Step, maxInFlight int, failFast bool) *AcrossStep {
+	step := InParallel(steps, maxInFlight, failFast)
+	step.kind = "across steps"
+
+	return &AcrossStep{step}
+}
@@ -0,0 +1,50 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AcrossStep", func() {
+	var (
+		fakeStepA *execfakes.FakeStep
+		fakeStepB *execfakes.FakeStep
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		fakeStepA = new(execfakes.FakeStep)
+		fakeStepB = new(execfakes.FakeStep)
+	})
+
+	JustBeforeEach(func() {
+		step = Across([]Step{fakeStepA, fakeStepB}, 1, false)
+		stepErr = step.Run(context.Background(), new(execfakes.FakeRunState))
+	})
+
+	It("runs each step", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(fakeStepA.RunCallCount()).To(Equal(1))
+		Expect(fakeStepB.RunCallCount()).To(Equal(1))
+	})
+
+	Context("when steps error", func() {
+		BeforeEach(func() {
+			fakeStepA.RunReturns(errors.New("error a"))
+			fakeStepB.RunReturns(errors.New("error b"))
+		})
+
+		It("aggregates the errors as across steps", func() {
+			Expect(stepErr).To(MatchError(HavePrefix("one or more across steps errored")))
+			Expect(stepErr).To(MatchError(ContainSubstring("error a")))
+			Expect(stepErr).To(MatchError(ContainSubstring("error b")))
+		})
+	})
+})
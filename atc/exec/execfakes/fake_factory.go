@@ -27,6 +27,19 @@ type FakeFactory struct {
 	getReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	LoadVarStub        func(lager.Logger, atc.Plan, exec.BuildStepDelegate) exec.Step
+	loadVarMutex       sync.RWMutex
+	loadVarArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 exec.BuildStepDelegate
+	}
+	loadVarReturns struct {
+		result1 exec.Step
+	}
+	loadVarReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	PutStub        func(lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.PutDelegate) exec.Step
 	putMutex       sync.RWMutex
 	putArgsForCall []struct {
@@ -43,6 +56,20 @@ type FakeFactory struct {
 	putReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	SetPipelineStub        func(lager.Logger, atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
+	setPipelineMutex       sync.RWMutex
+	setPipelineArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.BuildStepDelegate
+	}
+	setPipelineReturns struct {
+		result1 exec.Step
+	}
+	setPipelineReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	TaskStub        func(lager.Logger, atc.Plan, db.Build, db.ContainerMetadata, exec.TaskDelegate) exec.Step
 	taskMutex       sync.RWMutex
 	taskArgsForCall []struct {
@@ -117,6 +144,58 @@ func (fake *FakeFactory) GetReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeFactory) LoadVar(arg1 lager.Logger, arg2 atc.Plan, arg3 exec.BuildStepDelegate) exec.Step {
+	fake.loadVarMutex.Lock()
+	ret, specificReturn := fake.loadVarReturnsOnCall[len(fake.loadVarArgsForCall)]
+	fake.loadVarArgsForCall = append(fake.loadVarArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 exec.BuildStepDelegate
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("LoadVar", []interface{}{arg1, arg2, arg3})
+	fake.loadVarMutex.Unlock()
+	if fake.LoadVarStub != nil {
+		return fake.LoadVarStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loadVarReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) LoadVarCallCount() int {
+	fake.loadVarMutex.RLock()
+	defer fake.loadVarMutex.RUnlock()
+	return len(fake.loadVarArgsForCall)
+}
+
+func (fake *FakeFactory) LoadVarArgsForCall(i int) (lager.Logger, atc.Plan, exec.BuildStepDelegate) {
+	fake.loadVarMutex.RLock()
+	defer fake.loadVarMutex.RUnlock()
+	argsForCall := fake.loadVarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFactory) LoadVarReturns(result1 exec.Step) {
+	fake.LoadVarStub = nil
+	fake.loadVarReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) LoadVarReturnsOnCall(i int, result1 exec.Step) {
+	fake.LoadVarStub = nil
+	if fake.loadVarReturnsOnCall == nil {
+		fake.loadVarReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.loadVarReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeFactory) Put(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.StepMetadata, arg5 db.ContainerMetadata, arg6 exec.PutDelegate) exec.Step {
 	fake.putMutex.Lock()
 	ret, specificReturn := fake.putReturnsOnCall[len(fake.putArgsForCall)]
@@ -172,6 +251,59 @@ func (fake *FakeFactory) PutReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeFactory) SetPipeline(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.BuildStepDelegate) exec.Step {
+	fake.setPipelineMutex.Lock()
+	ret, specificReturn := fake.setPipelineReturnsOnCall[len(fake.setPipelineArgsForCall)]
+	fake.setPipelineArgsForCall = append(fake.setPipelineArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.BuildStepDelegate
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetPipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setPipelineMutex.Unlock()
+	if fake.SetPipelineStub != nil {
+		return fake.SetPipelineStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) SetPipelineCallCount() int {
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
+	return len(fake.setPipelineArgsForCall)
+}
+
+func (fake *FakeFactory) SetPipelineArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.BuildStepDelegate) {
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
+	argsForCall := fake.setPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFactory) SetPipelineReturns(result1 exec.Step) {
+	fake.SetPipelineStub = nil
+	fake.setPipelineReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) SetPipelineReturnsOnCall(i int, result1 exec.Step) {
+	fake.SetPipelineStub = nil
+	if fake.setPipelineReturnsOnCall == nil {
+		fake.setPipelineReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.setPipelineReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeFactory) Task(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 db.ContainerMetadata, arg5 exec.TaskDelegate) exec.Step {
 	fake.taskMutex.Lock()
 	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
@@ -231,8 +363,12 @@ func (fake *FakeFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
+	fake.loadVarMutex.RLock()
+	defer fake.loadVarMutex.RUnlock()
 	fake.putMutex.RLock()
 	defer fake.putMutex.RUnlock()
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -40,6 +41,21 @@ type Factory interface {
 		db.ContainerMetadata,
 		TaskDelegate,
 	) Step
+
+	// SetPipeline constructs a SetPipeline step.
+	SetPipeline(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		BuildStepDelegate,
+	) Step
+
+	// LoadVar constructs a LoadVar step.
+	LoadVar(
+		lager.Logger,
+		atc.Plan,
+		BuildStepDelegate,
+	) Step
 }
 
 // StepMetadata is used to inject metadata to make available to the step when
@@ -57,6 +73,14 @@ type BuildStepDelegate interface {
 	Stderr() io.Writer
 
 	Errored(lager.Logger, string)
+
+	// SelectedWorker is called with the name of the worker the step's
+	// container is placed on.
+	SelectedWorker(lager.Logger, string)
+
+	// Variables returns the build-local vars, shared by all steps in the
+	// build.
+	Variables() *creds.BuildVariables
 }
 
 // Privileged is used to indicate whether the given step should run with
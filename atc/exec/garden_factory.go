@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
@@ -19,8 +20,10 @@ type gardenFactory struct {
 	resourceFetcher        resource.Fetcher
 	resourceFactory        resource.ResourceFactory
 	dbResourceCacheFactory db.ResourceCacheFactory
+	dbTeamFactory          db.TeamFactory
 	variablesFactory       creds.VariablesFactory
 	defaultLimits          atc.ContainerLimits
+	clock                  clock.Clock
 }
 
 func NewGardenFactory(
@@ -28,16 +31,20 @@ func NewGardenFactory(
 	resourceFetcher resource.Fetcher,
 	resourceFactory resource.ResourceFactory,
 	dbResourceCacheFactory db.ResourceCacheFactory,
+	dbTeamFactory db.TeamFactory,
 	variablesFactory creds.VariablesFactory,
 	defaultLimits atc.ContainerLimits,
+	clock clock.Clock,
 ) Factory {
 	return &gardenFactory{
 		workerClient:           workerClient,
 		resourceFetcher:        resourceFetcher,
 		resourceFactory:        resourceFactory,
 		dbResourceCacheFactory: dbResourceCacheFactory,
+		dbTeamFactory:          dbTeamFactory,
 		variablesFactory:       variablesFactory,
 		defaultLimits:          defaultLimits,
+		clock:                  clock,
 	}
 }
 
@@ -51,7 +58,7 @@ func (factory *gardenFactory) Get(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("get")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(logger, build, delegate)
 
 	getStep := NewGetStep(
 		build,
@@ -63,6 +70,7 @@ func (factory *gardenFactory) Get(
 		creds.NewParams(variables, plan.Get.Params),
 		NewVersionSourceFromPlan(plan.Get),
 		plan.Get.Tags,
+		plan.Get.Placement,
 
 		delegate,
 		factory.resourceFetcher,
@@ -74,6 +82,7 @@ func (factory *gardenFactory) Get(
 		stepMetadata,
 
 		creds.NewVersionedResourceTypes(variables, plan.Get.VersionedResourceTypes),
+		factory.clock,
 	)
 
 	return LogError(getStep, delegate)
@@ -89,7 +98,7 @@ func (factory *gardenFactory) Put(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("put")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(logger, build, delegate)
 
 	putStep := NewPutStep(
 		build,
@@ -100,6 +109,7 @@ func (factory *gardenFactory) Put(
 		creds.NewSource(variables, plan.Put.Source),
 		creds.NewParams(variables, plan.Put.Params),
 		plan.Put.Tags,
+		plan.Put.Placement,
 
 		delegate,
 		factory.resourceFactory,
@@ -108,6 +118,7 @@ func (factory *gardenFactory) Put(
 		stepMetadata,
 
 		creds.NewVersionedResourceTypes(variables, plan.Put.VersionedResourceTypes),
+		factory.clock,
 	)
 
 	return LogError(putStep, delegate)
@@ -137,12 +148,13 @@ func (factory *gardenFactory) Task(
 
 	taskConfigSource = ValidatingConfigSource{ConfigSource: taskConfigSource}
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(logger, build, delegate)
 
 	taskStep := NewTaskStep(
 		Privileged(plan.Task.Privileged),
 		taskConfigSource,
 		plan.Task.Tags,
+		plan.Task.Placement,
 		plan.Task.InputMapping,
 		plan.Task.OutputMapping,
 
@@ -153,6 +165,7 @@ func (factory *gardenFactory) Task(
 
 		factory.workerClient,
 		build.TeamID(),
+		build.TeamName(),
 		build.ID(),
 		build.JobID(),
 		plan.Task.Name,
@@ -162,11 +175,68 @@ func (factory *gardenFactory) Task(
 		creds.NewVersionedResourceTypes(variables, plan.Task.VersionedResourceTypes),
 		variables,
 		factory.defaultLimits,
+		factory.clock,
 	)
 
 	return LogError(taskStep, delegate)
 }
 
+func (factory *gardenFactory) SetPipeline(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate BuildStepDelegate,
+) Step {
+	setPipelineStep := NewSetPipelineStep(
+		plan.ID,
+		*plan.SetPipeline,
+		build,
+		delegate,
+		factory.dbTeamFactory,
+	)
+
+	return LogError(setPipelineStep, delegate)
+}
+
+func (factory *gardenFactory) LoadVar(
+	logger lager.Logger,
+	plan atc.Plan,
+	delegate BuildStepDelegate,
+) Step {
+	loadVarStep := NewLoadVarStep(
+		plan.ID,
+		*plan.LoadVar,
+		delegate,
+	)
+
+	return LogError(loadVarStep, delegate)
+}
+
+// variables returns the Variables for evaluating a step's config, resolving
+// build-local vars before falling back to the pipeline's var sources and the
+// credential manager.
+func (factory *gardenFactory) variables(logger lager.Logger, build db.Build, delegate BuildStepDelegate) creds.Variables {
+	var varSources atc.VarSourceConfigs
+	if build.PipelineID() != 0 {
+		pipeline, found, err := build.Pipeline()
+		if err != nil {
+			logger.Error("failed-to-find-pipeline", err)
+		} else if found {
+			varSources = pipeline.VarSources()
+		}
+	}
+
+	return delegate.Variables().WithParent(
+		creds.NewPipelineVariables(
+			logger,
+			factory.variablesFactory,
+			build.TeamName(),
+			build.PipelineName(),
+			varSources,
+		),
+	)
+}
+
 func (factory *gardenFactory) taskWorkingDirectory(sourceName worker.ArtifactName) string {
 	sum := sha1.Sum([]byte(sourceName))
 	return filepath.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
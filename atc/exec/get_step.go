@@ -6,12 +6,15 @@ import (
 	"context"
 	"io"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -35,6 +38,7 @@ type GetStep struct {
 	params        creds.Params
 	versionSource VersionSource
 	tags          atc.Tags
+	placement     atc.PlacementConstraints
 
 	delegate GetDelegate
 
@@ -48,6 +52,8 @@ type GetStep struct {
 
 	resourceTypes creds.VersionedResourceTypes
 
+	clock clock.Clock
+
 	succeeded bool
 }
 
@@ -61,6 +67,7 @@ func NewGetStep(
 	params creds.Params,
 	versionSource VersionSource,
 	tags atc.Tags,
+	placement atc.PlacementConstraints,
 
 	delegate GetDelegate,
 
@@ -73,6 +80,7 @@ func NewGetStep(
 	stepMetadata StepMetadata,
 
 	resourceTypes creds.VersionedResourceTypes,
+	clock clock.Clock,
 ) Step {
 	return &GetStep{
 		build: build,
@@ -84,6 +92,7 @@ func NewGetStep(
 		params:        params,
 		versionSource: versionSource,
 		tags:          tags,
+		placement:     placement,
 
 		delegate: delegate,
 
@@ -96,6 +105,8 @@ func NewGetStep(
 		stepMetadata:           stepMetadata,
 
 		resourceTypes: resourceTypes,
+
+		clock: clock,
 	}
 }
 
@@ -123,6 +134,19 @@ func NewGetStep(
 // At the end, the resulting ArtifactSource (either from using the cache or
 // fetching the resource) is registered under the step's SourceName.
 func (step *GetStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "get", tracing.Attrs{
+		"name":     step.name,
+		"resource": step.resource,
+		"type":     step.resourceType,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *GetStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	version, err := step.versionSource.Version(state)
@@ -164,6 +188,16 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		db.NewBuildStepContainerOwner(step.buildID, step.planID),
 	)
 
+	metrics := newStepMetrics(step.clock, step.delegate, metric.StepFinished{
+		TeamName:     step.build.TeamName(),
+		PipelineName: step.containerMetadata.PipelineName,
+		JobName:      step.containerMetadata.JobName,
+		StepName:     step.name,
+		StepType:     "get",
+	})
+
+	defer metrics.emit(logger)
+
 	versionedSource, err := step.resourceFetcher.Fetch(
 		ctx,
 		logger,
@@ -171,16 +205,18 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 			Metadata: step.containerMetadata,
 		},
 		step.tags,
+		step.placement,
 		step.teamID,
 		step.resourceTypes,
 		resourceInstance,
 		step.stepMetadata,
-		step.delegate,
+		metrics,
 	)
 	if err != nil {
 		logger.Error("failed-to-fetch-resource", err)
 
 		if err, ok := err.(resource.ErrResourceScriptFailed); ok {
+			metrics.exited(ExitStatus(err.ExitStatus))
 			step.delegate.Finished(logger, ExitStatus(err.ExitStatus), VersionInfo{})
 			return nil
 		}
@@ -188,6 +224,8 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	metrics.exited(0)
+
 	state.Artifacts().RegisterSource(worker.ArtifactName(step.name), &getArtifactSource{
 		logger:           logger,
 		resourceInstance: resourceInstance,
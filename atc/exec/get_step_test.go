@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
@@ -38,6 +40,7 @@ var _ = Describe("GetStep", func() {
 		fakeDBResourceCacheFactory *dbfakes.FakeResourceCacheFactory
 		fakeVariablesFactory       *credsfakes.FakeVariablesFactory
 		variables                  creds.Variables
+		buildVariables             *creds.BuildVariables
 		fakeBuild                  *dbfakes.FakeBuild
 		fakeDelegate               *execfakes.FakeGetDelegate
 		getPlan                    *atc.GetPlan
@@ -103,18 +106,24 @@ var _ = Describe("GetStep", func() {
 		}
 
 		getPlan = &atc.GetPlan{
-			Name:                   "some-name",
-			Type:                   "some-resource-type",
-			Source:                 atc.Source{"some": "((source-param))"},
-			Params:                 atc.Params{"some-param": "some-value"},
-			Tags:                   []string{"some", "tags"},
+			Name:   "some-name",
+			Type:   "some-resource-type",
+			Source: atc.Source{"some": "((source-param))"},
+			Params: atc.Params{"some-param": "some-value"},
+			Tags:   []string{"some", "tags"},
+			Placement: atc.PlacementConstraints{
+				{Key: "os", Operator: atc.PlacementOperatorIn, Values: []string{"linux"}},
+			},
 			Version:                &atc.Version{"some-version": "some-value"},
 			VersionedResourceTypes: resourceTypes,
 		}
 
-		factory = exec.NewGardenFactory(fakeWorkerClient, fakeResourceFetcher, fakeResourceFactory, fakeDBResourceCacheFactory, fakeVariablesFactory, atc.ContainerLimits{})
+		factory = exec.NewGardenFactory(fakeWorkerClient, fakeResourceFetcher, fakeResourceFactory, fakeDBResourceCacheFactory, new(dbfakes.FakeTeamFactory), fakeVariablesFactory, atc.ContainerLimits{}, fakeclock.NewFakeClock(time.Unix(123456789, 0)))
+
+		buildVariables = creds.NewBuildVariables()
 
 		fakeDelegate = new(execfakes.FakeGetDelegate)
+		fakeDelegate.VariablesReturns(buildVariables)
 	})
 
 	AfterEach(func() {
@@ -141,7 +150,7 @@ var _ = Describe("GetStep", func() {
 		Expect(stepErr).ToNot(HaveOccurred())
 
 		Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-		fctx, _, sid, tags, actualTeamID, actualResourceTypes, resourceInstance, sm, delegate := fakeResourceFetcher.FetchArgsForCall(0)
+		fctx, _, sid, tags, placement, actualTeamID, actualResourceTypes, resourceInstance, sm, delegate := fakeResourceFetcher.FetchArgsForCall(0)
 		Expect(fctx).To(Equal(ctx))
 		Expect(sm).To(Equal(stepMetadata))
 		Expect(sid).To(Equal(resource.Session{
@@ -153,18 +162,20 @@ var _ = Describe("GetStep", func() {
 			},
 		}))
 		Expect(tags).To(ConsistOf("some", "tags"))
+		Expect(placement).To(Equal(getPlan.Placement))
 		Expect(actualTeamID).To(Equal(teamID))
 		Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 			"some-resource-type",
 			atc.Version{"some-version": "some-value"},
 			atc.Source{"some": "super-secret-source"},
 			atc.Params{"some-param": "some-value"},
-			creds.NewVersionedResourceTypes(variables, resourceTypes),
+			creds.NewVersionedResourceTypes(buildVariables.WithParent(variables), resourceTypes),
 			nil,
 			db.NewBuildStepContainerOwner(buildID, atc.PlanID(planID)),
 		)))
-		Expect(actualResourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, resourceTypes)))
-		Expect(delegate).To(Equal(fakeDelegate))
+		Expect(actualResourceTypes).To(Equal(creds.NewVersionedResourceTypes(buildVariables.WithParent(variables), resourceTypes)))
+		delegate.SelectedWorker(lagertest.NewTestLogger("test"), "some-worker")
+		Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
 		expectedLockName := fmt.Sprintf("%x",
 			sha256.Sum256([]byte(
 				`{"type":"some-resource-type","version":{"some-version":"some-value"},"source":{"some":"super-secret-source"},"params":{"some-param":"some-value"},"worker_name":"fake-worker"}`,
@@ -174,6 +185,20 @@ var _ = Describe("GetStep", func() {
 		Expect(resourceInstance.LockName("fake-worker")).To(Equal(expectedLockName))
 	})
 
+	Context("when the params reference a build-local var", func() {
+		BeforeEach(func() {
+			buildVariables.AddLocalVar("some-var", "some-local-value", false)
+			getPlan.Params = atc.Params{"some-param": "((.:some-var))"}
+		})
+
+		It("fetches the resource with the var's value", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			_, _, _, _, _, _, _, resourceInstance, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
+			Expect(resourceInstance.Params()).To(Equal(atc.Params{"some-param": "some-local-value"}))
+		})
+	})
+
 	Context("when fetching resource succeeds", func() {
 		BeforeEach(func() {
 			fakeVersionedSource.VersionReturns(atc.Version{"some": "version"})
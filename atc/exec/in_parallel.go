@@ -0,0 +1,112 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// InParallelStep is a step of steps to run in parallel, with an optional
+// limit on how many run at once.
+type InParallelStep struct {
+	steps    []Step
+	limit    int
+	failFast bool
+
+	// describes the steps in the aggregated error
+	kind string
+}
+
+// InParallel constructs an InParallelStep. A limit of 0 runs every step at
+// once.
+func InParallel(steps []Step, limit int, failFast bool) *InParallelStep {
+	return &InParallelStep{
+		steps:    steps,
+		limit:    limit,
+		failFast: failFast,
+
+		kind: "parallel steps",
+	}
+}
+
+// Run executes the steps in parallel, running no more than limit of them at a
+// time. Steps are started in order.
+//
+// If failFast is set, the first step to fail or error will cause the
+// remaining steps to be interrupted and any steps that have not yet started
+// to be skipped. Otherwise it will wait for all steps to exit, and their
+// errors (if any) will be aggregated and returned as a single error.
+func (step *InParallelStep) Run(ctx context.Context, state RunState) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limit := step.limit
+	if limit <= 0 || limit > len(step.steps) {
+		limit = len(step.steps)
+	}
+
+	inFlight := make(chan struct{}, limit)
+	errs := make(chan error, len(step.steps))
+
+	started := 0
+	for _, s := range step.steps {
+		inFlight <- struct{}{}
+
+		// skip the remaining steps if a step failed fast; if the build was
+		// aborted they are still run so that they are interrupted like the rest
+		if runCtx.Err() != nil && ctx.Err() == nil {
+			break
+		}
+
+		started++
+
+		s := s
+		go func() {
+			defer func() { <-inFlight }()
+
+			err := s.Run(runCtx, state)
+			if step.failFast && (err != nil || !s.Succeeded()) {
+				cancel()
+			}
+
+			errs <- err
+		}()
+	}
+
+	var errorMessages []string
+	for i := 0; i < started; i++ {
+		err := <-errs
+		if err == nil {
+			continue
+		}
+
+		// steps interrupted due to fail_fast are not errors in their own right
+		if err == context.Canceled && ctx.Err() == nil {
+			continue
+		}
+
+		errorMessages = append(errorMessages, err.Error())
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("one or more %s errored:\n%s", step.kind, strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// Succeeded is true if all of the steps' Succeeded is true. Steps that were
+// skipped due to fail_fast are not considered to have succeeded.
+func (step *InParallelStep) Succeeded() bool {
+	for _, s := range step.steps {
+		if !s.Succeeded() {
+			return false
+		}
+	}
+
+	return true
+}
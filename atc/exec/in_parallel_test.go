@@ -0,0 +1,216 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InParallelStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStepA *execfakes.FakeStep
+		fakeStepB *execfakes.FakeStep
+		fakeStepC *execfakes.FakeStep
+
+		state *execfakes.FakeRunState
+
+		limit    int
+		failFast bool
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStepA = new(execfakes.FakeStep)
+		fakeStepB = new(execfakes.FakeStep)
+		fakeStepC = new(execfakes.FakeStep)
+
+		fakeStepA.SucceededReturns(true)
+		fakeStepB.SucceededReturns(true)
+		fakeStepC.SucceededReturns(true)
+
+		state = new(execfakes.FakeRunState)
+
+		limit = 0
+		failFast = false
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = InParallel([]Step{fakeStepA, fakeStepB, fakeStepC}, limit, failFast)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs every step", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(fakeStepA.RunCallCount()).To(Equal(1))
+		Expect(fakeStepB.RunCallCount()).To(Equal(1))
+		Expect(fakeStepC.RunCallCount()).To(Equal(1))
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	Context("when no limit is set", func() {
+		BeforeEach(func() {
+			wg := new(sync.WaitGroup)
+			wg.Add(3)
+
+			run := func(context.Context, RunState) error {
+				wg.Done()
+				wg.Wait()
+				return nil
+			}
+
+			fakeStepA.RunStub = run
+			fakeStepB.RunStub = run
+			fakeStepC.RunStub = run
+		})
+
+		It("runs all steps in parallel", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when a limit is set", func() {
+		var (
+			lock         sync.Mutex
+			running      int
+			maxRunning   int
+			trackRunning func(context.Context, RunState) error
+		)
+
+		BeforeEach(func() {
+			limit = 2
+			running = 0
+			maxRunning = 0
+
+			trackRunning = func(context.Context, RunState) error {
+				lock.Lock()
+				running++
+				if running > maxRunning {
+					maxRunning = running
+				}
+				lock.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				lock.Lock()
+				running--
+				lock.Unlock()
+
+				return nil
+			}
+
+			fakeStepA.RunStub = trackRunning
+			fakeStepB.RunStub = trackRunning
+			fakeStepC.RunStub = trackRunning
+		})
+
+		It("runs no more than the limit of steps at a time", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(maxRunning).To(Equal(2))
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a step fails", func() {
+		BeforeEach(func() {
+			fakeStepB.SucceededReturns(false)
+		})
+
+		It("runs the remaining steps", func() {
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		})
+
+		It("does not succeed", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		Context("when fail_fast is set", func() {
+			BeforeEach(func() {
+				failFast = true
+				limit = 1
+			})
+
+			It("does not start the remaining steps", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(Equal(1))
+				Expect(fakeStepC.RunCallCount()).To(Equal(0))
+			})
+
+			It("does not succeed", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when fail_fast is set and a step errors while others are running", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			failFast = true
+
+			fakeStepA.RunStub = func(ctx context.Context, state RunState) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+
+			fakeStepB.RunReturns(disaster)
+			fakeStepB.SucceededReturns(false)
+		})
+
+		It("interrupts the running steps", func() {
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+		})
+
+		It("returns only the error that caused the interruption", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+			Expect(stepErr).ToNot(MatchError(ContainSubstring("context canceled")))
+		})
+	})
+
+	Context("when multiple steps error", func() {
+		BeforeEach(func() {
+			fakeStepA.RunReturns(errors.New("error a"))
+			fakeStepC.RunReturns(errors.New("error c"))
+		})
+
+		It("aggregates the errors", func() {
+			Expect(stepErr).To(MatchError(HavePrefix("one or more parallel steps errored")))
+			Expect(stepErr).To(MatchError(ContainSubstring("error a")))
+			Expect(stepErr).To(MatchError(ContainSubstring("error c")))
+		})
+	})
+
+	Context("when the build is aborted", func() {
+		BeforeEach(func() {
+			fakeStepA.RunStub = func(ctx context.Context, state RunState) error {
+				cancel()
+				return ctx.Err()
+			}
+		})
+
+		It("returns the context's error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+	})
+})
@@ -0,0 +1,142 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/yaml.v2"
+)
+
+// UnknownVarFormatError is returned when a LoadVar step is configured with a
+// format that it does not know how to parse.
+type UnknownVarFormatError struct {
+	Format string
+}
+
+// Error returns a human-friendly error message.
+func (err UnknownVarFormatError) Error() string {
+	return fmt.Sprintf("unknown var format: '%s'", err.Format)
+}
+
+// LoadVarStep reads a file out of the build's artifacts and registers its
+// contents as a build-local var, which later steps may reference as
+// ((.:name)).
+//
+// Build-local vars are not persisted. When a build is resumed, e.g. after
+// being handed off to another ATC, its plan is run from the start, so the
+// step runs again and re-derives the var from the same artifact before any
+// later steps use it.
+type LoadVarStep struct {
+	planID   atc.PlanID
+	plan     atc.LoadVarPlan
+	delegate BuildStepDelegate
+
+	succeeded bool
+}
+
+func NewLoadVarStep(
+	planID atc.PlanID,
+	plan atc.LoadVarPlan,
+	delegate BuildStepDelegate,
+) *LoadVarStep {
+	return &LoadVarStep{
+		planID:   planID,
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+// Run reads the file and parses it according to the configured format:
+//
+// * raw: the file's contents as-is
+// * trim: the file's contents with surrounding whitespace removed
+// * json and yaml: the parsed structure, whose fields may be referenced as
+//   ((.:name.field))
+//
+// If no format is configured, it is determined by the file's extension,
+// defaulting to trim.
+func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("load-var-step", lager.Data{
+		"var":  step.plan.Name,
+		"file": step.plan.File,
+	})
+
+	payload, err := readArtifactFile(state.Artifacts(), step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	value, err := step.parse(payload)
+	if err != nil {
+		return err
+	}
+
+	step.delegate.Variables().AddLocalVar(step.plan.Name, value, step.plan.Sensitive)
+
+	fmt.Fprintf(step.delegate.Stdout(), "loaded var %s from %s\n", step.plan.Name, step.plan.File)
+
+	logger.Debug("loaded")
+
+	step.succeeded = true
+
+	return nil
+}
+
+// Succeeded returns true if the var was loaded.
+func (step *LoadVarStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *LoadVarStep) parse(payload []byte) (interface{}, error) {
+	format := step.plan.Format
+	if format == "" {
+		format = formatFromExtension(step.plan.File)
+	}
+
+	switch format {
+	case "raw":
+		return string(payload), nil
+
+	case "trim":
+		return strings.TrimSpace(string(payload)), nil
+
+	case "json":
+		var value interface{}
+		err := json.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s as json: %s", step.plan.File, err)
+		}
+
+		return value, nil
+
+	case "yaml", "yml":
+		var value interface{}
+		err := yaml.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s as yaml: %s", step.plan.File, err)
+		}
+
+		return atc.SanitizeYAML(value)
+
+	default:
+		return nil, UnknownVarFormatError{format}
+	}
+}
+
+func formatFromExtension(path string) string {
+	switch filepath.Ext(path) {
+	case ".json":
+		return "json"
+	case ".yml", ".yaml":
+		return "yaml"
+	default:
+		return "trim"
+	}
+}
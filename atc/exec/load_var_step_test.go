@@ -0,0 +1,205 @@
+package exec_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadVarStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeSource *workerfakes.FakeArtifactSource
+
+		state          exec.RunState
+		delegate       *execfakes.FakeBuildStepDelegate
+		buildVariables *creds.BuildVariables
+		stdout         *bytes.Buffer
+
+		plan atc.LoadVarPlan
+
+		step    *exec.LoadVarStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("load-var-step-test"))
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamFileStub = func(path string) (io.ReadCloser, error) {
+			switch path {
+			case "version":
+				return ioutil.NopCloser(bytes.NewBufferString("1.2.3\n")), nil
+			case "metadata.json":
+				return ioutil.NopCloser(bytes.NewBufferString(`{"sha":"abcdef","count":3}`)), nil
+			case "metadata.yml":
+				return ioutil.NopCloser(bytes.NewBufferString("sha: abcdef\nnested:\n  key: value\n")), nil
+			default:
+				return nil, baggageclaim.ErrFileNotFound
+			}
+		}
+
+		state = exec.NewRunState()
+		state.Artifacts().RegisterSource("some-artifact", fakeSource)
+
+		stdout = new(bytes.Buffer)
+		buildVariables = creds.NewBuildVariables()
+
+		delegate = new(execfakes.FakeBuildStepDelegate)
+		delegate.StdoutReturns(stdout)
+		delegate.VariablesReturns(buildVariables)
+
+		plan = atc.LoadVarPlan{
+			Name: "some-var",
+			File: "some-artifact/version",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewLoadVarStep("some-plan-id", plan, delegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when no format is specified", func() {
+		It("trims the file's contents", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+
+			val, found := buildVariables.Get("some-var")
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("1.2.3"))
+		})
+
+		It("does not redact the value", func() {
+			Expect(buildVariables.Redact("1.2.3")).To(Equal("1.2.3"))
+		})
+
+		It("does not print the value", func() {
+			Expect(stdout.String()).To(ContainSubstring("loaded var some-var from some-artifact/version"))
+			Expect(stdout.String()).ToNot(ContainSubstring("1.2.3"))
+		})
+
+		Context("when the file has a json extension", func() {
+			BeforeEach(func() {
+				plan.File = "some-artifact/metadata.json"
+			})
+
+			It("parses the file as json", func() {
+				val, _ := buildVariables.Get("some-var")
+				Expect(val).To(Equal(map[string]interface{}{"sha": "abcdef", "count": float64(3)}))
+			})
+		})
+
+		Context("when the file has a yaml extension", func() {
+			BeforeEach(func() {
+				plan.File = "some-artifact/metadata.yml"
+			})
+
+			It("parses the file as yaml", func() {
+				val, _ := buildVariables.Get("some-var")
+				Expect(val).To(Equal(map[string]interface{}{
+					"sha": "abcdef",
+					"nested": map[string]interface{}{
+						"key": "value",
+					},
+				}))
+			})
+		})
+	})
+
+	Context("when the format is raw", func() {
+		BeforeEach(func() {
+			plan.Format = "raw"
+		})
+
+		It("keeps the file's contents as-is", func() {
+			val, _ := buildVariables.Get("some-var")
+			Expect(val).To(Equal("1.2.3\n"))
+		})
+	})
+
+	Context("when the format is json but the file is not", func() {
+		BeforeEach(func() {
+			plan.Format = "json"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("failed to parse some-artifact/version as json"))
+		})
+	})
+
+	Context("when the format is unknown", func() {
+		BeforeEach(func() {
+			plan.Format = "toml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.UnknownVarFormatError{Format: "toml"}))
+		})
+	})
+
+	Context("when the var is sensitive", func() {
+		BeforeEach(func() {
+			plan.Sensitive = true
+		})
+
+		It("redacts the value", func() {
+			Expect(buildVariables.Redact("version 1.2.3")).To(Equal("version ((redacted))"))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "some-artifact/missing"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("file 'some-artifact/missing' not found"))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the artifact does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "missing-artifact/version"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(BeAssignableToTypeOf(exec.UnknownArtifactSourceError{}))
+		})
+	})
+
+	Context("when streaming the file fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeSource.StreamFileStub = nil
+			fakeSource.StreamFileReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+	})
+})
@@ -3,12 +3,15 @@ package exec
 import (
 	"context"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -30,6 +33,7 @@ type PutStep struct {
 	source       creds.Source
 	params       creds.Params
 	tags         atc.Tags
+	placement    atc.PlacementConstraints
 
 	resource string
 
@@ -41,6 +45,8 @@ type PutStep struct {
 
 	resourceTypes creds.VersionedResourceTypes
 
+	clock clock.Clock
+
 	versionInfo VersionInfo
 	succeeded   bool
 }
@@ -53,12 +59,14 @@ func NewPutStep(
 	source creds.Source,
 	params creds.Params,
 	tags atc.Tags,
+	placement atc.PlacementConstraints,
 	delegate PutDelegate,
 	resourceFactory resource.ResourceFactory,
 	planID atc.PlanID,
 	containerMetadata db.ContainerMetadata,
 	stepMetadata StepMetadata,
 	resourceTypes creds.VersionedResourceTypes,
+	clock clock.Clock,
 ) *PutStep {
 	return &PutStep{
 		build: build,
@@ -69,12 +77,14 @@ func NewPutStep(
 		source:            source,
 		params:            params,
 		tags:              tags,
+		placement:         placement,
 		delegate:          delegate,
 		resourceFactory:   resourceFactory,
 		planID:            planID,
 		containerMetadata: containerMetadata,
 		stepMetadata:      stepMetadata,
 		resourceTypes:     resourceTypes,
+		clock:             clock,
 	}
 }
 
@@ -87,14 +97,28 @@ func NewPutStep(
 // The resource's put script is then invoked. If the context is canceled, the
 // script will be interrupted.
 func (step *PutStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "put", tracing.Attrs{
+		"name":     step.name,
+		"resource": step.resource,
+		"type":     step.resourceType,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *PutStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	containerSpec := worker.ContainerSpec{
 		ImageSpec: worker.ImageSpec{
 			ResourceType: step.resourceType,
 		},
-		Tags:   step.tags,
-		TeamID: step.build.TeamID(),
+		Tags:      step.tags,
+		Placement: step.placement,
+		TeamID:    step.build.TeamID(),
 
 		Dir: resource.ResourcesDir("put"),
 
@@ -108,6 +132,16 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		})
 	}
 
+	metrics := newStepMetrics(step.clock, step.delegate, metric.StepFinished{
+		TeamName:     step.build.TeamName(),
+		PipelineName: step.containerMetadata.PipelineName,
+		JobName:      step.containerMetadata.JobName,
+		StepName:     step.name,
+		StepType:     "put",
+	})
+
+	defer metrics.emit(logger)
+
 	putResource, err := step.resourceFactory.NewResource(
 		ctx,
 		logger,
@@ -115,7 +149,7 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		step.containerMetadata,
 		containerSpec,
 		step.resourceTypes,
-		step.delegate,
+		metrics,
 	)
 	if err != nil {
 		return err
@@ -131,6 +165,8 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	metrics.running()
+
 	versionedSource, err := putResource.Put(
 		ctx,
 		resource.IOConfig{
@@ -145,6 +181,7 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		logger.Error("failed-to-put-resource", err)
 
 		if err, ok := err.(resource.ErrResourceScriptFailed); ok {
+			metrics.exited(ExitStatus(err.ExitStatus))
 			step.delegate.Finished(logger, ExitStatus(err.ExitStatus), VersionInfo{})
 			return nil
 		}
@@ -152,6 +189,8 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	metrics.exited(0)
+
 	step.versionInfo = VersionInfo{
 		Version:  versionedSource.Version(),
 		Metadata: versionedSource.Metadata(),
@@ -3,7 +3,10 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
@@ -107,12 +110,16 @@ var _ = Describe("PutStep", func() {
 			creds.NewSource(variables, atc.Source{"some": "((source-param))"}),
 			creds.NewParams(variables, atc.Params{"some-param": "some-value"}),
 			[]string{"some", "tags"},
+			atc.PlacementConstraints{
+				{Key: "os", Operator: atc.PlacementOperatorIn, Values: []string{"linux"}},
+			},
 			fakeDelegate,
 			fakeResourceFactory,
 			planID,
 			containerMetadata,
 			stepMetadata,
 			resourceTypes,
+			fakeclock.NewFakeClock(time.Unix(123456789, 0)),
 		)
 
 		stepErr = putStep.Run(ctx, state)
@@ -162,6 +169,9 @@ var _ = Describe("PutStep", func() {
 					ResourceType: "some-resource-type",
 				}))
 				Expect(containerSpec.Tags).To(Equal([]string{"some", "tags"}))
+				Expect(containerSpec.Placement).To(Equal(atc.PlacementConstraints{
+					{Key: "os", Operator: atc.PlacementOperatorIn, Values: []string{"linux"}},
+				}))
 				Expect(containerSpec.TeamID).To(Equal(123))
 				Expect(containerSpec.Env).To(Equal([]string{"a=1", "b=2"}))
 				Expect(containerSpec.Dir).To(Equal("/tmp/build/put"))
@@ -176,7 +186,8 @@ var _ = Describe("PutStep", func() {
 					exec.PutResourceSource{fakeMountedSource},
 				))
 				Expect(actualResourceTypes).To(Equal(resourceTypes))
-				Expect(delegate).To(Equal(fakeDelegate))
+				delegate.SelectedWorker(lagertest.NewTestLogger("test"), "some-worker")
+				Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
 			})
 
 			It("puts the resource with the given context", func() {
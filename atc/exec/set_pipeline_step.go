@@ -0,0 +1,234 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+	"gopkg.in/yaml.v2"
+)
+
+// SetPipelineStep configures a pipeline from a config file fetched out of the
+// build's artifacts, in the same way that fly set-pipeline does.
+type SetPipelineStep struct {
+	planID      atc.PlanID
+	plan        atc.SetPipelinePlan
+	build       db.Build
+	delegate    BuildStepDelegate
+	teamFactory db.TeamFactory
+
+	succeeded bool
+}
+
+func NewSetPipelineStep(
+	planID atc.PlanID,
+	plan atc.SetPipelinePlan,
+	build db.Build,
+	delegate BuildStepDelegate,
+	teamFactory db.TeamFactory,
+) *SetPipelineStep {
+	return &SetPipelineStep{
+		planID:      planID,
+		plan:        plan,
+		build:       build,
+		delegate:    delegate,
+		teamFactory: teamFactory,
+	}
+}
+
+// Run reads the pipeline config and any var files from the artifact
+// repository, interpolates the configured vars into the config, and saves the
+// pipeline to the build's team if it is valid and has changed.
+//
+// A diff of the changes is written to the build's stdout. Invalid
+// configuration results in the step failing rather than erroring.
+func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("set-pipeline-step", lager.Data{
+		"pipeline": step.plan.Name,
+	})
+
+	stdout := step.delegate.Stdout()
+	stderr := step.delegate.Stderr()
+
+	config, err := step.fetchConfig(state.Artifacts())
+	if err != nil {
+		return err
+	}
+
+	warnings, errorMessages := config.Validate()
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(errorMessages) > 0 {
+		fmt.Fprintln(stderr, "invalid pipeline config:")
+		for _, errorMessage := range errorMessages {
+			fmt.Fprintf(stderr, "  - %s\n", errorMessage)
+		}
+
+		step.succeeded = false
+		return nil
+	}
+
+	team := step.teamFactory.GetByID(step.build.TeamID())
+
+	fromVersion := db.ConfigVersion(1)
+	pausedState := db.PipelineUnpaused
+
+	existingConfig := atc.Config{}
+
+	pipelineRef := atc.PipelineRef{Name: step.plan.Name}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		existingConfig, err = pipelineConfig(pipeline)
+		if err != nil {
+			return err
+		}
+
+		fromVersion = pipeline.ConfigVersion()
+		pausedState = db.PipelineNoChange
+	}
+
+	diffExists := existingConfig.Diff(stdout, config)
+	if !diffExists {
+		logger.Debug("no-diff")
+
+		fmt.Fprintln(stdout, "no changes to apply")
+		step.succeeded = true
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
+
+	_, _, err = team.SavePipeline(pipelineRef, config, fromVersion, pausedState)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("saved-pipeline")
+
+	fmt.Fprintln(stdout, "done")
+	step.succeeded = true
+
+	return nil
+}
+
+// Succeeded returns true if the pipeline config was valid and saved (or had
+// no changes).
+func (step *SetPipelineStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *SetPipelineStep) fetchConfig(repo *worker.ArtifactRepository) (atc.Config, error) {
+	configPayload, err := readArtifactFile(repo, step.plan.File)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	staticVars := template.StaticVariables{}
+	for name, value := range step.plan.Vars {
+		staticVars[name] = value
+	}
+
+	vars := []template.Variables{staticVars}
+	for _, path := range step.plan.VarFiles {
+		payload, err := readArtifactFile(repo, path)
+		if err != nil {
+			return atc.Config{}, err
+		}
+
+		var fileVars template.StaticVariables
+		err = yaml.Unmarshal(payload, &fileVars)
+		if err != nil {
+			return atc.Config{}, fmt.Errorf("failed to load vars from %s: %s", path, err)
+		}
+
+		vars = append(vars, fileVars)
+	}
+
+	evaluatedConfig, err := template.NewTemplate(configPayload).Evaluate(
+		template.NewMultiVars(vars),
+		nil,
+		template.EvaluateOpts{},
+	)
+	if err != nil {
+		return atc.Config{}, fmt.Errorf("failed to interpolate %s: %s", step.plan.File, err)
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluatedConfig, &config)
+	if err != nil {
+		return atc.Config{}, fmt.Errorf("failed to load %s: %s", step.plan.File, err)
+	}
+
+	return config, nil
+}
+
+func pipelineConfig(pipeline db.Pipeline) (atc.Config, error) {
+	jobs, err := pipeline.Jobs()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	return atc.Config{
+		Groups:        pipeline.Groups(),
+		Resources:     resources.Configs(),
+		ResourceTypes: resourceTypes.Configs(),
+		Jobs:          jobs.Configs(),
+		VarSources:    pipeline.VarSources(),
+	}, nil
+}
+
+// readArtifactFile reads a file out of the worker.ArtifactRepository. The path
+// must be in the format SOURCE_NAME/FILE/PATH, with SOURCE_NAME determining
+// the ArtifactSource to stream the file out of.
+func readArtifactFile(repo *worker.ArtifactRepository, path string) ([]byte, error) {
+	segs := strings.SplitN(path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{path}
+	}
+
+	sourceName := worker.ArtifactName(segs[0])
+	filePath := segs[1]
+
+	source, found := repo.SourceFor(sourceName)
+	if !found {
+		return nil, UnknownArtifactSourceError{sourceName, path}
+	}
+
+	stream, err := source.StreamFile(filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, fmt.Errorf("file '%s/%s' not found", sourceName, filePath)
+		}
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
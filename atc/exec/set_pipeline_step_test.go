@@ -0,0 +1,267 @@
+package exec_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetPipelineStep", func() {
+	const pipelineConfig = `---
+resources:
+- name: some-resource
+  type: some-type
+  source:
+    uri: ((uri))
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+  - task: some-task
+    file: some-resource/task.yml
+    params:
+      SECRET: ((secret))
+`
+
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild       *dbfakes.FakeBuild
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		fakePipeline    *dbfakes.FakePipeline
+		fakeSource      *workerfakes.FakeArtifactSource
+
+		state    exec.RunState
+		delegate *execfakes.FakeBuildStepDelegate
+		stdout   *bytes.Buffer
+		stderr   *bytes.Buffer
+
+		plan atc.SetPipelinePlan
+
+		step    *exec.SetPipelineStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("set-pipeline-step-test"))
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.TeamIDReturns(123)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamFileStub = func(path string) (io.ReadCloser, error) {
+			switch path {
+			case "pipeline.yml":
+				return ioutil.NopCloser(bytes.NewBufferString(pipelineConfig)), nil
+			case "vars.yml":
+				return ioutil.NopCloser(bytes.NewBufferString("uri: file-uri\nsecret: file-secret\n")), nil
+			default:
+				return nil, errors.New("unknown file")
+			}
+		}
+
+		state = exec.NewRunState()
+		state.Artifacts().RegisterSource("some-artifact", fakeSource)
+
+		stdout = new(bytes.Buffer)
+		stderr = new(bytes.Buffer)
+
+		delegate = new(execfakes.FakeBuildStepDelegate)
+		delegate.StdoutReturns(stdout)
+		delegate.StderrReturns(stderr)
+
+		plan = atc.SetPipelinePlan{
+			Name: "some-pipeline",
+			File: "some-artifact/pipeline.yml",
+			Vars: atc.Params{"uri": "static-uri"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewSetPipelineStep(
+			"some-plan-id",
+			plan,
+			fakeBuild,
+			delegate,
+			fakeTeamFactory,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the pipeline does not exist", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(nil, false, nil)
+		})
+
+		It("saves the interpolated config as a new, unpaused pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+
+			Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(123))
+			Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+
+			pipelineRef, config, _, pausedState := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			Expect(pausedState).To(Equal(db.PipelineUnpaused))
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "static-uri"}))
+		})
+
+		It("leaves unknown vars for the credential manager to resolve at runtime", func() {
+			_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(config.Jobs[0].Plan[1].Params).To(Equal(atc.Params{"SECRET": "((secret))"}))
+		})
+
+		It("writes a diff to stdout", func() {
+			Expect(stdout.String()).To(ContainSubstring("resource some-resource has been added"))
+			Expect(stdout.String()).To(ContainSubstring("job some-job has been added"))
+		})
+
+		Context("when var files are configured", func() {
+			BeforeEach(func() {
+				plan.VarFiles = []string{"some-artifact/vars.yml"}
+			})
+
+			It("interpolates vars from the files, giving precedence to static vars", func() {
+				_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+				Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "static-uri"}))
+				Expect(config.Jobs[0].Plan[1].Params).To(Equal(atc.Params{"SECRET": "file-secret"}))
+			})
+		})
+	})
+
+	Context("when the pipeline already exists", func() {
+		BeforeEach(func() {
+			fakePipeline.ConfigVersionReturns(42)
+			fakeTeam.PipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("saves the pipeline from its current config version without changing its paused state", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+
+			Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+
+			_, _, from, pausedState := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(from).To(Equal(db.ConfigVersion(42)))
+			Expect(pausedState).To(Equal(db.PipelineNoChange))
+		})
+	})
+
+	Context("when the config has var sources", func() {
+		BeforeEach(func() {
+			fakeSource.StreamFileReturns(ioutil.NopCloser(bytes.NewBufferString(`---
+var_sources:
+- name: some-vault
+  type: vault
+  config:
+    url: https://vault.example.com
+    client_token: some-new-vault-token
+`)), nil)
+			fakeSource.StreamFileStub = nil
+
+			fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+				{
+					Name: "some-vault",
+					Type: "vault",
+					Config: map[string]interface{}{
+						"url":          "https://vault.example.com",
+						"client_token": "some-old-vault-token",
+					},
+				},
+			})
+			fakeTeam.PipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("saves the changed config", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+		})
+
+		It("does not write their configs to stdout", func() {
+			Expect(stdout.String()).To(ContainSubstring("var source some-vault has changed"))
+			Expect(stdout.String()).ToNot(ContainSubstring("vault-token"))
+			Expect(stdout.String()).ToNot(ContainSubstring("vault.example.com"))
+		})
+	})
+
+	Context("when the config has not changed", func() {
+		BeforeEach(func() {
+			fakeSource.StreamFileReturns(ioutil.NopCloser(bytes.NewBufferString("{}")), nil)
+			fakeSource.StreamFileStub = nil
+
+			fakeTeam.PipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("does not save the pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+			Expect(stdout.String()).To(ContainSubstring("no changes to apply"))
+		})
+	})
+
+	Context("when the config is invalid", func() {
+		BeforeEach(func() {
+			fakeSource.StreamFileReturns(ioutil.NopCloser(bytes.NewBufferString("jobs: [{name: some-job, plan: [{get: missing-resource}]}]")), nil)
+			fakeSource.StreamFileStub = nil
+		})
+
+		It("fails without saving the pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+			Expect(stderr.String()).To(ContainSubstring("invalid pipeline config"))
+		})
+	})
+
+	Context("when the artifact is not present", func() {
+		BeforeEach(func() {
+			plan.File = "missing-artifact/pipeline.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(BeAssignableToTypeOf(exec.UnknownArtifactSourceError{}))
+		})
+	})
+
+	Context("when saving the pipeline fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeTeam.SavePipelineReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+	})
+})
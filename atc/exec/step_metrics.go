@@ -0,0 +1,111 @@
+package exec
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// stepMetrics is handed to the worker in place of a get, put or task step's
+// delegate. It notes when the step's worker was selected and how long its
+// image and inputs took to fetch, so that the step can report where its time
+// went once it finishes.
+type stepMetrics struct {
+	BuildStepDelegate
+
+	clock clock.Clock
+
+	lock  sync.Mutex
+	event metric.StepFinished
+
+	startTime    time.Time
+	preparedTime time.Time
+	runStartTime time.Time
+}
+
+func newStepMetrics(clock clock.Clock, delegate BuildStepDelegate, event metric.StepFinished) *stepMetrics {
+	return &stepMetrics{
+		BuildStepDelegate: delegate,
+
+		clock: clock,
+
+		event:     event,
+		startTime: clock.Now(),
+	}
+}
+
+func (metrics *stepMetrics) SelectedWorker(logger lager.Logger, workerName string) {
+	metrics.lock.Lock()
+
+	// containers for fetching the step's image are placed on the same
+	// worker, so only the first selection counts
+	if metrics.event.WorkerName == "" {
+		metrics.event.WorkerName = workerName
+		metrics.event.WorkerWaitDuration = metrics.clock.Since(metrics.startTime)
+		metrics.preparedTime = metrics.clock.Now()
+	}
+
+	metrics.lock.Unlock()
+
+	metrics.BuildStepDelegate.SelectedWorker(logger, workerName)
+}
+
+func (metrics *stepMetrics) ImageFetched(logger lager.Logger, duration time.Duration) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	// fetching the step's image may involve creating containers with images
+	// of their own, but the step's image is always the last to be fetched
+	metrics.event.ImageFetchDuration = duration
+	metrics.preparedTime = metrics.clock.Now()
+}
+
+func (metrics *stepMetrics) InputStreamed(logger lager.Logger, bytes int64, duration time.Duration) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	metrics.event.InputStreamBytes += bytes
+	metrics.event.InputStreamDuration += duration
+	metrics.preparedTime = metrics.clock.Now()
+}
+
+// running marks the start of the step's process. Steps which can't tell when
+// their process starts are considered to be running as soon as their
+// container's image and inputs are ready.
+func (metrics *stepMetrics) running() {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	metrics.runStartTime = metrics.clock.Now()
+}
+
+func (metrics *stepMetrics) exited(status ExitStatus) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	runStartTime := metrics.runStartTime
+	if runStartTime.IsZero() {
+		runStartTime = metrics.preparedTime
+	}
+
+	metrics.event.Exited = true
+	metrics.event.ExitStatus = int(status)
+	metrics.event.RunDuration = metrics.clock.Since(runStartTime)
+}
+
+// emit reports the step's metrics, unless it never made it onto a worker,
+// e.g. a get step whose version was already cached.
+func (metrics *stepMetrics) emit(logger lager.Logger) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	if metrics.event.WorkerName == "" {
+		return
+	}
+
+	metrics.event.Duration = metrics.clock.Since(metrics.startTime)
+	metrics.event.Emit(logger)
+}
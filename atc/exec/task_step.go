@@ -12,12 +12,15 @@ import (
 	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -70,6 +73,7 @@ type TaskStep struct {
 	privileged    Privileged
 	configSource  TaskConfigSource
 	tags          atc.Tags
+	placement     atc.PlacementConstraints
 	inputMapping  map[string]string
 	outputMapping map[string]string
 
@@ -80,6 +84,7 @@ type TaskStep struct {
 
 	workerPool        worker.Client
 	teamID            int
+	teamName          string
 	buildID           int
 	jobID             int
 	stepName          string
@@ -91,6 +96,8 @@ type TaskStep struct {
 	variables     creds.Variables
 	defaultLimits atc.ContainerLimits
 
+	clock clock.Clock
+
 	succeeded bool
 }
 
@@ -98,6 +105,7 @@ func NewTaskStep(
 	privileged Privileged,
 	configSource TaskConfigSource,
 	tags atc.Tags,
+	placement atc.PlacementConstraints,
 	inputMapping map[string]string,
 	outputMapping map[string]string,
 	artifactsRoot string,
@@ -105,6 +113,7 @@ func NewTaskStep(
 	delegate TaskDelegate,
 	workerPool worker.Client,
 	teamID int,
+	teamName string,
 	buildID int,
 	jobID int,
 	stepName string,
@@ -113,11 +122,13 @@ func NewTaskStep(
 	resourceTypes creds.VersionedResourceTypes,
 	variables creds.Variables,
 	defaultLimits atc.ContainerLimits,
+	clock clock.Clock,
 ) Step {
 	return &TaskStep{
 		privileged:        privileged,
 		configSource:      configSource,
 		tags:              tags,
+		placement:         placement,
 		inputMapping:      inputMapping,
 		outputMapping:     outputMapping,
 		artifactsRoot:     artifactsRoot,
@@ -125,6 +136,7 @@ func NewTaskStep(
 		delegate:          delegate,
 		workerPool:        workerPool,
 		teamID:            teamID,
+		teamName:          teamName,
 		buildID:           buildID,
 		jobID:             jobID,
 		stepName:          stepName,
@@ -133,6 +145,7 @@ func NewTaskStep(
 		resourceTypes:     resourceTypes,
 		variables:         variables,
 		defaultLimits:     defaultLimits,
+		clock:             clock,
 	}
 }
 
@@ -152,6 +165,17 @@ func NewTaskStep(
 // task's entire working directory is registered as an ArtifactSource under the
 // name of the task.
 func (action *TaskStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
+		"name": action.stepName,
+	})
+
+	err := action.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (action *TaskStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	repository := state.Artifacts()
@@ -179,11 +203,46 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
-	container, err := action.workerPool.FindOrCreateContainer(
+	// let the task attach its own spans to the build's trace
+	containerSpec.Env = append(containerSpec.Env, tracing.Env(ctx)...)
+
+	owner := db.NewBuildStepContainerOwner(action.buildID, action.planID)
+
+	metrics := newStepMetrics(action.clock, action.delegate, metric.StepFinished{
+		TeamName:     action.teamName,
+		PipelineName: action.containerMetadata.PipelineName,
+		JobName:      action.containerMetadata.JobName,
+		StepName:     action.stepName,
+		StepType:     "task",
+	})
+
+	defer metrics.emit(logger)
+
+	chosenWorker, err := action.workerPool.FindOrChooseWorker(
+		ctx,
+		logger,
+		metrics,
+		owner,
+		containerSpec,
+		action.resourceTypes,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the task was counted as active on the worker when it was chosen
+	defer func() {
+		err := chosenWorker.DecreaseActiveTasks()
+		if err != nil {
+			logger.Error("failed-to-decrease-active-tasks", err)
+		}
+	}()
+
+	container, err := chosenWorker.FindOrCreateContainer(
 		ctx,
 		logger,
-		action.delegate,
-		db.NewBuildStepContainerOwner(action.buildID, action.planID),
+		metrics,
+		owner,
 		action.containerMetadata,
 		containerSpec,
 		action.resourceTypes,
@@ -216,6 +275,8 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		Stderr: action.delegate.Stderr(),
 	}
 
+	metrics.running()
+
 	process, err := container.Attach(taskProcessID, processIO)
 	if err == nil {
 		logger.Info("already-running")
@@ -278,6 +339,8 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 			return err
 		}
 
+		metrics.exited(ExitStatus(processStatus))
+
 		action.delegate.Finished(logger, ExitStatus(processStatus))
 
 		err = container.SetProperty(taskExitStatusPropertyName, fmt.Sprintf("%d", processStatus))
@@ -326,8 +389,10 @@ func (action *TaskStep) containerSpec(logger lager.Logger, repository *worker.Ar
 	}
 
 	containerSpec := worker.ContainerSpec{
+		Type:      db.ContainerTypeTask,
 		Platform:  config.Platform,
 		Tags:      action.tags,
+		Placement: action.placement,
 		TeamID:    action.teamID,
 		ImageSpec: imageSpec,
 		Limits:    worker.ContainerLimits(config.Limits),
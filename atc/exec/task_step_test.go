@@ -8,16 +8,23 @@ import (
 	"io"
 	"io/ioutil"
 	"strings"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracetest"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
@@ -31,6 +38,7 @@ var _ = Describe("TaskStep", func() {
 		cancel func()
 
 		fakeWorkerClient *workerfakes.FakeClient
+		fakeWorker       *workerfakes.FakeWorker
 
 		stdoutBuf *gbytes.Buffer
 		stderrBuf *gbytes.Buffer
@@ -39,9 +47,11 @@ var _ = Describe("TaskStep", func() {
 		containerMetadata db.ContainerMetadata
 
 		fakeDelegate *execfakes.FakeTaskDelegate
+		fakeClock    *fakeclock.FakeClock
 
 		privileged    exec.Privileged
 		tags          []string
+		placement     atc.PlacementConstraints
 		teamID        int
 		buildID       int
 		planID        atc.PlanID
@@ -64,16 +74,22 @@ var _ = Describe("TaskStep", func() {
 		ctx, cancel = context.WithCancel(context.Background())
 
 		fakeWorkerClient = new(workerfakes.FakeClient)
+		fakeWorker = new(workerfakes.FakeWorker)
+		fakeWorkerClient.FindOrChooseWorkerReturns(fakeWorker, nil)
 
 		stdoutBuf = gbytes.NewBuffer()
 		stderrBuf = gbytes.NewBuffer()
 
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
 		fakeDelegate.StdoutReturns(stdoutBuf)
 		fakeDelegate.StderrReturns(stderrBuf)
 
 		privileged = false
 		tags = []string{"step", "tags"}
+		placement = atc.PlacementConstraints{
+			{Key: "os", Operator: atc.PlacementOperatorIn, Values: []string{"linux"}},
+		}
 		teamID = 123
 		planID = atc.PlanID(42)
 		buildID = 1234
@@ -118,6 +134,7 @@ var _ = Describe("TaskStep", func() {
 			privileged,
 			configSource,
 			tags,
+			placement,
 			inputMapping,
 			outputMapping,
 			"some-artifact-root",
@@ -125,6 +142,7 @@ var _ = Describe("TaskStep", func() {
 			fakeDelegate,
 			fakeWorkerClient,
 			teamID,
+			"some-team",
 			buildID,
 			jobID,
 			"some-task",
@@ -133,6 +151,7 @@ var _ = Describe("TaskStep", func() {
 			resourceTypes,
 			variables,
 			atc.ContainerLimits{},
+			fakeClock,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -176,14 +195,14 @@ var _ = Describe("TaskStep", func() {
 			BeforeEach(func() {
 				fakeContainer = new(workerfakes.FakeContainer)
 				fakeContainer.HandleReturns("some-handle")
-				fakeWorkerClient.FindOrCreateContainerReturns(fakeContainer, nil)
+				fakeWorker.FindOrCreateContainerReturns(fakeContainer, nil)
 			})
 
 			Describe("before creating a container", func() {
 				BeforeEach(func() {
 					fakeDelegate.InitializingStub = func(lager.Logger, atc.TaskConfig) {
 						defer GinkgoRecover()
-						Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(BeZero())
+						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
 					}
 				})
 
@@ -192,9 +211,24 @@ var _ = Describe("TaskStep", func() {
 				})
 			})
 
+			It("chooses a worker for the task", func() {
+				Expect(fakeWorkerClient.FindOrChooseWorkerCallCount()).To(Equal(1))
+				_, _, delegate, owner, spec, actualResourceTypes := fakeWorkerClient.FindOrChooseWorkerArgsForCall(0)
+				delegate.SelectedWorker(lagertest.NewTestLogger("test"), "some-worker")
+				Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
+				Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID)))
+				Expect(spec.Type).To(Equal(db.ContainerTypeTask))
+				Expect(spec.Placement).To(Equal(placement))
+				Expect(actualResourceTypes).To(Equal(resourceTypes))
+			})
+
+			It("stops counting the task as active on the worker once it is done", func() {
+				Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+			})
+
 			It("finds or creates a container", func() {
-				Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
-				_, cancel, delegate, owner, createdMetadata, spec, actualResourceTypes := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				_, cancel, delegate, owner, createdMetadata, spec, actualResourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
 				Expect(cancel).ToNot(BeNil())
 				Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID)))
 				Expect(createdMetadata).To(Equal(db.ContainerMetadata{
@@ -202,14 +236,17 @@ var _ = Describe("TaskStep", func() {
 					StepName: "some-step",
 				}))
 
-				Expect(delegate).To(Equal(fakeDelegate))
+				delegate.SelectedWorker(lagertest.NewTestLogger("test"), "some-worker")
+				Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
 
 				cpu := uint64(1024)
 				memory := uint64(1024)
 				Expect(spec).To(Equal(worker.ContainerSpec{
-					Platform: "some-platform",
-					Tags:     []string{"step", "tags"},
-					TeamID:   teamID,
+					Type:      db.ContainerTypeTask,
+					Platform:  "some-platform",
+					Tags:      []string{"step", "tags"},
+					Placement: placement,
+					TeamID:    teamID,
 					ImageSpec: worker.ImageSpec{
 						ImageResource: &worker.ImageResource{
 							Type:    "docker",
@@ -231,6 +268,35 @@ var _ = Describe("TaskStep", func() {
 				Expect(actualResourceTypes).To(Equal(resourceTypes))
 			})
 
+			Context("when tracing is configured", func() {
+				var provider *tracetest.Provider
+
+				BeforeEach(func() {
+					provider = tracetest.NewProvider()
+					tracing.ConfigureTraceProvider(provider)
+				})
+
+				AfterEach(func() {
+					tracing.Configured = false
+				})
+
+				It("runs the task in a span", func() {
+					spans := provider.Spans()
+					Expect(spans).To(HaveLen(1))
+					Expect(spans[0].Name()).To(Equal("task"))
+					Expect(spans[0].Ended()).To(BeTrue())
+				})
+
+				It("propagates the trace context to the container", func() {
+					_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+
+					sc := provider.Spans()[0].SpanContext()
+					Expect(spec.Env).To(ContainElement(
+						"TRACEPARENT=00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-00",
+					))
+				})
+			})
+
 			Context("when rootfs uri is set instead of image resource", func() {
 				BeforeEach(func() {
 					fetchedConfig = atc.TaskConfig{
@@ -247,8 +313,8 @@ var _ = Describe("TaskStep", func() {
 				})
 
 				It("finds or creates a container", func() {
-					Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
-					_, cancel, delegate, owner, createdMetadata, spec, actualResourceTypes := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+					_, cancel, delegate, owner, createdMetadata, spec, actualResourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
 					Expect(cancel).ToNot(BeNil())
 					Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID)))
 					Expect(createdMetadata).To(Equal(db.ContainerMetadata{
@@ -256,12 +322,15 @@ var _ = Describe("TaskStep", func() {
 						StepName: "some-step",
 					}))
 
-					Expect(delegate).To(Equal(fakeDelegate))
+					delegate.SelectedWorker(lagertest.NewTestLogger("test"), "some-worker")
+					Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
 
 					Expect(spec).To(Equal(worker.ContainerSpec{
-						Platform: "some-platform",
-						Tags:     []string{"step", "tags"},
-						TeamID:   teamID,
+						Type:      db.ContainerTypeTask,
+						Platform:  "some-platform",
+						Tags:      []string{"step", "tags"},
+						Placement: placement,
+						TeamID:    teamID,
 						ImageSpec: worker.ImageSpec{
 							ImageURL:   "some-image",
 							Privileged: false,
@@ -470,8 +539,8 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("creates the container privileged", func() {
-						Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
-						_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+						_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(spec.ImageSpec.Privileged).To(BeTrue())
 					})
 
@@ -519,7 +588,7 @@ var _ = Describe("TaskStep", func() {
 						})
 
 						It("creates the container with the inputs configured correctly", func() {
-							_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(spec.Inputs).To(HaveLen(2))
 							for _, input := range spec.Inputs {
 								switch input.DestinationPath() {
@@ -569,7 +638,7 @@ var _ = Describe("TaskStep", func() {
 						})
 
 						It("uses remapped input", func() {
-							_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(spec.Inputs).To(HaveLen(1))
 							Expect(spec.Inputs[0].Source()).To(Equal(remappedInputSource))
 							Expect(spec.Inputs[0].DestinationPath()).To(Equal("some-artifact-root/remapped-input"))
@@ -614,7 +683,7 @@ var _ = Describe("TaskStep", func() {
 
 						It("runs successfully without the optional input", func() {
 							Expect(stepErr).ToNot(HaveOccurred())
-							_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(spec.Inputs).To(HaveLen(2))
 							Expect(spec.Inputs[0].Source()).To(Equal(optionalInput2Source))
 							Expect(spec.Inputs[0].DestinationPath()).To(Equal("some-artifact-root/optional-input-2"))
@@ -668,7 +737,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("creates the container with the caches in the inputs", func() {
-						_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(spec.Inputs).To(HaveLen(2))
 						Expect([]string{
 							spec.Inputs[0].DestinationPath(),
@@ -729,7 +798,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("configures them appropriately in the container spec", func() {
-						_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(spec.Outputs).To(Equal(worker.OutputPaths{
 							"some-output":                "some-artifact-root/some-output-configured-path/",
 							"some-other-output":          "some-artifact-root/some-other-output/",
@@ -829,7 +898,7 @@ var _ = Describe("TaskStep", func() {
 								})
 
 								It("passes existing output volumes to the resource", func() {
-									_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+									_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 									Expect(spec.Outputs).To(Equal(worker.OutputPaths{
 										"some-output":                "some-artifact-root/some-output-configured-path/",
 										"some-other-output":          "some-artifact-root/some-other-output/",
@@ -1142,7 +1211,7 @@ var _ = Describe("TaskStep", func() {
 						})
 
 						It("creates the container with the image artifact source", func() {
-							_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(spec.ImageSpec).To(Equal(worker.ImageSpec{
 								ImageArtifactSource: imageArtifactSource,
 								ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1177,7 +1246,7 @@ var _ = Describe("TaskStep", func() {
 									})
 
 									It("still creates the container with the volume and a metadata stream", func() {
-										_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+										_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 										Expect(spec.ImageSpec).To(Equal(worker.ImageSpec{
 											ImageArtifactSource: imageArtifactSource,
 											ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1206,7 +1275,7 @@ var _ = Describe("TaskStep", func() {
 									})
 
 									It("still creates the container with the volume and a metadata stream", func() {
-										_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+										_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 										Expect(spec.ImageSpec).To(Equal(worker.ImageSpec{
 											ImageArtifactSource: imageArtifactSource,
 											ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1236,7 +1305,7 @@ var _ = Describe("TaskStep", func() {
 									})
 
 									It("still creates the container with the volume and a metadata stream", func() {
-										_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+										_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 										Expect(spec.ImageSpec).To(Equal(worker.ImageSpec{
 											ImageArtifactSource: imageArtifactSource,
 											ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1277,7 +1346,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("adds the user to the container spec", func() {
-						_, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, spec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(spec.User).To(Equal("some-user"))
 					})
 
@@ -1374,6 +1443,66 @@ var _ = Describe("TaskStep", func() {
 						Expect(taskStep.Succeeded()).To(BeFalse())
 					})
 
+					Context("when a metrics emitter is configured", func() {
+						var fakeEmitter *metricfakes.FakeEmitter
+
+						BeforeEach(func() {
+							fakeEmitterFactory := new(metricfakes.FakeEmitterFactory)
+							fakeEmitter = new(metricfakes.FakeEmitter)
+							fakeEmitterFactory.IsConfiguredReturns(true)
+							fakeEmitterFactory.NewEmitterReturns(fakeEmitter, nil)
+
+							metric.RegisterEmitter(fakeEmitterFactory)
+							err := metric.Initialize(lagertest.NewTestLogger("test"), "test", map[string]string{}, metric.Dimensions{
+								Allowed: []string{metric.DimensionTeam, metric.DimensionStep, metric.DimensionWorker},
+							})
+							Expect(err).ToNot(HaveOccurred())
+
+							fakeWorker.FindOrCreateContainerStub = func(_ context.Context, logger lager.Logger, delegate worker.ImageFetchingDelegate, _ db.ContainerOwner, _ db.ContainerMetadata, _ worker.ContainerSpec, _ creds.VersionedResourceTypes) (worker.Container, error) {
+								fakeClock.Increment(10 * time.Second)
+								delegate.SelectedWorker(logger, "some-worker")
+								fakeClock.Increment(time.Minute)
+								delegate.ImageFetched(logger, time.Minute)
+								delegate.InputStreamed(logger, 1024, time.Second)
+								delegate.InputStreamed(logger, 2048, 2*time.Second)
+								return fakeContainer, nil
+							}
+
+							fakeProcess.WaitStub = func() (int, error) {
+								fakeClock.Increment(30 * time.Second)
+								return 1, nil
+							}
+						})
+
+						It("emits where the step spent its time and how it exited", func() {
+							Eventually(fakeEmitter.EmitCallCount).Should(Equal(7))
+
+							events := map[string]metric.Event{}
+							for i := 0; i < fakeEmitter.EmitCallCount(); i++ {
+								_, event := fakeEmitter.EmitArgsForCall(i)
+								events[event.Name] = event
+							}
+
+							Expect(events["build step duration"].Value).To(Equal(float64(100000)))
+							Expect(events["build step duration"].Histogram).To(BeTrue())
+							Expect(events["step worker wait duration"].Value).To(Equal(float64(10000)))
+							Expect(events["step run duration"].Value).To(Equal(float64(30000)))
+
+							Expect(events["step image fetch duration"].Value).To(Equal(float64(60000)))
+							Expect(events["step input stream duration"].Value).To(Equal(float64(3000)))
+							Expect(events["step input stream bytes"].Value).To(Equal(int64(3072)))
+
+							Expect(events["step exit status"].Value).To(Equal(1))
+							Expect(events["step exit status"].State).To(Equal(metric.EventStateWarning))
+							Expect(events["step exit status"].Labels).To(Equal(map[string]string{
+								"team":      "some-team",
+								"step":      "some-task",
+								"step_type": "task",
+								"worker":    "some-worker",
+							}))
+						})
+					})
+
 					Context("when saving the exit status succeeds", func() {
 						BeforeEach(func() {
 							fakeContainer.SetPropertyReturns(nil)
@@ -1504,7 +1633,7 @@ var _ = Describe("TaskStep", func() {
 			disaster := errors.New("nope")
 
 			BeforeEach(func() {
-				fakeWorkerClient.FindOrCreateContainerReturns(nil, disaster)
+				fakeWorker.FindOrCreateContainerReturns(nil, disaster)
 			})
 
 			It("returns the error", func() {
@@ -2,9 +2,13 @@ package gc
 
 import (
 	"context"
+	"math"
+	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/logarchive"
 )
 
 type buildLogCollector struct {
@@ -12,6 +16,8 @@ type buildLogCollector struct {
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	clock                       clock.Clock
+	archiver                    logarchive.Archiver
 }
 
 func NewBuildLogCollector(
@@ -19,12 +25,16 @@ func NewBuildLogCollector(
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	clock clock.Clock,
+	archiver logarchive.Archiver,
 ) Collector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		clock:                       clock,
+		archiver:                    archiver,
 	}
 }
 
@@ -41,7 +51,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 	}
 
 	for _, pipeline := range pipelines {
-		if pipeline.Paused() {
+		// archived pipelines keep their build logs around for posterity
+		if pipeline.Paused() || pipeline.Archived() {
 			continue
 		}
 
@@ -52,8 +63,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 		}
 
 		for _, job := range jobs {
-			buildLogsToRetain := br.buildLogRetentionCalculator.BuildLogsToRetain(job)
-			if buildLogsToRetain == 0 {
+			retention := br.buildLogRetentionCalculator.BuildLogsToRetain(job)
+			if retention.Builds == 0 && retention.Days == 0 {
 				continue
 			}
 
@@ -76,9 +87,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 			}
 
 			if limit > 0 {
-				moreBuildsToConsiderDeleting, _, err := job.Builds(
-					db.Page{Until: until, Limit: limit},
-				)
+				moreBuildsToConsiderDeleting, err := unreapedBuildsAfter(job, until, limit)
 				if err != nil {
 					logger.Error("failed-to-get-job-builds-to-delete", err)
 					return err
@@ -95,26 +104,51 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				buildIDsToConsiderDeleting = append(buildIDsToConsiderDeleting, build.ID())
 			}
 
-			buildsToRetain, _, err := job.Builds(
-				db.Page{Limit: buildLogsToRetain},
-			)
+			firstBuildToRetain := math.MaxInt32
+			if retention.Builds > 0 {
+				buildsToRetain, _, err := job.Builds(
+					db.Page{Limit: retention.Builds},
+				)
+				if err != nil {
+					logger.Error("failed-to-get-job-builds-to-retain", err)
+					return err
+				}
+
+				if len(buildsToRetain) == 0 {
+					continue
+				}
+
+				firstBuildToRetain = buildsToRetain[len(buildsToRetain)-1].ID()
+			}
+
+			// never reap the latest successful build(s), so that there is always
+			// a log of what last worked. they are skipped rather than retaining
+			// every build since, which would keep all the builds of a job that
+			// has been failing for a long time.
+			succeededBuildsToRetain := retention.MinimumSucceededBuilds
+			if succeededBuildsToRetain < 1 {
+				succeededBuildsToRetain = 1
+			}
+
+			succeededBuilds, err := job.LatestSucceededBuilds(succeededBuildsToRetain)
 			if err != nil {
-				logger.Error("failed-to-get-job-builds-to-retain", err)
+				logger.Error("failed-to-get-job-succeeded-builds-to-retain", err)
 				return err
 			}
 
-			buildIDsToRetain := []int{}
-			for _, build := range buildsToRetain {
-				buildIDsToRetain = append(buildIDsToRetain, build.ID())
+			succeededBuildIDsToRetain := map[int]bool{}
+			for _, build := range succeededBuilds {
+				succeededBuildIDsToRetain[build.ID()] = true
 			}
 
-			if len(buildsToRetain) == 0 {
-				continue
+			var retainSince time.Time
+			if retention.Days > 0 {
+				retainSince = br.clock.Now().Add(-time.Duration(retention.Days) * 24 * time.Hour)
 			}
 
-			firstBuildToRetain := buildsToRetain[len(buildsToRetain)-1].ID()
-
+			buildsToDelete := []db.Build{}
 			buildIDsToDelete := []int{}
+			firstSucceededBuildRetained := 0
 			for i := len(buildsToConsiderDeleting) - 1; i >= 0; i-- {
 				build := buildsToConsiderDeleting[i]
 
@@ -122,12 +156,29 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 					break
 				}
 
+				if retention.Days > 0 && build.EndTime().After(retainSince) {
+					break
+				}
+
+				if !build.ReapTime().IsZero() {
+					continue
+				}
+
+				if succeededBuildIDsToRetain[build.ID()] {
+					if firstSucceededBuildRetained == 0 {
+						firstSucceededBuildRetained = build.ID()
+					}
+
+					continue
+				}
+
 				if br.drainerConfigured == true {
 					if build.IsDrained() == false {
 						continue
 					}
 				}
 
+				buildsToDelete = append(buildsToDelete, build)
 				buildIDsToDelete = append(buildIDsToDelete, build.ID())
 			}
 
@@ -135,13 +186,36 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				continue
 			}
 
+			if br.archiver != nil {
+				for _, build := range buildsToDelete {
+					err = br.archiver.Archive(logger, build)
+					if err != nil {
+						logger.Error("failed-to-archive-build-events", err)
+						return err
+					}
+
+					err = build.MarkLogsArchived()
+					if err != nil {
+						logger.Error("failed-to-mark-build-logs-archived", err)
+						return err
+					}
+				}
+			}
+
 			err = pipeline.DeleteBuildEventsByBuildIDs(buildIDsToDelete)
 			if err != nil {
 				logger.Error("failed-to-delete-build-events", err)
 				return err
 			}
 
-			err = job.UpdateFirstLoggedBuildID(buildIDsToDelete[len(buildIDsToDelete)-1] + 1)
+			// a retained successful build is reaped once newer ones succeed, so
+			// the first logged build must not move past it
+			firstLoggedBuildID := buildIDsToDelete[len(buildIDsToDelete)-1] + 1
+			if firstSucceededBuildRetained != 0 && firstSucceededBuildRetained < firstLoggedBuildID {
+				firstLoggedBuildID = firstSucceededBuildRetained
+			}
+
+			err = job.UpdateFirstLoggedBuildID(firstLoggedBuildID)
 			if err != nil {
 				logger.Error("failed-to-update-first-logged-build-id", err)
 				return err
@@ -151,3 +225,36 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// unreapedBuildsAfter returns up to limit builds after the given build ID
+// whose logs have not been reaped yet, newest first. The builds after the first
+// logged build may already have been reaped when it was held back by a
+// retained successful build, so these are paged past rather than filling the
+// batch.
+func unreapedBuildsAfter(job db.Job, after int, limit int) ([]db.Build, error) {
+	builds := []db.Build{}
+
+	for len(builds) < limit {
+		requested := limit - len(builds)
+
+		page, _, err := job.Builds(db.Page{Until: after, Limit: requested})
+		if err != nil {
+			return nil, err
+		}
+
+		// pages of builds are ordered newest first
+		for i := len(page) - 1; i >= 0; i-- {
+			if page[i].ReapTime().IsZero() {
+				builds = append([]db.Build{page[i]}, builds...)
+			}
+		}
+
+		if len(page) < requested {
+			break
+		}
+
+		after = page[0].ID()
+	}
+
+	return builds, nil
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -20,12 +26,16 @@ var _ = Describe("BuildLogCollector", func() {
 		fakePipelineFactory *dbfakes.FakePipelineFactory
 		batchSize           int
 		buildLogRetainCalc  BuildLogRetentionCalculator
+		fakeClock           *fakeclock.FakeClock
+		archiver            logarchive.Archiver
 	)
 
 	BeforeEach(func() {
 		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
 		batchSize = 5
-		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0)
+		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0, 0, 0)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		archiver = nil
 	})
 
 	JustBeforeEach(func() {
@@ -34,6 +44,8 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			fakeClock,
+			archiver,
 		)
 	})
 
@@ -69,7 +81,7 @@ var _ = Describe("BuildLogCollector", func() {
 				fakeJob.NameReturns("job-1")
 				fakeJob.FirstLoggedBuildIDReturns(6)
 				fakeJob.ConfigReturns(atc.JobConfig{
-					BuildLogsToRetain: 10,
+					BuildLogsToRetain: &atc.BuildLogRetention{Builds: 10},
 				})
 
 				fakePipeline.JobsReturns([]db.Job{fakeJob}, nil)
@@ -82,6 +94,8 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						fakeClock,
+						archiver,
 					)
 				})
 				BeforeEach(func() {
@@ -330,6 +344,199 @@ var _ = Describe("BuildLogCollector", func() {
 				})
 			})
 
+			Context("when the latest successful build would be reaped", func() {
+				BeforeEach(func() {
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if page == (db.Page{Limit: 10}) {
+							return []db.Build{sb(16), sb(15), sb(14), sb(13), sb(12), sb(11), sb(10), sb(9), sb(8), sb(7)}, db.Pagination{}, nil
+						} else if page == (db.Page{Until: 5, Limit: 5}) {
+							return []db.Build{sb(10), sb(9), sb(8), sb(7), sb(6)}, db.Pagination{}, nil
+						}
+
+						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+						return nil, db.Pagination{}, nil
+					}
+
+					fakeJob.LatestSucceededBuildsReturns([]db.Build{sb(6)}, nil)
+
+					fakePipeline.DeleteBuildEventsByBuildIDsReturns(nil)
+					fakeJob.UpdateFirstLoggedBuildIDReturns(nil)
+				})
+
+				It("keeps it", func() {
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeJob.LatestSucceededBuildsCallCount()).To(Equal(1))
+					Expect(fakeJob.LatestSucceededBuildsArgsForCall(0)).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+				})
+
+				Context("when many builds have failed since", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							BuildLogsToRetain: &atc.BuildLogRetention{Builds: 3},
+						})
+
+						fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+							if page == (db.Page{Limit: 3}) {
+								return []db.Build{sb(16), sb(15), sb(14)}, db.Pagination{}, nil
+							} else if page == (db.Page{Until: 5, Limit: 5}) {
+								return []db.Build{sb(10), sb(9), sb(8), sb(7), sb(6)}, db.Pagination{}, nil
+							}
+
+							Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+							return nil, db.Pagination{}, nil
+						}
+					})
+
+					It("keeps it but reaps the failed builds past the limit", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(7, 8, 9, 10))
+					})
+
+					It("does not move the first logged build past it", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)).To(Equal(6))
+					})
+				})
+
+				Context("when a newer build has succeeded since it was kept", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							BuildLogsToRetain: &atc.BuildLogRetention{Builds: 3},
+						})
+
+						fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+							if page == (db.Page{Limit: 3}) {
+								return []db.Build{sb(17), sb(16), sb(15)}, db.Pagination{}, nil
+							} else if page == (db.Page{Until: 5, Limit: 5}) {
+								return []db.Build{reapedBuild(10), reapedBuild(9), reapedBuild(8), reapedBuild(7), sb(6)}, db.Pagination{}, nil
+							} else if page == (db.Page{Until: 10, Limit: 4}) {
+								return []db.Build{sb(14), sb(13), sb(12), sb(11)}, db.Pagination{}, nil
+							}
+
+							Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+							return nil, db.Pagination{}, nil
+						}
+
+						fakeJob.LatestSucceededBuildsReturns([]db.Build{sb(12)}, nil)
+					})
+
+					It("reaps it along with the builds which were not reaped yet", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6, 11, 13, 14))
+					})
+
+					It("moves the first logged build up to the newer successful build", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)).To(Equal(12))
+					})
+				})
+
+				Context("when the job asks for more successful builds to be kept", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							BuildLogsToRetain: &atc.BuildLogRetention{
+								Builds:                 10,
+								MinimumSucceededBuilds: 3,
+							},
+						})
+					})
+
+					It("asks for that many", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeJob.LatestSucceededBuildsArgsForCall(0)).To(Equal(3))
+					})
+				})
+
+				Context("when getting the successful builds fails", func() {
+					var disaster error
+
+					BeforeEach(func() {
+						disaster = errors.New("major malfunction")
+						fakeJob.LatestSucceededBuildsReturns(nil, disaster)
+					})
+
+					It("returns the error", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).To(Equal(disaster))
+					})
+				})
+			})
+
+			Context("when the job retains builds for a number of days", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{
+						BuildLogsToRetain: &atc.BuildLogRetention{Days: 2},
+					})
+
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if page == (db.Page{Until: 5, Limit: 5}) {
+							return []db.Build{
+								endedBuild(10, fakeClock.Now().Add(-time.Hour)),
+								endedBuild(9, fakeClock.Now().Add(-47*time.Hour)),
+								endedBuild(8, fakeClock.Now().Add(-49*time.Hour)),
+								endedBuild(7, fakeClock.Now().Add(-72*time.Hour)),
+								endedBuild(6, fakeClock.Now().Add(-96*time.Hour)),
+							}, db.Pagination{}, nil
+						}
+
+						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+						return nil, db.Pagination{}, nil
+					}
+
+					fakePipeline.DeleteBuildEventsByBuildIDsReturns(nil)
+					fakeJob.UpdateFirstLoggedBuildIDReturns(nil)
+				})
+
+				It("reaps builds which ended before then", func() {
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6, 7, 8))
+
+					Expect(fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)).To(Equal(9))
+				})
+
+				Context("when the job also retains a number of builds", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							BuildLogsToRetain: &atc.BuildLogRetention{Builds: 4, Days: 2},
+						})
+
+						buildsStub := fakeJob.BuildsStub
+						fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+							if page == (db.Page{Limit: 4}) {
+								return []db.Build{sb(10), sb(9), sb(8), sb(7)}, db.Pagination{}, nil
+							}
+
+							return buildsStub(page)
+						}
+					})
+
+					It("keeps whichever retains more", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6))
+					})
+				})
+			})
+
 			Context("when no builds exist", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsReturns(nil, db.Pagination{}, nil)
@@ -372,7 +579,7 @@ var _ = Describe("BuildLogCollector", func() {
 				fakeJob.NameReturns("job-1")
 				fakeJob.FirstLoggedBuildIDReturns(1)
 				fakeJob.ConfigReturns(atc.JobConfig{
-					BuildLogsToRetain: 10,
+					BuildLogsToRetain: &atc.BuildLogRetention{Builds: 10},
 				})
 
 				fakePipeline.JobsReturns([]db.Job{fakeJob}, nil)
@@ -380,7 +587,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when we install a custom build log retention calculator", func() {
 				BeforeEach(func() {
-					buildLogRetainCalc = NewBuildLogRetentionCalculator(3, 3)
+					buildLogRetainCalc = NewBuildLogRetentionCalculator(3, 3, 0, 0)
 
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Since: 2, Limit: 1}) {
@@ -466,6 +673,62 @@ var _ = Describe("BuildLogCollector", func() {
 						Expect(actualNewFirstLoggedBuildID).To(Equal(2))
 					})
 				})
+
+				Context("when an archiver is configured", func() {
+					var fakeArchiver *logarchivefakes.FakeArchiver
+
+					BeforeEach(func() {
+						fakeArchiver = new(logarchivefakes.FakeArchiver)
+						archiver = fakeArchiver
+					})
+
+					It("archives each build before reaping it", func() {
+						fakeArchiver.ArchiveStub = func(_ lager.Logger, _ db.Build) error {
+							Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+							return nil
+						}
+
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						archivedBuildIDs := []int{}
+						for i := 0; i < fakeArchiver.ArchiveCallCount(); i++ {
+							_, build := fakeArchiver.ArchiveArgsForCall(i)
+							archivedBuildIDs = append(archivedBuildIDs, build.ID())
+						}
+
+						Expect(archivedBuildIDs).To(ConsistOf(1, 2, 3, 4, 5))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					})
+
+					It("marks the logs of each build as archived", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeArchiver.ArchiveCallCount()).To(Equal(5))
+						for i := 0; i < fakeArchiver.ArchiveCallCount(); i++ {
+							_, build := fakeArchiver.ArchiveArgsForCall(i)
+							Expect(build.(*dbfakes.FakeBuild).MarkLogsArchivedCallCount()).To(Equal(1))
+						}
+					})
+
+					Context("when archiving fails", func() {
+						var disaster error
+
+						BeforeEach(func() {
+							disaster = errors.New("bucket on fire")
+							fakeArchiver.ArchiveReturns(disaster)
+						})
+
+						It("returns the error without reaping anything", func() {
+							err := buildLogCollector.Run(context.TODO())
+							Expect(err).To(Equal(disaster))
+
+							Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+							Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+						})
+					})
+				})
 			})
 
 			Context("when no build of this job has build id 1", func() {
@@ -520,7 +783,7 @@ var _ = Describe("BuildLogCollector", func() {
 				fakeJob.NameReturns("job-1")
 				fakeJob.FirstLoggedBuildIDReturns(0)
 				fakeJob.ConfigReturns(atc.JobConfig{
-					BuildLogsToRetain: 10,
+					BuildLogsToRetain: &atc.BuildLogRetention{Builds: 10},
 				})
 
 				fakePipeline.JobsReturns([]db.Job{fakeJob}, nil)
@@ -573,7 +836,7 @@ var _ = Describe("BuildLogCollector", func() {
 				fakeJob.NameReturns("job-1")
 				fakeJob.FirstLoggedBuildIDReturns(6)
 				fakeJob.ConfigReturns(atc.JobConfig{
-					BuildLogsToRetain: 0,
+					BuildLogsToRetain: &atc.BuildLogRetention{Builds: 0},
 				})
 				fakeJob.TagsReturns([]string{})
 
@@ -637,6 +900,22 @@ func sb(id int) db.Build {
 	return build
 }
 
+func reapedBuild(id int) db.Build {
+	build := new(dbfakes.FakeBuild)
+	build.IDReturns(id)
+	build.IsRunningReturns(false)
+	build.ReapTimeReturns(time.Unix(1, 0))
+	return build
+}
+
+func endedBuild(id int, endTime time.Time) db.Build {
+	build := new(dbfakes.FakeBuild)
+	build.IDReturns(id)
+	build.IsRunningReturns(false)
+	build.EndTimeReturns(endTime)
+	return build
+}
+
 func sbDrained(id int, drained bool) db.Build {
 	build := new(dbfakes.FakeBuild)
 	build.IsDrainedReturns(drained)
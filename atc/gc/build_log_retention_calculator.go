@@ -1,47 +1,67 @@
 package gc
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type BuildLogRetentionCalculator interface {
-	BuildLogsToRetain(db.Job) int
+	BuildLogsToRetain(db.Job) atc.BuildLogRetention
 }
 
 type buildLogRetentionCalculator struct {
 	defaultBuildLogsToRetain uint64
 	maxBuildLogsToRetain     uint64
+	defaultDaysToRetain      uint64
+	maxDaysToRetain          uint64
 }
 
 func NewBuildLogRetentionCalculator(
 	defaultBuildLogsToRetain uint64,
 	maxBuildLogsToRetain uint64,
+	defaultDaysToRetain uint64,
+	maxDaysToRetain uint64,
 ) BuildLogRetentionCalculator {
 	return &buildLogRetentionCalculator{
 		defaultBuildLogsToRetain: defaultBuildLogsToRetain,
 		maxBuildLogsToRetain:     maxBuildLogsToRetain,
+		defaultDaysToRetain:      defaultDaysToRetain,
+		maxDaysToRetain:          maxDaysToRetain,
 	}
 }
 
-func (blrc *buildLogRetentionCalculator) BuildLogsToRetain(job db.Job) int {
+func (blrc *buildLogRetentionCalculator) BuildLogsToRetain(job db.Job) atc.BuildLogRetention {
 	// What does the job want?
-	buildLogsToRetain := job.Config().BuildLogsToRetain
+	var retention atc.BuildLogRetention
+	if job.Config().BuildLogsToRetain != nil {
+		retention = *job.Config().BuildLogsToRetain
+	}
+
+	return atc.BuildLogRetention{
+		Builds:                 retainWithin(retention.Builds, blrc.defaultBuildLogsToRetain, blrc.maxBuildLogsToRetain),
+		Days:                   retainWithin(retention.Days, blrc.defaultDaysToRetain, blrc.maxDaysToRetain),
+		MinimumSucceededBuilds: retention.MinimumSucceededBuilds,
+	}
+}
 
+// retainWithin applies the ATC-wide default and maximum to a value configured
+// on a job, where 0 means to retain everything.
+func retainWithin(configured int, defaultValue uint64, maxValue uint64) int {
 	// If not specified, set to default
-	if buildLogsToRetain == 0 {
-		buildLogsToRetain = int(blrc.defaultBuildLogsToRetain)
+	if configured == 0 {
+		configured = int(defaultValue)
 	}
 
 	// If we don't have a max set, then we're done
-	if blrc.maxBuildLogsToRetain == 0 {
-		return buildLogsToRetain
+	if maxValue == 0 {
+		return configured
 	}
 
 	// If we have a value set, and we're less than the max, then return
-	if buildLogsToRetain > 0 && buildLogsToRetain < int(blrc.maxBuildLogsToRetain) {
-		return buildLogsToRetain
+	if configured > 0 && configured < int(maxValue) {
+		return configured
 	}
 
 	// Else, return the max
-	return int(blrc.maxBuildLogsToRetain)
+	return int(maxValue)
 }
@@ -12,29 +12,64 @@ import (
 
 var _ = Describe("BuildLogRetentionCalculator", func() {
 	It("nothing set gives all", func() {
-		Expect(NewBuildLogRetentionCalculator(0, 0).BuildLogsToRetain(makeJob(0))).To(Equal(0))
+		Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeJob(0)).Builds).To(Equal(0))
 	})
 	It("nothing set but job gives job", func() {
-		Expect(NewBuildLogRetentionCalculator(0, 0).BuildLogsToRetain(makeJob(3))).To(Equal(3))
+		Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeJob(3)).Builds).To(Equal(3))
 	})
 	It("default set gives default", func() {
-		Expect(NewBuildLogRetentionCalculator(5, 0).BuildLogsToRetain(makeJob(0))).To(Equal(5))
+		Expect(NewBuildLogRetentionCalculator(5, 0, 0, 0).BuildLogsToRetain(makeJob(0)).Builds).To(Equal(5))
 	})
 	It("default and job set gives job", func() {
-		Expect(NewBuildLogRetentionCalculator(5, 0).BuildLogsToRetain(makeJob(6))).To(Equal(6))
+		Expect(NewBuildLogRetentionCalculator(5, 0, 0, 0).BuildLogsToRetain(makeJob(6)).Builds).To(Equal(6))
 	})
 	It("default and job set and max set gives max if lower", func() {
-		Expect(NewBuildLogRetentionCalculator(5, 4).BuildLogsToRetain(makeJob(6))).To(Equal(4))
+		Expect(NewBuildLogRetentionCalculator(5, 4, 0, 0).BuildLogsToRetain(makeJob(6)).Builds).To(Equal(4))
 	})
 	It("max only set gives max", func() {
-		Expect(NewBuildLogRetentionCalculator(0, 4).BuildLogsToRetain(makeJob(0))).To(Equal(4))
+		Expect(NewBuildLogRetentionCalculator(0, 4, 0, 0).BuildLogsToRetain(makeJob(0)).Builds).To(Equal(4))
+	})
+
+	Context("days", func() {
+		It("nothing set gives all", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeDaysJob(0)).Days).To(Equal(0))
+		})
+		It("nothing set but job gives job", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeDaysJob(3)).Days).To(Equal(3))
+		})
+		It("default set gives default", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 7, 0).BuildLogsToRetain(makeDaysJob(0)).Days).To(Equal(7))
+		})
+		It("default and job set and max set gives max if lower", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 7, 30).BuildLogsToRetain(makeDaysJob(90)).Days).To(Equal(30))
+		})
+	})
+
+	It("passes through the minimum succeeded builds from the job", func() {
+		job := new(dbfakes.FakeJob)
+		job.ConfigReturns(atc.JobConfig{
+			BuildLogsToRetain: &atc.BuildLogRetention{MinimumSucceededBuilds: 2},
+		})
+
+		Expect(NewBuildLogRetentionCalculator(5, 0, 0, 0).BuildLogsToRetain(job)).To(Equal(atc.BuildLogRetention{
+			Builds:                 5,
+			MinimumSucceededBuilds: 2,
+		}))
 	})
 })
 
 func makeJob(retainAmount int) db.Job {
 	rv := new(dbfakes.FakeJob)
 	rv.ConfigReturns(atc.JobConfig{
-		BuildLogsToRetain: retainAmount,
+		BuildLogsToRetain: &atc.BuildLogRetention{Builds: retainAmount},
+	})
+	return rv
+}
+
+func makeDaysJob(days int) db.Job {
+	rv := new(dbfakes.FakeJob)
+	rv.ConfigReturns(atc.JobConfig{
+		BuildLogsToRetain: &atc.BuildLogRetention{Days: days},
 	})
 	return rv
 }
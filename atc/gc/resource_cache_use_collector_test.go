@@ -63,7 +63,7 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 				var created bool
 				var err error
 				pipelineWithTypes, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+					atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						ResourceTypes: atc.ResourceTypes{versionedResourceType.ResourceType},
 					},
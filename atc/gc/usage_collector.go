@@ -0,0 +1,38 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type usageCollector struct {
+	usageRepository db.UsageRepository
+}
+
+func NewUsageCollector(usageRepository db.UsageRepository) Collector {
+	return &usageCollector{
+		usageRepository: usageRepository,
+	}
+}
+
+func (uc *usageCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("usage-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	closed, err := uc.usageRepository.CloseOrphanedUsage()
+	if err != nil {
+		logger.Error("failed-to-close-orphaned-usage", err)
+		return err
+	}
+
+	if closed > 0 {
+		logger.Debug("closed-orphaned-usage", lager.Data{"count": closed})
+	}
+
+	return nil
+}
@@ -60,5 +60,15 @@ func (wc *workerCollector) Run(ctx context.Context) error {
 		logger.Debug("landed", lager.Data{"count": len(affected), "workers": affected})
 	}
 
+	affected, err = wc.workerLifecycle.ReconcileActiveTasks()
+	if err != nil {
+		logger.Error("failed-to-reconcile-active-tasks", err)
+		return err
+	}
+
+	if len(affected) > 0 {
+		logger.Debug("reconciled-active-tasks", lager.Data{"count": len(affected), "workers": affected})
+	}
+
 	return nil
 }
@@ -27,6 +27,7 @@ var _ = Describe("WorkerCollector", func() {
 		fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.DeleteFinishedRetiringWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.LandFinishedLandingWorkersReturns(nil, nil)
+		fakeWorkerLifecycle.ReconcileActiveTasksReturns(nil, nil)
 	})
 
 	Describe("Run", func() {
@@ -58,6 +59,13 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(fakeWorkerLifecycle.LandFinishedLandingWorkersCallCount()).To(Equal(1))
 		})
 
+		It("tells the worker factory to reconcile active tasks", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.ReconcileActiveTasksCallCount()).To(Equal(1))
+		})
+
 		It("returns an error if stalling unresponsive workers fails", func() {
 			returnedErr := errors.New("some-error")
 			fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, returnedErr)
@@ -81,5 +89,13 @@ var _ = Describe("WorkerCollector", func() {
 			err := workerCollector.Run(context.TODO())
 			Expect(err).To(MatchError(returnedErr))
 		})
+
+		It("returns an error if reconciling active tasks fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.ReconcileActiveTasksReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
 	})
 })
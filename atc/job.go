@@ -12,6 +12,7 @@ type Job struct {
 	NextBuild            *Build `json:"next_build"`
 	FinishedBuild        *Build `json:"finished_build"`
 	TransitionBuild      *Build `json:"transition_build,omitempty"`
+	NextScheduled        int64  `json:"next_scheduled,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs"`
 	Outputs []JobOutput `json:"outputs"`
@@ -43,3 +44,52 @@ type BuildInput struct {
 	Version  Version  `json:"version"`
 	Tags     []string `json:"tags,omitempty"`
 }
+
+// InputOverrides chooses the versions of some of a job's inputs for a
+// manually triggered build. Inputs which are not given are resolved as usual.
+type InputOverrides struct {
+	Inputs map[string]Version `json:"inputs,omitempty"`
+
+	// IgnorePassed skips the 'passed' constraints of the overridden inputs,
+	// so that versions which never made it through upstream jobs can be used.
+	IgnorePassed bool `json:"ignore_passed,omitempty"`
+
+	// Priority overrides the job's priority for the triggered build.
+	Priority *int `json:"priority,omitempty"`
+}
+
+type SchedulingBlockerReason string
+
+const (
+	SchedulingBlockerPipelinePaused     SchedulingBlockerReason = "pipeline_paused"
+	SchedulingBlockerJobPaused          SchedulingBlockerReason = "job_paused"
+	SchedulingBlockerMaxInFlightReached SchedulingBlockerReason = "max_in_flight_reached"
+	SchedulingBlockerSerialGroupBlocked SchedulingBlockerReason = "serial_group_blocked"
+	SchedulingBlockerInputUnsatisfied   SchedulingBlockerReason = "input_unsatisfied"
+	SchedulingBlockerCheckErrored       SchedulingBlockerReason = "check_errored"
+	SchedulingBlockerTeamQuotaReached   SchedulingBlockerReason = "team_quota_reached"
+)
+
+type SchedulingBlocker struct {
+	Reason      SchedulingBlockerReason `json:"reason"`
+	Input       string                  `json:"input,omitempty"`
+	Resource    string                  `json:"resource,omitempty"`
+	InputReason string                  `json:"input_reason,omitempty"`
+	PassedJobs  []string                `json:"passed_jobs,omitempty"`
+	BuildID     int                     `json:"build_id,omitempty"`
+	BuildName   string                  `json:"build_name,omitempty"`
+	JobName     string                  `json:"job_name,omitempty"`
+	CheckError  string                  `json:"check_error,omitempty"`
+
+	ActiveBuilds        int `json:"active_builds,omitempty"`
+	MaxConcurrentBuilds int `json:"max_concurrent_builds,omitempty"`
+}
+
+// JobSchedulingStatus explains why a job's pending builds are not being
+// started. A job without blockers is scheduled as usual.
+type JobSchedulingStatus struct {
+	JobName          string              `json:"job_name"`
+	PendingBuildID   int                 `json:"pending_build_id,omitempty"`
+	PendingBuildName string              `json:"pending_build_name,omitempty"`
+	Blockers         []SchedulingBlocker `json:"blockers"`
+}
@@ -1,15 +1,25 @@
 package atc
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+)
+
 type JobConfig struct {
 	Name   string `yaml:"name" json:"name" mapstructure:"name"`
 	Public bool   `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
 
-	DisableManualTrigger bool     `yaml:"disable_manual_trigger,omitempty" json:"disable_manual_trigger,omitempty" mapstructure:"disable_manual_trigger"`
-	Serial               bool     `yaml:"serial,omitempty" json:"serial,omitempty" mapstructure:"serial"`
-	Interruptible        bool     `yaml:"interruptible,omitempty" json:"interruptible,omitempty" mapstructure:"interruptible"`
-	SerialGroups         []string `yaml:"serial_groups,omitempty" json:"serial_groups,omitempty" mapstructure:"serial_groups"`
-	RawMaxInFlight       int      `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
-	BuildLogsToRetain    int      `yaml:"build_logs_to_retain,omitempty" json:"build_logs_to_retain,omitempty" mapstructure:"build_logs_to_retain"`
+	DisableManualTrigger bool               `yaml:"disable_manual_trigger,omitempty" json:"disable_manual_trigger,omitempty" mapstructure:"disable_manual_trigger"`
+	Serial               bool               `yaml:"serial,omitempty" json:"serial,omitempty" mapstructure:"serial"`
+	Interruptible        bool               `yaml:"interruptible,omitempty" json:"interruptible,omitempty" mapstructure:"interruptible"`
+	SerialGroups         []string           `yaml:"serial_groups,omitempty" json:"serial_groups,omitempty" mapstructure:"serial_groups"`
+	RawMaxInFlight       int                `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
+	BuildLogsToRetain    *BuildLogRetention `yaml:"build_logs_to_retain,omitempty" json:"build_logs_to_retain,omitempty" mapstructure:"build_logs_to_retain"`
+	Priority             int                `yaml:"priority,omitempty" json:"priority,omitempty" mapstructure:"priority"`
+
+	Schedule *JobSchedule `yaml:"schedule,omitempty" json:"schedule,omitempty" mapstructure:"schedule"`
 
 	Plan PlanSequence `yaml:"plan,omitempty" json:"plan,omitempty" mapstructure:"plan"`
 
@@ -19,6 +29,112 @@ type JobConfig struct {
 	Success *PlanConfig `yaml:"on_success,omitempty" json:"on_success,omitempty" mapstructure:"on_success"`
 }
 
+// JobSchedule configures builds of a job to be created periodically.
+type JobSchedule struct {
+	Cron     string `yaml:"cron" json:"cron" mapstructure:"cron"`
+	Location string `yaml:"location,omitempty" json:"location,omitempty" mapstructure:"location"`
+	Jitter   string `yaml:"jitter,omitempty" json:"jitter,omitempty" mapstructure:"jitter"`
+}
+
+// BuildLogRetention configures how long the logs of a job's builds are kept
+// around for. A build's logs are kept if any of the settings call for it.
+//
+// A plain number is shorthand for the number of builds to keep.
+type BuildLogRetention struct {
+	Builds                 int `yaml:"builds,omitempty" json:"builds,omitempty" mapstructure:"builds"`
+	Days                   int `yaml:"days,omitempty" json:"days,omitempty" mapstructure:"days"`
+	MinimumSucceededBuilds int `yaml:"minimum_succeeded_builds,omitempty" json:"minimum_succeeded_builds,omitempty" mapstructure:"minimum_succeeded_builds"`
+}
+
+func (retention *BuildLogRetention) UnmarshalJSON(payload []byte) error {
+	var builds int
+	if json.Unmarshal(payload, &builds) == nil {
+		retention.Builds = builds
+		return nil
+	}
+
+	// avoid recursing into UnmarshalJSON
+	type target BuildLogRetention
+
+	var config target
+	err := json.Unmarshal(payload, &config)
+	if err != nil {
+		return err
+	}
+
+	*retention = BuildLogRetention(config)
+
+	return nil
+}
+
+func (retention *BuildLogRetention) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var builds int
+	if unmarshal(&builds) == nil {
+		retention.Builds = builds
+		return nil
+	}
+
+	// avoid recursing into UnmarshalYAML
+	type target BuildLogRetention
+
+	var config target
+	err := unmarshal(&config)
+	if err != nil {
+		return err
+	}
+
+	*retention = BuildLogRetention(config)
+
+	return nil
+}
+
+// MarshalJSON keeps the shorthand form when only a number of builds is
+// configured, so that existing configs round-trip unchanged.
+func (retention BuildLogRetention) MarshalJSON() ([]byte, error) {
+	if retention.isBuildsOnly() {
+		return json.Marshal(retention.Builds)
+	}
+
+	type target BuildLogRetention
+
+	return json.Marshal(target(retention))
+}
+
+func (retention BuildLogRetention) MarshalYAML() (interface{}, error) {
+	if retention.isBuildsOnly() {
+		return retention.Builds, nil
+	}
+
+	type target BuildLogRetention
+
+	return target(retention), nil
+}
+
+func (retention BuildLogRetention) isBuildsOnly() bool {
+	return retention.Days == 0 && retention.MinimumSucceededBuilds == 0
+}
+
+func (schedule JobSchedule) CronSchedule() (cron.Schedule, error) {
+	location := time.UTC
+	if schedule.Location != "" {
+		var err error
+		location, err = time.LoadLocation(schedule.Location)
+		if err != nil {
+			return cron.Schedule{}, err
+		}
+	}
+
+	return cron.ParseInLocation(schedule.Cron, location)
+}
+
+func (schedule JobSchedule) JitterDuration() (time.Duration, error) {
+	if schedule.Jitter == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(schedule.Jitter)
+}
+
 func (config JobConfig) Hooks() Hooks {
 	return Hooks{Abort: config.Abort, Failure: config.Failure, Ensure: config.Ensure, Success: config.Success}
 }
@@ -94,6 +210,12 @@ func collectPlans(plan PlanConfig) []PlanConfig {
 		}
 	}
 
+	if plan.InParallel != nil {
+		for _, p := range plan.InParallel.Steps {
+			plans = append(plans, collectPlans(p)...)
+		}
+	}
+
 	return append(plans, plan)
 }
 
@@ -0,0 +1,162 @@
+package logarchive
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+//go:generate counterfeiter . Archiver
+
+// Archiver keeps a copy of a build's events before they are reaped from the
+// database, and can replay them afterwards.
+type Archiver interface {
+	Archive(lager.Logger, db.Build) error
+	Events(build db.Build, from uint) (db.EventSource, error)
+}
+
+type archiver struct {
+	store Store
+}
+
+func NewArchiver(store Store) Archiver {
+	return &archiver{
+		store: store,
+	}
+}
+
+func (a *archiver) Archive(logger lager.Logger, build db.Build) error {
+	logger = logger.Session("archive", lager.Data{"build": build.ID()})
+
+	events, err := build.Events(0)
+	if err != nil {
+		logger.Error("failed-to-get-build-events", err)
+		return err
+	}
+
+	defer db.Close(events)
+
+	// the events are written to disk rather than held in memory, as the
+	// largest build logs are the likeliest to be archived
+	file, err := ioutil.TempFile("", "build-events")
+	if err != nil {
+		logger.Error("failed-to-create-temp-file", err)
+		return err
+	}
+
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	enc := json.NewEncoder(gz)
+
+	count := 0
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			logger.Error("failed-to-get-next-build-event", err)
+			return err
+		}
+
+		err = enc.Encode(ev)
+		if err != nil {
+			logger.Error("failed-to-encode-build-event", err)
+			return err
+		}
+
+		count++
+	}
+
+	err = gz.Close()
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	err = a.store.Put(archiveKey(build), file)
+	if err != nil {
+		logger.Error("failed-to-store-build-events", err)
+		return err
+	}
+
+	logger.Debug("archived", lager.Data{"events": count})
+
+	return nil
+}
+
+func (a *archiver) Events(build db.Build, from uint) (db.EventSource, error) {
+	contents, err := a.store.Get(archiveKey(build))
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(contents)
+	if err != nil {
+		_ = contents.Close()
+		return nil, err
+	}
+
+	source := &archivedEventSource{
+		contents: contents,
+		gz:       gz,
+		dec:      json.NewDecoder(gz),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			_ = source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+func archiveKey(build db.Build) string {
+	return fmt.Sprintf("builds/%d.json.gz", build.ID())
+}
+
+type archivedEventSource struct {
+	contents io.ReadCloser
+	gz       *gzip.Reader
+	dec      *json.Decoder
+}
+
+func (source *archivedEventSource) Next() (event.Envelope, error) {
+	var ev event.Envelope
+	err := source.dec.Decode(&ev)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return ev, nil
+}
+
+func (source *archivedEventSource) Close() error {
+	_ = source.gz.Close()
+	return source.contents.Close()
+}
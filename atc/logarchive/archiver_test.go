@@ -0,0 +1,166 @@
+package logarchive_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archiver", func() {
+	var (
+		dir       string
+		fakeBuild *dbfakes.FakeBuild
+		archiver  Archiver
+		logger    *lagertest.TestLogger
+		events    []event.Envelope
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "log-archive")
+		Expect(err).NotTo(HaveOccurred())
+
+		logger = lagertest.NewTestLogger("test")
+
+		events = []event.Envelope{
+			envelope(event.Log{Payload: "hello"}),
+			envelope(event.Log{Payload: "world"}),
+			envelope(event.Status{Status: "succeeded"}),
+		}
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.EventsStub = func(from uint) (db.EventSource, error) {
+			Expect(from).To(BeZero())
+
+			source := new(dbfakes.FakeEventSource)
+			for i, ev := range events {
+				source.NextReturnsOnCall(i, ev, nil)
+			}
+			source.NextReturnsOnCall(len(events), event.Envelope{}, db.ErrEndOfBuildEventStream)
+			return source, nil
+		}
+
+		archiver = NewArchiver(NewFilesystemStore(dir))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	readAll := func(source db.EventSource) []event.Envelope {
+		defer source.Close()
+
+		replayed := []event.Envelope{}
+		for {
+			ev, err := source.Next()
+			if err == db.ErrEndOfBuildEventStream {
+				return replayed
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			replayed = append(replayed, ev)
+		}
+	}
+
+	It("replays the events of an archived build", func() {
+		Expect(archiver.Archive(logger, fakeBuild)).To(Succeed())
+
+		source, err := archiver.Events(fakeBuild, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readAll(source)).To(Equal(events))
+	})
+
+	It("replays from the given event", func() {
+		Expect(archiver.Archive(logger, fakeBuild)).To(Succeed())
+
+		source, err := archiver.Events(fakeBuild, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readAll(source)).To(Equal(events[2:]))
+	})
+
+	It("returns ErrNotFound for a build which was never archived", func() {
+		_, err := archiver.Events(fakeBuild, 0)
+		Expect(err).To(Equal(ErrNotFound))
+	})
+
+	Context("when reading the build's events fails", func() {
+		var fakeStore *logarchivefakes.FakeStore
+
+		BeforeEach(func() {
+			fakeStore = new(logarchivefakes.FakeStore)
+			archiver = NewArchiver(fakeStore)
+
+			fakeBuild.EventsReturns(nil, errors.New("nope"))
+			fakeBuild.EventsStub = nil
+		})
+
+		It("does not store anything", func() {
+			Expect(archiver.Archive(logger, fakeBuild)).To(MatchError("nope"))
+			Expect(fakeStore.PutCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when storing the archive", func() {
+		var (
+			fakeStore *logarchivefakes.FakeStore
+			tmpPath   string
+		)
+
+		BeforeEach(func() {
+			fakeStore = new(logarchivefakes.FakeStore)
+			fakeStore.PutStub = func(key string, contents io.ReadSeeker) error {
+				file, ok := contents.(*os.File)
+				Expect(ok).To(BeTrue())
+				tmpPath = file.Name()
+				Expect(tmpPath).To(BeAnExistingFile())
+				return nil
+			}
+			archiver = NewArchiver(fakeStore)
+		})
+
+		It("streams the events through a temp file which is removed afterwards", func() {
+			Expect(archiver.Archive(logger, fakeBuild)).To(Succeed())
+			Expect(fakeStore.PutCallCount()).To(Equal(1))
+			Expect(tmpPath).NotTo(BeAnExistingFile())
+		})
+	})
+
+	Context("when storing the archive fails", func() {
+		BeforeEach(func() {
+			fakeStore := new(logarchivefakes.FakeStore)
+			fakeStore.PutReturns(errors.New("disk full"))
+			archiver = NewArchiver(fakeStore)
+		})
+
+		It("returns the error", func() {
+			Expect(archiver.Archive(logger, fakeBuild)).To(MatchError("disk full"))
+		})
+	})
+})
+
+func envelope(ev atc.Event) event.Envelope {
+	payload, err := json.Marshal(ev)
+	Expect(err).NotTo(HaveOccurred())
+
+	data := json.RawMessage(payload)
+
+	return event.Envelope{
+		Data:    &data,
+		Event:   ev.EventType(),
+		Version: ev.Version(),
+	}
+}
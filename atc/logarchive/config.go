@@ -0,0 +1,79 @@
+package logarchive
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type Config struct {
+	Dir string `long:"dir" description:"Directory in which to archive build logs before they are reaped."`
+
+	S3Bucket          string `long:"s3-bucket" description:"S3 bucket in which to archive build logs before they are reaped."`
+	S3Prefix          string `long:"s3-prefix" description:"Prefix for the keys of archived build logs in the S3 bucket."`
+	S3Region          string `long:"s3-region" description:"AWS region of the S3 bucket." env:"AWS_REGION"`
+	S3Endpoint        string `long:"s3-endpoint" description:"Endpoint of an S3-compatible object store to use instead of AWS (e.g. MinIO)."`
+	S3ForcePathStyle  bool   `long:"s3-force-path-style" description:"Address the bucket as part of the path rather than the hostname, as most S3-compatible object stores require."`
+	S3AccessKeyID     string `long:"s3-access-key" description:"AWS access key ID. Defaults to the usual AWS credential chain."`
+	S3SecretAccessKey string `long:"s3-secret-key" description:"AWS secret access key."`
+	S3SessionToken    string `long:"s3-session-token" description:"AWS session token."`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.Dir != "" || config.S3Bucket != ""
+}
+
+func (config Config) Validate() error {
+	if config.Dir != "" && config.S3Bucket != "" {
+		return errors.New("build log archive is misconfigured, cannot archive to both a directory and an S3 bucket")
+	}
+
+	return nil
+}
+
+// Archiver constructs the configured archiver, or returns nil if archiving is
+// not configured.
+func (config Config) Archiver() (Archiver, error) {
+	if !config.IsConfigured() {
+		return nil, nil
+	}
+
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Dir != "" {
+		return NewArchiver(NewFilesystemStore(config.Dir)), nil
+	}
+
+	awsConfig := &aws.Config{
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	}
+
+	if config.S3Region != "" {
+		awsConfig.Region = aws.String(config.S3Region)
+	}
+
+	if config.S3Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.S3Endpoint)
+	}
+
+	if config.S3AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(
+			config.S3AccessKeyID,
+			config.S3SecretAccessKey,
+			config.S3SessionToken,
+		)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewArchiver(NewS3Store(s3.New(sess), config.S3Bucket, config.S3Prefix)), nil
+}
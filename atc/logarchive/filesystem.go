@@ -0,0 +1,62 @@
+package logarchive
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+type filesystemStore struct {
+	dir string
+}
+
+func NewFilesystemStore(dir string) Store {
+	return &filesystemStore{
+		dir: dir,
+	}
+}
+
+func (store *filesystemStore) Put(key string, contents io.ReadSeeker) error {
+	path := filepath.Join(store.dir, filepath.FromSlash(key))
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a partially written archive is
+	// never served
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".archive-")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tmp, contents)
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (store *filesystemStore) Get(key string) (io.ReadCloser, error) {
+	file, err := os.Open(filepath.Join(store.dir, filepath.FromSlash(key)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
@@ -0,0 +1,58 @@
+package logarchive_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/concourse/concourse/atc/logarchive"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FilesystemStore", func() {
+	var (
+		dir   string
+		store Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "log-archive")
+		Expect(err).NotTo(HaveOccurred())
+
+		store = NewFilesystemStore(dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("stores contents under the key", func() {
+		Expect(store.Put("builds/1.json.gz", strings.NewReader("some-contents"))).To(Succeed())
+
+		contents, err := ioutil.ReadFile(filepath.Join(dir, "builds", "1.json.gz"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("gets stored contents", func() {
+		Expect(store.Put("builds/1.json.gz", strings.NewReader("some-contents"))).To(Succeed())
+
+		reader, err := store.Get("builds/1.json.gz")
+		Expect(err).NotTo(HaveOccurred())
+
+		defer reader.Close()
+
+		contents, err := ioutil.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("returns ErrNotFound for a key which was never stored", func() {
+		_, err := store.Get("builds/2.json.gz")
+		Expect(err).To(Equal(ErrNotFound))
+	})
+})
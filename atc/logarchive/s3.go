@@ -0,0 +1,53 @@
+package logarchive
+
+import (
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+type s3Store struct {
+	api    s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3Store archives build logs to a bucket in S3, or in anything else which
+// speaks its API (e.g. MinIO). Keys are placed under the given prefix.
+func NewS3Store(api s3iface.S3API, bucket string, prefix string) Store {
+	return &s3Store{
+		api:    api,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (store *s3Store) Put(key string, contents io.ReadSeeker) error {
+	_, err := store.api.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+		Body:   contents,
+	})
+
+	return err
+}
+
+func (store *s3Store) Get(key string) (io.ReadCloser, error) {
+	output, err := store.api.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
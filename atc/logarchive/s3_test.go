@@ -0,0 +1,104 @@
+package logarchive_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/concourse/concourse/atc/logarchive"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// objectServer is a stand-in for an S3-compatible object store such as MinIO,
+// supporting just enough of the API to put and get objects.
+type objectServer struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+}
+
+func (server *objectServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		payload, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		server.objects[r.URL.Path] = payload
+	case http.MethodGet:
+		payload, found := server.objects[r.URL.Path]
+		if !found {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+
+		_, _ = w.Write(payload)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+var _ = Describe("S3Store", func() {
+	var (
+		objects *objectServer
+		server  *httptest.Server
+		store   Store
+	)
+
+	BeforeEach(func() {
+		objects = &objectServer{objects: map[string][]byte{}}
+		server = httptest.NewServer(objects)
+
+		sess, err := session.NewSession(&aws.Config{
+			Endpoint:         aws.String(server.URL),
+			Region:           aws.String("us-east-1"),
+			Credentials:      credentials.NewStaticCredentials("some-access-key", "some-secret-key", ""),
+			S3ForcePathStyle: aws.Bool(true),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		store = NewS3Store(s3.New(sess), "some-bucket", "some-prefix")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("puts objects under the prefix in the bucket", func() {
+		Expect(store.Put("builds/1.json.gz", strings.NewReader("some-contents"))).To(Succeed())
+
+		Expect(objects.objects).To(HaveKeyWithValue("/some-bucket/some-prefix/builds/1.json.gz", []byte("some-contents")))
+	})
+
+	It("gets stored objects", func() {
+		Expect(store.Put("builds/1.json.gz", strings.NewReader("some-contents"))).To(Succeed())
+
+		reader, err := store.Get("builds/1.json.gz")
+		Expect(err).NotTo(HaveOccurred())
+
+		defer reader.Close()
+
+		contents, err := ioutil.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("returns ErrNotFound for a key which was never stored", func() {
+		_, err := store.Get("builds/2.json.gz")
+		Expect(err).To(Equal(ErrNotFound))
+	})
+})
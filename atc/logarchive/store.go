@@ -0,0 +1,17 @@
+package logarchive
+
+import (
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("archived build log not found")
+
+//go:generate counterfeiter . Store
+
+// Store is where archived build logs are kept, keyed by a slash-separated
+// path.
+type Store interface {
+	Put(key string, contents io.ReadSeeker) error
+	Get(key string) (io.ReadCloser, error)
+}
@@ -0,0 +1,135 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Scheduler interface {
+	Run(context.Context) error
+}
+
+type scheduler struct {
+	workerFactory db.WorkerFactory
+	buildFactory  db.BuildFactory
+	clock         clock.Clock
+	leadTime      time.Duration
+}
+
+// NewScheduler returns a Scheduler which lands or retires workers leadTime
+// ahead of their maintenance window, so that their builds have a chance to
+// finish before the window starts.
+func NewScheduler(
+	workerFactory db.WorkerFactory,
+	buildFactory db.BuildFactory,
+	clock clock.Clock,
+	leadTime time.Duration,
+) Scheduler {
+	return &scheduler{
+		workerFactory: workerFactory,
+		buildFactory:  buildFactory,
+		clock:         clock,
+		leadTime:      leadTime,
+	}
+}
+
+func (s *scheduler) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("maintenance-scheduler")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	workers, err := s.workerFactory.Workers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return err
+	}
+
+	now := s.clock.Now()
+
+	for _, worker := range workers {
+		window, scheduled := worker.MaintenanceWindow()
+		if !scheduled {
+			continue
+		}
+
+		logger := logger.WithData(lager.Data{"worker": worker.Name()})
+
+		if !now.Before(window.End()) {
+			err := worker.CancelMaintenance()
+			if err != nil {
+				logger.Error("failed-to-clear-finished-maintenance-window", err)
+				continue
+			}
+
+			logger.Info("finished-maintenance-window")
+			continue
+		}
+
+		if now.Before(window.Start.Add(-s.leadTime)) {
+			continue
+		}
+
+		switch worker.State() {
+		case db.WorkerStateRunning:
+			s.startMaintenance(logger, worker, window)
+
+		case db.WorkerStateLanding, db.WorkerStateRetiring:
+			s.reportBlockingBuilds(logger, worker, window, now)
+		}
+	}
+
+	return nil
+}
+
+func (s *scheduler) startMaintenance(logger lager.Logger, worker db.Worker, window db.MaintenanceWindow) {
+	var err error
+	switch window.Behaviour {
+	case db.MaintenanceBehaviourRetire:
+		err = worker.Retire()
+	default:
+		err = worker.Land()
+	}
+
+	if err != nil {
+		logger.Error("failed-to-start-maintenance", err)
+		return
+	}
+
+	logger.Info("started-maintenance", lager.Data{
+		"behaviour": window.Behaviour,
+		"start":     window.Start,
+	})
+}
+
+// reportBlockingBuilds logs which builds a worker is still waiting on, so
+// that operators can tell what is holding up the maintenance.
+func (s *scheduler) reportBlockingBuilds(logger lager.Logger, worker db.Worker, window db.MaintenanceWindow, now time.Time) {
+	builds, err := s.buildFactory.BuildsBlockingWorker(worker.Name())
+	if err != nil {
+		logger.Error("failed-to-find-blocking-builds", err)
+		return
+	}
+
+	if len(builds) == 0 {
+		return
+	}
+
+	buildIDs := []int{}
+	for _, build := range builds {
+		buildIDs = append(buildIDs, build.ID())
+	}
+
+	data := lager.Data{"builds": buildIDs}
+
+	if now.Before(window.Start) {
+		logger.Debug("waiting-for-builds", data)
+	} else {
+		logger.Info("maintenance-window-blocked-by-builds", data)
+	}
+}
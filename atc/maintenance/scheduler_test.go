@@ -0,0 +1,143 @@
+package maintenance_test
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/maintenance"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		fakeWorkerFactory *dbfakes.FakeWorkerFactory
+		fakeBuildFactory  *dbfakes.FakeBuildFactory
+		fakeClock         *fakeclock.FakeClock
+		fakeWorker        *dbfakes.FakeWorker
+
+		logger *lagertest.TestLogger
+		now    time.Time
+		window db.MaintenanceWindow
+
+		scheduler maintenance.Scheduler
+		runErr    error
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1542200000, 0)
+
+		fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeClock = fakeclock.NewFakeClock(now)
+		logger = lagertest.NewTestLogger("test")
+
+		fakeWorker = new(dbfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		fakeWorker.StateReturns(db.WorkerStateRunning)
+		fakeWorkerFactory.WorkersReturns([]db.Worker{fakeWorker}, nil)
+
+		window = db.MaintenanceWindow{
+			Start:     now.Add(2 * time.Hour),
+			Duration:  time.Hour,
+			Behaviour: db.MaintenanceBehaviourLand,
+		}
+
+		scheduler = maintenance.NewScheduler(fakeWorkerFactory, fakeBuildFactory, fakeClock, time.Hour)
+	})
+
+	JustBeforeEach(func() {
+		runErr = scheduler.Run(lagerctx.NewContext(context.Background(), logger))
+	})
+
+	Context("when the worker has no maintenance window", func() {
+		It("leaves it alone", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeWorker.LandCallCount()).To(BeZero())
+			Expect(fakeWorker.RetireCallCount()).To(BeZero())
+			Expect(fakeWorker.CancelMaintenanceCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the maintenance window is further away than the lead time", func() {
+		BeforeEach(func() {
+			fakeWorker.MaintenanceWindowReturns(window, true)
+		})
+
+		It("leaves the worker alone", func() {
+			Expect(fakeWorker.LandCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the maintenance window is within the lead time", func() {
+		BeforeEach(func() {
+			window.Start = now.Add(30 * time.Minute)
+			fakeWorker.MaintenanceWindowReturns(window, true)
+		})
+
+		It("lands the worker", func() {
+			Expect(fakeWorker.LandCallCount()).To(Equal(1))
+			Expect(fakeWorker.RetireCallCount()).To(BeZero())
+		})
+
+		Context("when the worker is to be retired", func() {
+			BeforeEach(func() {
+				window.Behaviour = db.MaintenanceBehaviourRetire
+				fakeWorker.MaintenanceWindowReturns(window, true)
+			})
+
+			It("retires the worker", func() {
+				Expect(fakeWorker.RetireCallCount()).To(Equal(1))
+				Expect(fakeWorker.LandCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the worker is already landing", func() {
+			BeforeEach(func() {
+				fakeWorker.StateReturns(db.WorkerStateLanding)
+
+				fakeBuild := new(dbfakes.FakeBuild)
+				fakeBuild.IDReturns(42)
+				fakeBuildFactory.BuildsBlockingWorkerReturns([]db.Build{fakeBuild}, nil)
+			})
+
+			It("does not land it again", func() {
+				Expect(fakeWorker.LandCallCount()).To(BeZero())
+			})
+
+			It("reports the builds it is waiting on", func() {
+				Expect(fakeBuildFactory.BuildsBlockingWorkerCallCount()).To(Equal(1))
+				Expect(fakeBuildFactory.BuildsBlockingWorkerArgsForCall(0)).To(Equal("some-worker"))
+			})
+		})
+
+		Context("when the worker is stalled", func() {
+			BeforeEach(func() {
+				fakeWorker.StateReturns(db.WorkerStateStalled)
+			})
+
+			It("leaves it alone", func() {
+				Expect(fakeWorker.LandCallCount()).To(BeZero())
+				Expect(fakeBuildFactory.BuildsBlockingWorkerCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the maintenance window is over", func() {
+		BeforeEach(func() {
+			window.Start = now.Add(-2 * time.Hour)
+			fakeWorker.MaintenanceWindowReturns(window, true)
+		})
+
+		It("clears the maintenance window without landing the worker", func() {
+			Expect(fakeWorker.CancelMaintenanceCallCount()).To(Equal(1))
+			Expect(fakeWorker.LandCallCount()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package metric
+
+import "strings"
+
+const (
+	DimensionTeam     = "team"
+	DimensionPipeline = "pipeline"
+	DimensionJob      = "job"
+	DimensionStep     = "step"
+	DimensionResource = "resource"
+	DimensionWorker   = "worker"
+)
+
+// dimensionAttributes maps the attributes of events to the dimension which
+// governs whether they may be used as labels.
+var dimensionAttributes = map[string]string{
+	"team":      DimensionTeam,
+	"team_name": DimensionTeam,
+	"pipeline":  DimensionPipeline,
+	"job":       DimensionJob,
+	"step":      DimensionStep,
+	"resource":  DimensionResource,
+	"worker":    DimensionWorker,
+}
+
+// pipelineDimensions are only broken down for pipelines on the allow-list.
+var pipelineDimensions = map[string]bool{
+	DimensionPipeline: true,
+	DimensionJob:      true,
+	DimensionStep:     true,
+	DimensionResource: true,
+}
+
+// unboundedAttributes identify a single build or request, and are never used
+// as labels. Requests are broken down by their route instead of their path,
+// which e.g. includes the ID of the build whose events are being streamed.
+var unboundedAttributes = map[string]bool{
+	"build_id":   true,
+	"build_name": true,
+	"path":       true,
+}
+
+// Dimensions is the cardinality allow-list for labels of emitted metrics.
+// Every distinct combination of label values ends up as its own time series,
+// so e.g. breaking build durations down by job across thousands of pipelines
+// gets expensive fast.
+type Dimensions struct {
+	// Allowed lists the dimensions metrics may be broken down by. Attributes
+	// which aren't a dimension, e.g. build_status, are always allowed.
+	Allowed []string
+
+	// Pipelines, if set, lists the pipelines (as TEAM/PIPELINE) for which
+	// metrics may be broken down by pipeline, job, step or resource.
+	Pipelines []string
+}
+
+// Labels returns the attributes which may be used as labels.
+func (dimensions Dimensions) Labels(attributes map[string]string) map[string]string {
+	allowed := map[string]bool{}
+	for _, dimension := range dimensions.Allowed {
+		allowed[dimension] = true
+	}
+
+	pipelineAllowed := dimensions.pipelineAllowed(attributes)
+
+	labels := map[string]string{}
+	for k, v := range attributes {
+		if unboundedAttributes[k] {
+			continue
+		}
+
+		dimension, isDimension := dimensionAttributes[k]
+		if isDimension {
+			if !allowed[dimension] {
+				continue
+			}
+
+			if pipelineDimensions[dimension] && !pipelineAllowed {
+				continue
+			}
+		}
+
+		labels[k] = v
+	}
+
+	return labels
+}
+
+func (dimensions Dimensions) pipelineAllowed(attributes map[string]string) bool {
+	if len(dimensions.Pipelines) == 0 {
+		return true
+	}
+
+	team, found := attributes["team"]
+	if !found {
+		team = attributes["team_name"]
+	}
+
+	pipeline := attributes["pipeline"]
+
+	for _, allowed := range dimensions.Pipelines {
+		segs := strings.SplitN(allowed, "/", 2)
+		if len(segs) == 2 && segs[0] == team && segs[1] == pipeline {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package metric_test
+
+import (
+	"github.com/concourse/concourse/atc/metric"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dimensions", func() {
+	var (
+		dimensions metric.Dimensions
+		attributes map[string]string
+		labels     map[string]string
+	)
+
+	BeforeEach(func() {
+		dimensions = metric.Dimensions{
+			Allowed: []string{
+				metric.DimensionTeam,
+				metric.DimensionPipeline,
+				metric.DimensionJob,
+			},
+		}
+
+		attributes = map[string]string{
+			"team":         "some-team",
+			"pipeline":     "some-pipeline",
+			"job":          "some-job",
+			"step":         "some-step",
+			"worker":       "some-worker",
+			"build_id":     "42",
+			"build_name":   "7",
+			"build_status": "succeeded",
+			"route":        "GetBuild",
+			"path":         "/api/v1/builds/42",
+		}
+	})
+
+	JustBeforeEach(func() {
+		labels = dimensions.Labels(attributes)
+	})
+
+	It("keeps the allowed dimensions and the attributes which aren't dimensions", func() {
+		Expect(labels).To(Equal(map[string]string{
+			"team":         "some-team",
+			"pipeline":     "some-pipeline",
+			"job":          "some-job",
+			"build_status": "succeeded",
+			"route":        "GetBuild",
+		}))
+	})
+
+	It("does not modify the attributes", func() {
+		Expect(attributes).To(HaveLen(10))
+	})
+
+	Context("when the team is given as team_name", func() {
+		BeforeEach(func() {
+			delete(attributes, "team")
+			attributes["team_name"] = "some-team"
+			dimensions.Allowed = []string{metric.DimensionPipeline}
+		})
+
+		It("is governed by the team dimension", func() {
+			Expect(labels).ToNot(HaveKey("team_name"))
+		})
+	})
+
+	Context("when pipelines are allow-listed", func() {
+		BeforeEach(func() {
+			dimensions.Pipelines = []string{"other-team/some-pipeline", "some-team/other-pipeline"}
+		})
+
+		Context("when the pipeline is not on the allow-list", func() {
+			It("only keeps the dimensions which aren't per-pipeline", func() {
+				Expect(labels).To(Equal(map[string]string{
+					"team":         "some-team",
+					"build_status": "succeeded",
+					"route":        "GetBuild",
+				}))
+			})
+		})
+
+		Context("when the pipeline is on the allow-list", func() {
+			BeforeEach(func() {
+				dimensions.Pipelines = append(dimensions.Pipelines, "some-team/some-pipeline")
+			})
+
+			It("keeps the per-pipeline dimensions", func() {
+				Expect(labels).To(HaveKeyWithValue("pipeline", "some-pipeline"))
+				Expect(labels).To(HaveKeyWithValue("job", "some-job"))
+			})
+		})
+	})
+})
@@ -17,6 +17,14 @@ type Event struct {
 	Attributes map[string]string
 	Host       string
 	Time       time.Time
+
+	// Histogram marks the Value as one observation of a distribution, e.g. a
+	// duration, rather than the current reading of a gauge.
+	Histogram bool
+
+	// Labels is the subset of Attributes allowed by the configured Dimensions,
+	// for emitters which aggregate events into time series.
+	Labels map[string]string
 }
 
 type EventState string
@@ -55,6 +63,7 @@ func WireEmitters(group *flags.Group) {
 var emitter Emitter
 var eventHost string
 var eventAttributes map[string]string
+var eventDimensions Dimensions
 
 type eventEmission struct {
 	event  Event
@@ -63,7 +72,7 @@ type eventEmission struct {
 
 var emissions = make(chan eventEmission, 1000)
 
-func Initialize(logger lager.Logger, host string, attributes map[string]string) error {
+func Initialize(logger lager.Logger, host string, attributes map[string]string, dimensions Dimensions) error {
 	var emitterDescriptions []string
 	for _, factory := range emitterFactories {
 		if factory.IsConfigured() {
@@ -91,6 +100,7 @@ func Initialize(logger lager.Logger, host string, attributes map[string]string)
 	emitter = emitter
 	eventHost = host
 	eventAttributes = attributes
+	eventDimensions = dimensions
 
 	go emitLoop()
 
@@ -117,6 +127,7 @@ func emit(logger lager.Logger, event Event) {
 	}
 
 	event.Attributes = mergedAttributes
+	event.Labels = eventDimensions.Labels(mergedAttributes)
 
 	select {
 	case emissions <- eventEmission{logger: logger, event: event}:
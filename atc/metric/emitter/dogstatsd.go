@@ -94,7 +94,13 @@ func (emitter *DogstatsdEmitter) Emit(logger lager.Logger, event metric.Event) {
 		fmt.Sprintf("state:%s", event.State),
 	}
 
-	for k, v := range event.Attributes {
+	attributes := event.Attributes
+	if event.Histogram {
+		// distributions are only broken down by the allowed dimensions
+		attributes = event.Labels
+	}
+
+	for k, v := range attributes {
 		tags = append(tags, fmt.Sprintf("%s:%s", k, v))
 	}
 
@@ -106,7 +112,11 @@ func (emitter *DogstatsdEmitter) Emit(logger lager.Logger, event metric.Event) {
 		return
 	}
 
-	err = emitter.client.Gauge(name, value, tags, 1)
+	if event.Histogram {
+		err = emitter.client.Histogram(name, value, tags, 1)
+	} else {
+		err = emitter.client.Gauge(name, value, tags, 1)
+	}
 	if err != nil {
 		logger.Error("failed-to-send-metric", err)
 		return
@@ -0,0 +1,118 @@
+package emitter
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"go.opentelemetry.io/otel/api/kv"
+	otelmetric "go.opentelemetry.io/otel/api/metric"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/sdk/metric/controller/push"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// OTLPEmitter records every event as a value of an OpenTelemetry value
+// recorder named after the event, labelled with the event's labels. The
+// collector receives the count, sum, min and max of each series per push
+// interval; for gauges this amounts to their range over the interval.
+type OTLPEmitter struct {
+	meter otelmetric.Meter
+
+	recorders  map[string]otelmetric.Float64ValueRecorder
+	recordersL sync.Mutex
+}
+
+type OTLPConfig struct {
+	Address      string            `long:"otlp-address" description:"Address of an OTLP collector to export metrics to, e.g. localhost:55680."`
+	Headers      map[string]string `long:"otlp-header" description:"Header to attach to every request to the OTLP collector. Can be specified multiple times." value-name:"NAME:VALUE"`
+	Insecure     bool              `long:"otlp-insecure" description:"Connect to the OTLP collector without TLS."`
+	PushInterval time.Duration     `long:"otlp-push-interval" default:"10s" description:"Interval on which to export metrics to the OTLP collector."`
+}
+
+func init() {
+	metric.RegisterEmitter(&OTLPConfig{})
+}
+
+func (config *OTLPConfig) Description() string { return "OTLP" }
+func (config *OTLPConfig) IsConfigured() bool  { return config.Address != "" }
+
+func (config *OTLPConfig) NewEmitter() (metric.Emitter, error) {
+	options := []otlp.ExporterOption{
+		otlp.WithAddress(config.Address),
+		otlp.WithHeaders(config.Headers),
+	}
+
+	if config.Insecure {
+		options = append(options, otlp.WithInsecure())
+	}
+
+	exporter, err := otlp.NewExporter(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	pusher := push.New(
+		simple.NewWithInexpensiveDistribution(),
+		exporter,
+		push.WithPeriod(config.PushInterval),
+		push.WithResource(resource.New(kv.String("service.name", "concourse-web"))),
+	)
+
+	pusher.Start()
+
+	return &OTLPEmitter{
+		meter:     pusher.Provider().Meter("github.com/concourse/concourse/atc/metric"),
+		recorders: map[string]otelmetric.Float64ValueRecorder{},
+	}, nil
+}
+
+func (emitter *OTLPEmitter) Emit(logger lager.Logger, event metric.Event) {
+	name := "concourse." + specialChars.ReplaceAllString(strings.Replace(strings.ToLower(event.Name), " ", "_", -1), "")
+
+	value, err := getFloatHelper(event.Value)
+	if err != nil {
+		logger.Error("failed-to-convert-metric-for-otlp", nil, lager.Data{
+			"metric-name": name,
+		})
+		return
+	}
+
+	recorder, err := emitter.recorder(name)
+	if err != nil {
+		logger.Error("failed-to-create-value-recorder", err, lager.Data{
+			"metric-name": name,
+		})
+		return
+	}
+
+	labels := []kv.KeyValue{kv.String("host", event.Host)}
+	for k, v := range event.Labels {
+		labels = append(labels, kv.String(k, v))
+	}
+
+	recorder.Record(context.Background(), value, labels...)
+}
+
+func (emitter *OTLPEmitter) recorder(name string) (otelmetric.Float64ValueRecorder, error) {
+	emitter.recordersL.Lock()
+	defer emitter.recordersL.Unlock()
+
+	recorder, found := emitter.recorders[name]
+	if found {
+		return recorder, nil
+	}
+
+	recorder, err := emitter.meter.NewFloat64ValueRecorder(name)
+	if err != nil {
+		return otelmetric.Float64ValueRecorder{}, err
+	}
+
+	emitter.recorders[name] = recorder
+
+	return recorder, nil
+}
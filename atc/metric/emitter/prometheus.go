@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -25,6 +26,19 @@ type PrometheusEmitter struct {
 	buildsFinishedVec *prometheus.CounterVec
 	buildDurationsVec *prometheus.HistogramVec
 
+	buildQueueDurationsVec *prometheus.HistogramVec
+	stepDurationsVec       *prometheus.HistogramVec
+
+	stepWorkerWaitDurationsVec  *prometheus.HistogramVec
+	stepImageFetchDurationsVec  *prometheus.HistogramVec
+	stepInputStreamDurationsVec *prometheus.HistogramVec
+	stepInputStreamBytesVec     *prometheus.HistogramVec
+	stepRunDurationsVec         *prometheus.HistogramVec
+	stepExitsVec                *prometheus.CounterVec
+
+	teamActiveBuilds    *prometheus.GaugeVec
+	teamQuotaReachedVec *prometheus.CounterVec
+
 	workerContainers *prometheus.GaugeVec
 	workerVolumes    *prometheus.GaugeVec
 
@@ -37,7 +51,8 @@ type PrometheusEmitter struct {
 	dbQueriesTotal prometheus.Counter
 	dbConnections  *prometheus.GaugeVec
 
-	resourceChecksVec *prometheus.CounterVec
+	resourceChecksVec         *prometheus.CounterVec
+	resourceCheckDurationsVec *prometheus.HistogramVec
 
 	workerLastSeen map[string]time.Time
 	mu             sync.Mutex
@@ -132,6 +147,124 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	buildQueueDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "builds",
+			Name:      "queue_duration_seconds",
+			Help:      "Time in seconds builds were pending before starting",
+			Buckets:   []float64{1, 5, 15, 30, 60, 180, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(buildQueueDurationsVec)
+
+	stepDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds",
+			Help:      "Time in seconds get, put and task steps took to run",
+			Buckets:   []float64{1, 5, 15, 30, 60, 180, 300, 600, 900, 1800, 3600, 7200},
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker"},
+	)
+	prometheus.MustRegister(stepDurationsVec)
+
+	stepWorkerWaitDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "worker_wait_duration_seconds",
+			Help:      "Time in seconds get, put and task steps waited for a worker to be chosen",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 180, 300, 600, 1800},
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker"},
+	)
+	prometheus.MustRegister(stepWorkerWaitDurationsVec)
+
+	stepImageFetchDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "image_fetch_duration_seconds",
+			Help:      "Time in seconds get, put and task steps took to fetch their container's image",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 180, 300, 600, 1800},
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker"},
+	)
+	prometheus.MustRegister(stepImageFetchDurationsVec)
+
+	stepInputStreamDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "input_stream_duration_seconds",
+			Help:      "Time in seconds get, put and task steps spent streaming inputs from other workers",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 180, 300, 600, 1800},
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker"},
+	)
+	prometheus.MustRegister(stepInputStreamDurationsVec)
+
+	stepInputStreamBytesVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "input_stream_bytes",
+			Help:      "Bytes get, put and task steps streamed into their inputs from other workers",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 12),
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker"},
+	)
+	prometheus.MustRegister(stepInputStreamBytesVec)
+
+	stepRunDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "run_duration_seconds",
+			Help:      "Time in seconds the processes of get, put and task steps ran for",
+			Buckets:   []float64{1, 5, 15, 30, 60, 180, 300, 600, 900, 1800, 3600, 7200},
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker"},
+	)
+	prometheus.MustRegister(stepRunDurationsVec)
+
+	stepExitsVec := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "exits_total",
+			Help:      "Count of get, put and task step processes which exited, by exit status",
+		},
+		[]string{"team", "pipeline", "job", "step", "type", "worker", "exit_status"},
+	)
+	prometheus.MustRegister(stepExitsVec)
+
+	// team metrics
+	teamActiveBuilds := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "active_builds",
+			Help:      "Number of scheduled or running builds per team",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamActiveBuilds)
+
+	teamQuotaReachedVec := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "quota_reached_total",
+			Help:      "Number of times a build was held back by its team's build quota",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(teamQuotaReachedVec)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -231,6 +364,18 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(resourceChecksVec)
 
+	resourceCheckDurationsVec := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "resource",
+			Name:      "check_duration_seconds",
+			Help:      "Time in seconds resource checks took",
+			Buckets:   []float64{0.5, 1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"team", "pipeline", "resource", "worker"},
+	)
+	prometheus.MustRegister(resourceCheckDurationsVec)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -248,6 +393,19 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		buildsFailed:      buildsFailed,
 		buildsAborted:     buildsAborted,
 
+		buildQueueDurationsVec: buildQueueDurationsVec,
+		stepDurationsVec:       stepDurationsVec,
+
+		stepWorkerWaitDurationsVec:  stepWorkerWaitDurationsVec,
+		stepImageFetchDurationsVec:  stepImageFetchDurationsVec,
+		stepInputStreamDurationsVec: stepInputStreamDurationsVec,
+		stepInputStreamBytesVec:     stepInputStreamBytesVec,
+		stepRunDurationsVec:         stepRunDurationsVec,
+		stepExitsVec:                stepExitsVec,
+
+		teamActiveBuilds:    teamActiveBuilds,
+		teamQuotaReachedVec: teamQuotaReachedVec,
+
 		workerContainers: workerContainers,
 		workerVolumes:    workerVolumes,
 
@@ -260,7 +418,8 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		dbQueriesTotal: dbQueriesTotal,
 		dbConnections:  dbConnections,
 
-		resourceChecksVec: resourceChecksVec,
+		resourceChecksVec:         resourceChecksVec,
+		resourceCheckDurationsVec: resourceCheckDurationsVec,
 
 		workerLastSeen: map[string]time.Time{},
 	}
@@ -283,6 +442,10 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.buildsStarted.Inc()
 	case "build finished":
 		emitter.buildFinishedMetrics(logger, event)
+	case "team active builds":
+		emitter.teamMetrics(logger, event)
+	case "team quota reached":
+		emitter.teamMetrics(logger, event)
 	case "worker containers":
 		emitter.workerContainersMetric(logger, event)
 	case "worker volumes":
@@ -301,6 +464,24 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "build queue duration":
+		emitter.durationMetric(logger, event, emitter.buildQueueDurationsVec, "team", "pipeline", "job")
+	case "build step duration":
+		emitter.durationMetric(logger, event, emitter.stepDurationsVec, "team", "pipeline", "job", "step", "step_type", "worker")
+	case "resource check duration":
+		emitter.durationMetric(logger, event, emitter.resourceCheckDurationsVec, "team", "pipeline", "resource", "worker")
+	case "step worker wait duration":
+		emitter.durationMetric(logger, event, emitter.stepWorkerWaitDurationsVec, stepAttributes...)
+	case "step image fetch duration":
+		emitter.durationMetric(logger, event, emitter.stepImageFetchDurationsVec, stepAttributes...)
+	case "step input stream duration":
+		emitter.durationMetric(logger, event, emitter.stepInputStreamDurationsVec, stepAttributes...)
+	case "step input stream bytes":
+		emitter.stepInputStreamBytesMetric(logger, event)
+	case "step run duration":
+		emitter.durationMetric(logger, event, emitter.stepRunDurationsVec, stepAttributes...)
+	case "step exit status":
+		emitter.stepExitStatusMetric(logger, event)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -357,6 +538,30 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 	emitter.buildDurationsVec.WithLabelValues(team, pipeline).Observe(duration)
 }
 
+func (emitter *PrometheusEmitter) teamMetrics(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	value, ok := event.Value.(int)
+	if !ok {
+		logger.Error("team-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	switch event.Name {
+	case "team active builds":
+		// concourse_teams_active_builds
+		emitter.teamActiveBuilds.WithLabelValues(team).Set(float64(value))
+	case "team quota reached":
+		// concourse_teams_quota_reached_total
+		emitter.teamQuotaReachedVec.WithLabelValues(team).Add(float64(value))
+	default:
+	}
+}
+
 func (emitter *PrometheusEmitter) workerContainersMetric(logger lager.Logger, event metric.Event) {
 	worker, exists := event.Attributes["worker"]
 	if !exists {
@@ -483,6 +688,55 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 	emitter.resourceChecksVec.WithLabelValues(pipeline, team).Inc()
 }
 
+// stepAttributes label the metrics of get, put and task steps.
+var stepAttributes = []string{"team", "pipeline", "job", "step", "step_type", "worker"}
+
+func (emitter *PrometheusEmitter) stepInputStreamBytesMetric(logger lager.Logger, event metric.Event) {
+	streamedBytes, ok := event.Value.(int64)
+	if !ok {
+		logger.Error("step-input-stream-bytes-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int64"))
+		return
+	}
+
+	emitter.stepInputStreamBytesVec.WithLabelValues(labelValues(event, stepAttributes)...).Observe(float64(streamedBytes))
+}
+
+func (emitter *PrometheusEmitter) stepExitStatusMetric(logger lager.Logger, event metric.Event) {
+	exitStatus, ok := event.Value.(int)
+	if !ok {
+		logger.Error("step-exit-status-event-value-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	labels := append(labelValues(event, stepAttributes), strconv.Itoa(exitStatus))
+	emitter.stepExitsVec.WithLabelValues(labels...).Inc()
+}
+
+// labelValues returns the values of the given attributes among the event's
+// labels. Attributes which the configured dimensions don't allow are left
+// empty.
+func labelValues(event metric.Event, attributes []string) []string {
+	labels := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		labels[i] = event.Labels[attribute]
+	}
+
+	return labels
+}
+
+// durationMetric observes a histogram event in the given vec, labelled with
+// the given attributes.
+func (emitter *PrometheusEmitter) durationMetric(logger lager.Logger, event metric.Event, vec *prometheus.HistogramVec, attributes ...string) {
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("duration-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// seconds are the standard prometheus base unit for time
+	vec.WithLabelValues(labelValues(event, attributes)...).Observe(duration / 1000)
+}
+
 // updateLastSeen tracks for each worker when it last received a metric event.
 func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	emitter.mu.Lock()
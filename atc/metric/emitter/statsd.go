@@ -0,0 +1,108 @@
+package emitter
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/DataDog/datadog-go/statsd"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// StatsdEmitter emits to a plain statsd server, which has no notion of tags.
+// Instead, the labels of an event are appended to its metric name, e.g.
+// concourse.build_duration.job.unit.pipeline.main.team.main.
+type StatsdEmitter struct {
+	client *statsd.Client
+}
+
+type StatsdConfig struct {
+	Host   string `long:"statsd-host" description:"Statsd server host to emit metrics to."`
+	Port   string `long:"statsd-port" description:"Statsd server port to emit metrics to."`
+	Prefix string `long:"statsd-prefix" default:"concourse" description:"Prefix for all metric names."`
+}
+
+func init() {
+	metric.RegisterEmitter(&StatsdConfig{})
+}
+
+func (config *StatsdConfig) Description() string { return "Statsd" }
+func (config *StatsdConfig) IsConfigured() bool  { return config.Host != "" && config.Port != "" }
+
+func (config *StatsdConfig) NewEmitter() (metric.Emitter, error) {
+	client, err := statsd.New(fmt.Sprintf("%s:%s", config.Host, config.Port))
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Prefix != "" {
+		client.Namespace = strings.TrimSuffix(config.Prefix, ".") + "."
+	}
+
+	return &StatsdEmitter{
+		client: client,
+	}, nil
+}
+
+var nonSegmentChars = regexp.MustCompile("[^a-zA-Z0-9_-]+")
+
+// statsdCounts are distributions which aren't durations, so they can't be sent
+// as timers. They're counted instead, e.g. summing the bytes streamed into
+// steps over each flush interval.
+var statsdCounts = map[string]bool{
+	"step input stream bytes": true,
+}
+
+func (emitter *StatsdEmitter) Emit(logger lager.Logger, event metric.Event) {
+	name := statsdName(event)
+
+	value, err := getFloatHelper(event.Value)
+	if err != nil {
+		logger.Error("failed-to-convert-metric-for-statsd", nil, lager.Data{
+			"metric-name": name,
+		})
+		return
+	}
+
+	if statsdCounts[event.Name] {
+		err = emitter.client.Count(name, int64(value), nil, 1)
+	} else if event.Histogram {
+		err = emitter.client.TimeInMilliseconds(name, value, nil, 1)
+	} else {
+		err = emitter.client.Gauge(name, value, nil, 1)
+	}
+	if err != nil {
+		logger.Error("failed-to-send-metric", err)
+		return
+	}
+}
+
+func statsdName(event metric.Event) string {
+	segments := []string{
+		specialChars.ReplaceAllString(strings.Replace(strings.ToLower(event.Name), " ", "_", -1), ""),
+	}
+
+	keys := []string{}
+	for k := range event.Labels {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := event.Labels[k]
+		if v == "" {
+			continue
+		}
+
+		segments = append(
+			segments,
+			nonSegmentChars.ReplaceAllString(k, "_"),
+			nonSegmentChars.ReplaceAllString(v, "_"),
+		)
+	}
+
+	return strings.Join(segments, ".")
+}
@@ -297,6 +297,62 @@ func (event GarbageCollectionContainerCollectorJobDropped) Emit(logger lager.Log
 	)
 }
 
+type WorkerQuarantined struct {
+	WorkerName string
+	Errors     int
+}
+
+func (event WorkerQuarantined) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("worker-quarantined"),
+		Event{
+			Name:  "worker quarantined",
+			Value: event.Errors,
+			State: EventStateWarning,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
+type TeamActiveBuilds struct {
+	TeamName     string
+	ActiveBuilds int
+}
+
+func (event TeamActiveBuilds) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("team-active-builds"),
+		Event{
+			Name:  "team active builds",
+			Value: event.ActiveBuilds,
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+			},
+		},
+	)
+}
+
+type TeamQuotaReached struct {
+	TeamName string
+}
+
+func (event TeamQuotaReached) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("team-quota-reached"),
+		Event{
+			Name:  "team quota reached",
+			Value: 1,
+			State: EventStateWarning,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+			},
+		},
+	)
+}
+
 type BuildStarted struct {
 	PipelineName string
 	JobName      string
@@ -331,11 +387,16 @@ type BuildFinished struct {
 	BuildStatus   db.BuildStatus
 	BuildDuration time.Duration
 	TeamName      string
+
+	// QueueDuration is how long the build was pending before it started.
+	QueueDuration time.Duration
 }
 
 func (event BuildFinished) Emit(logger lager.Logger) {
+	logger = logger.Session("build-finished")
+
 	emit(
-		logger.Session("build-finished"),
+		logger,
 		Event{
 			Name:  "build finished",
 			Value: ms(event.BuildDuration),
@@ -350,6 +411,167 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 			},
 		},
 	)
+
+	emit(
+		logger,
+		Event{
+			Name:      "build duration",
+			Value:     ms(event.BuildDuration),
+			State:     EventStateOK,
+			Histogram: true,
+			Attributes: map[string]string{
+				"team":         event.TeamName,
+				"pipeline":     event.PipelineName,
+				"job":          event.JobName,
+				"build_status": string(event.BuildStatus),
+			},
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:      "build queue duration",
+			Value:     ms(event.QueueDuration),
+			State:     EventStateOK,
+			Histogram: true,
+			Attributes: map[string]string{
+				"team":     event.TeamName,
+				"pipeline": event.PipelineName,
+				"job":      event.JobName,
+			},
+		},
+	)
+
+}
+
+// StepFinished breaks down where a get, put or task step which ran on a
+// worker spent its time.
+type StepFinished struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	StepName     string
+	StepType     string
+	WorkerName   string
+
+	// Duration is how long the step took from start to finish.
+	Duration time.Duration
+
+	// WorkerWaitDuration is how long it took for a worker to be chosen.
+	WorkerWaitDuration time.Duration
+
+	// ImageFetchDuration is how long it took to fetch the container's image.
+	ImageFetchDuration time.Duration
+
+	// InputStreamDuration and InputStreamBytes total the inputs which had to
+	// be streamed into the container from other workers.
+	InputStreamDuration time.Duration
+	InputStreamBytes    int64
+
+	// Exited is false if the step errored before its process exited, in which
+	// case there is no run duration or exit status to report.
+	Exited      bool
+	RunDuration time.Duration
+	ExitStatus  int
+}
+
+func (event StepFinished) Emit(logger lager.Logger) {
+	logger = logger.Session("step-finished")
+
+	attributes := map[string]string{
+		"team":      event.TeamName,
+		"pipeline":  event.PipelineName,
+		"job":       event.JobName,
+		"step":      event.StepName,
+		"step_type": event.StepType,
+		"worker":    event.WorkerName,
+	}
+
+	emit(
+		logger,
+		Event{
+			Name:       "build step duration",
+			Value:      ms(event.Duration),
+			State:      EventStateOK,
+			Histogram:  true,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:       "step worker wait duration",
+			Value:      ms(event.WorkerWaitDuration),
+			State:      EventStateOK,
+			Histogram:  true,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:       "step image fetch duration",
+			Value:      ms(event.ImageFetchDuration),
+			State:      EventStateOK,
+			Histogram:  true,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:       "step input stream duration",
+			Value:      ms(event.InputStreamDuration),
+			State:      EventStateOK,
+			Histogram:  true,
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:       "step input stream bytes",
+			Value:      event.InputStreamBytes,
+			State:      EventStateOK,
+			Histogram:  true,
+			Attributes: attributes,
+		},
+	)
+
+	if !event.Exited {
+		return
+	}
+
+	emit(
+		logger,
+		Event{
+			Name:       "step run duration",
+			Value:      ms(event.RunDuration),
+			State:      EventStateOK,
+			Histogram:  true,
+			Attributes: attributes,
+		},
+	)
+
+	state := EventStateOK
+	if event.ExitStatus != 0 {
+		state = EventStateWarning
+	}
+
+	emit(
+		logger,
+		Event{
+			Name:       "step exit status",
+			Value:      event.ExitStatus,
+			State:      state,
+			Attributes: attributes,
+		},
+	)
 }
 
 func ms(duration time.Duration) float64 {
@@ -390,19 +612,23 @@ func (event HTTPResponseTime) Emit(logger lager.Logger) {
 }
 
 type ResourceCheck struct {
-	PipelineName string
-	ResourceName string
-	TeamName     string
-	Success      bool
+	PipelineName  string
+	ResourceName  string
+	TeamName      string
+	WorkerName    string
+	CheckDuration time.Duration
+	Success       bool
 }
 
 func (event ResourceCheck) Emit(logger lager.Logger) {
+	logger = logger.Session("resource-check")
+
 	state := EventStateOK
 	if !event.Success {
 		state = EventStateWarning
 	}
 	emit(
-		logger.Session("resource-check"),
+		logger,
 		Event{
 			Name:  "resource checked",
 			Value: 1,
@@ -411,6 +637,23 @@ func (event ResourceCheck) Emit(logger lager.Logger) {
 				"pipeline": event.PipelineName,
 				"resource": event.ResourceName,
 				"team":     event.TeamName,
+				"worker":   event.WorkerName,
+			},
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:      "resource check duration",
+			Value:     ms(event.CheckDuration),
+			State:     state,
+			Histogram: true,
+			Attributes: map[string]string{
+				"pipeline": event.PipelineName,
+				"resource": event.ResourceName,
+				"team":     event.TeamName,
+				"worker":   event.WorkerName,
 			},
 		},
 	)
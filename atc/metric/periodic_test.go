@@ -34,7 +34,7 @@ var _ = Describe("Periodic emission of metrics", func() {
 		b := &dbfakes.FakeConn{}
 		b.NameReturns("B")
 		metric.Databases = []db.Conn{a, b}
-		metric.Initialize(nil, "test", map[string]string{})
+		metric.Initialize(nil, "test", map[string]string{}, metric.Dimensions{})
 
 		process = ifrit.Invoke(metric.PeriodicallyEmit(lager.NewLogger("dont care"), 250*time.Millisecond))
 	})
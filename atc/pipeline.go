@@ -1,14 +1,111 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	Paused       bool         `json:"paused"`
+	Public       bool         `json:"public"`
+	Archived     bool         `json:"archived,omitempty"`
+	Groups       GroupConfigs `json:"groups,omitempty"`
+	TeamName     string       `json:"team_name"`
+}
+
+// Ref returns the PipelineRef identifying the pipeline.
+func (pipeline Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// InstanceVars distinguish the instances of a pipeline which share the same
+// name, e.g. one instance per release branch.
+type InstanceVars map[string]interface{}
+
+// String renders the vars as comma-separated key:value pairs, sorted by key.
+func (vars InstanceVars) String() string {
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		var value string
+		switch v := vars[key].(type) {
+		case string:
+			value = v
+		default:
+			payload, _ := json.Marshal(v)
+			value = string(payload)
+		}
+
+		pairs[i] = key + ":" + value
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// A PipelineRef identifies a pipeline within a team by its name and, for an
+// instanced pipeline, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	return ref.Name + "/" + ref.InstanceVars.String()
+}
+
+// QueryParams returns the query params which identify the pipeline's instance
+// in requests to the pipeline's API routes.
+func (ref PipelineRef) QueryParams() url.Values {
+	params := url.Values{}
+
+	if len(ref.InstanceVars) != 0 {
+		payload, _ := json.Marshal(ref.InstanceVars)
+		params.Set(InstanceVarsQueryParam, string(payload))
+	}
+
+	return params
+}
+
+// InstanceVarsFromQueryParams parses the instance vars given to a pipeline's
+// API route. No vars are returned for a pipeline which is not instanced.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var vars InstanceVars
+	err := json.Unmarshal([]byte(payload), &vars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %s", err)
+	}
+
+	if len(vars) == 0 {
+		return nil, nil
+	}
+
+	return vars, nil
+}
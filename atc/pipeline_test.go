@@ -0,0 +1,75 @@
+package atc_test
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineRef", func() {
+	var ref atc.PipelineRef
+
+	Context("when the pipeline is not instanced", func() {
+		BeforeEach(func() {
+			ref = atc.PipelineRef{Name: "some-pipeline"}
+		})
+
+		It("renders as the pipeline name", func() {
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("has no query params", func() {
+			Expect(ref.QueryParams()).To(BeEmpty())
+		})
+	})
+
+	Context("when the pipeline is instanced", func() {
+		BeforeEach(func() {
+			ref = atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"version": "1.2",
+					"branch":  "release",
+					"tags":    []interface{}{"a", "b"},
+				},
+			}
+		})
+
+		It("renders the instance vars sorted by key", func() {
+			Expect(ref.String()).To(Equal(`some-pipeline/branch:release,tags:["a","b"],version:1.2`))
+		})
+
+		It("round-trips the instance vars through the query params", func() {
+			vars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(ref.InstanceVars))
+		})
+	})
+})
+
+var _ = Describe("InstanceVarsFromQueryParams", func() {
+	It("returns no vars when the param is missing", func() {
+		vars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vars).To(BeNil())
+	})
+
+	It("returns no vars when the param is empty", func() {
+		vars, err := atc.InstanceVarsFromQueryParams(url.Values{
+			atc.InstanceVarsQueryParam: []string{"{}"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vars).To(BeNil())
+	})
+
+	It("errors when the param is malformed", func() {
+		_, err := atc.InstanceVarsFromQueryParams(url.Values{
+			atc.InstanceVarsQueryParam: []string{"bogus"},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("malformed instance vars: "))
+	})
+})
@@ -30,6 +30,7 @@ type radarSchedulerFactory struct {
 	resourceTypeCheckingInterval      time.Duration
 	resourceCheckingInterval          time.Duration
 	engine                            engine.Engine
+	buildQueue                        db.BuildQueue
 }
 
 func NewRadarSchedulerFactory(
@@ -38,6 +39,7 @@ func NewRadarSchedulerFactory(
 	resourceTypeCheckingInterval time.Duration,
 	resourceCheckingInterval time.Duration,
 	engine engine.Engine,
+	buildQueue db.BuildQueue,
 ) RadarSchedulerFactory {
 	return &radarSchedulerFactory{
 		resourceFactory:                   resourceFactory,
@@ -45,6 +47,7 @@ func NewRadarSchedulerFactory(
 		resourceTypeCheckingInterval:      resourceTypeCheckingInterval,
 		resourceCheckingInterval:          resourceCheckingInterval,
 		engine:                            engine,
+		buildQueue:                        buildQueue,
 	}
 }
 
@@ -88,11 +91,15 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalU
 			factory.NewBuildFactory(
 				pipeline.ID(),
 				atc.NewPlanFactory(time.Now().Unix()),
+				variables,
 			),
 			scanner,
 			inputMapper,
 			rsf.engine,
+			rsf.buildQueue,
 		),
-		Scanner: scanner,
+		BuildQueue: rsf.buildQueue,
+		Scanner:    scanner,
+		Clock:      clock.NewClock(),
 	}
 }
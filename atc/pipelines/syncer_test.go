@@ -158,6 +158,30 @@ var _ = Describe("Pipelines Syncer", func() {
 		})
 	})
 
+	Context("when a pipeline is archived", func() {
+		JustBeforeEach(func() {
+			Eventually(fakeRunner.RunCallCount).Should(Equal(1))
+			Eventually(otherFakeRunner.RunCallCount).Should(Equal(1))
+
+			pipeline1.ArchivedReturns(true)
+			pipelineFactory.AllPipelinesReturns([]db.Pipeline{pipeline1, pipeline2}, nil)
+
+			syncer.Sync()
+		})
+
+		It("stops the process", func() {
+			signals, _ := fakeRunner.RunArgsForCall(0)
+			Eventually(signals).Should(Receive(Equal(os.Interrupt)))
+		})
+
+		Context("when we sync again", func() {
+			It("does not spawn the process again", func() {
+				syncer.Sync()
+				Consistently(fakeRunner.RunCallCount).Should(Equal(1))
+			})
+		})
+	})
+
 	Context("when the pipeline's process exits", func() {
 		BeforeEach(func() {
 			fakeRunnerExitChan <- nil
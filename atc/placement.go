@@ -0,0 +1,104 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PlacementOperator string
+
+const (
+	PlacementOperatorIn     PlacementOperator = "in"
+	PlacementOperatorNotIn  PlacementOperator = "not_in"
+	PlacementOperatorExists PlacementOperator = "exists"
+)
+
+// A PlacementConstraint restricts the workers a step may run on to the ones
+// whose labels match it.
+type PlacementConstraint struct {
+	Key      string            `yaml:"key" json:"key" mapstructure:"key"`
+	Operator PlacementOperator `yaml:"operator" json:"operator" mapstructure:"operator"`
+	Values   []string          `yaml:"values,omitempty" json:"values,omitempty" mapstructure:"values"`
+}
+
+// PlacementConstraints must all be matched by a worker for a step to run on
+// it.
+type PlacementConstraints []PlacementConstraint
+
+func (constraints PlacementConstraints) Match(labels map[string]string) bool {
+	for _, constraint := range constraints {
+		if !constraint.Match(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (constraints PlacementConstraints) String() string {
+	descriptions := []string{}
+	for _, constraint := range constraints {
+		descriptions = append(descriptions, constraint.String())
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// Match returns whether the labels satisfy the constraint. A worker without
+// the label satisfies 'not_in'.
+func (constraint PlacementConstraint) Match(labels map[string]string) bool {
+	value, found := labels[constraint.Key]
+
+	switch constraint.Operator {
+	case PlacementOperatorIn:
+		return found && constraint.hasValue(value)
+	case PlacementOperatorNotIn:
+		return !found || !constraint.hasValue(value)
+	case PlacementOperatorExists:
+		return found
+	default:
+		return false
+	}
+}
+
+func (constraint PlacementConstraint) String() string {
+	switch constraint.Operator {
+	case PlacementOperatorExists:
+		return fmt.Sprintf("label '%s' exists", constraint.Key)
+	case PlacementOperatorNotIn:
+		return fmt.Sprintf("label '%s' not in (%s)", constraint.Key, strings.Join(constraint.Values, ", "))
+	default:
+		return fmt.Sprintf("label '%s' in (%s)", constraint.Key, strings.Join(constraint.Values, ", "))
+	}
+}
+
+func (constraint PlacementConstraint) Validate() error {
+	if constraint.Key == "" {
+		return fmt.Errorf("has no key specified")
+	}
+
+	switch constraint.Operator {
+	case PlacementOperatorIn, PlacementOperatorNotIn:
+		if len(constraint.Values) == 0 {
+			return fmt.Errorf("has no values specified for operator '%s'", constraint.Operator)
+		}
+	case PlacementOperatorExists:
+		if len(constraint.Values) != 0 {
+			return fmt.Errorf("has values specified for operator '%s'", constraint.Operator)
+		}
+	default:
+		return fmt.Errorf("has an unknown operator '%s' (must be 'in', 'not_in' or 'exists')", constraint.Operator)
+	}
+
+	return nil
+}
+
+func (constraint PlacementConstraint) hasValue(value string) bool {
+	for _, v := range constraint.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
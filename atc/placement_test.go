@@ -0,0 +1,60 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PlacementConstraints", func() {
+	labels := map[string]string{
+		"os":     "linux",
+		"memory": "32gb",
+	}
+
+	DescribeTable("Match",
+		func(constraints atc.PlacementConstraints, matches bool) {
+			Expect(constraints.Match(labels)).To(Equal(matches))
+		},
+		Entry("with no constraints", atc.PlacementConstraints{}, true),
+		Entry("with a value in the list", atc.PlacementConstraints{
+			{Key: "memory", Operator: atc.PlacementOperatorIn, Values: []string{"32gb", "64gb"}},
+		}, true),
+		Entry("with a value not in the list", atc.PlacementConstraints{
+			{Key: "memory", Operator: atc.PlacementOperatorIn, Values: []string{"64gb"}},
+		}, false),
+		Entry("with a missing label for 'in'", atc.PlacementConstraints{
+			{Key: "spot", Operator: atc.PlacementOperatorIn, Values: []string{"true"}},
+		}, false),
+		Entry("with a value excluded by 'not_in'", atc.PlacementConstraints{
+			{Key: "os", Operator: atc.PlacementOperatorNotIn, Values: []string{"linux"}},
+		}, false),
+		Entry("with a missing label for 'not_in'", atc.PlacementConstraints{
+			{Key: "spot", Operator: atc.PlacementOperatorNotIn, Values: []string{"true"}},
+		}, true),
+		Entry("with an existing label", atc.PlacementConstraints{
+			{Key: "os", Operator: atc.PlacementOperatorExists},
+		}, true),
+		Entry("with a missing label for 'exists'", atc.PlacementConstraints{
+			{Key: "gpu", Operator: atc.PlacementOperatorExists},
+		}, false),
+		Entry("with one of several constraints failing", atc.PlacementConstraints{
+			{Key: "os", Operator: atc.PlacementOperatorExists},
+			{Key: "gpu", Operator: atc.PlacementOperatorExists},
+		}, false),
+	)
+
+	Describe("String", func() {
+		It("describes every constraint", func() {
+			constraints := atc.PlacementConstraints{
+				{Key: "memory", Operator: atc.PlacementOperatorIn, Values: []string{"32gb", "64gb"}},
+				{Key: "spot", Operator: atc.PlacementOperatorNotIn, Values: []string{"true"}},
+				{Key: "gpu", Operator: atc.PlacementOperatorExists},
+			}
+
+			Expect(constraints.String()).To(Equal("label 'memory' in (32gb, 64gb), label 'spot' not in (true), label 'gpu' exists"))
+		})
+	})
+})
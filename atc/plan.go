@@ -4,18 +4,23 @@ type Plan struct {
 	ID       PlanID `json:"id"`
 	Attempts []int  `json:"attempts,omitempty"`
 
-	Aggregate *AggregatePlan `json:"aggregate,omitempty"`
-	Do        *DoPlan        `json:"do,omitempty"`
-	Get       *GetPlan       `json:"get,omitempty"`
-	Put       *PutPlan       `json:"put,omitempty"`
-	Task      *TaskPlan      `json:"task,omitempty"`
-	OnAbort   *OnAbortPlan   `json:"on_abort,ommitempty"`
-	Ensure    *EnsurePlan    `json:"ensure,omitempty"`
-	OnSuccess *OnSuccessPlan `json:"on_success,omitempty"`
-	OnFailure *OnFailurePlan `json:"on_failure,omitempty"`
-	Try       *TryPlan       `json:"try,omitempty"`
-	Timeout   *TimeoutPlan   `json:"timeout,omitempty"`
-	Retry     *RetryPlan     `json:"retry,omitempty"`
+	Aggregate  *AggregatePlan  `json:"aggregate,omitempty"`
+	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
+	Do         *DoPlan         `json:"do,omitempty"`
+	Get        *GetPlan        `json:"get,omitempty"`
+	Put        *PutPlan        `json:"put,omitempty"`
+	Task       *TaskPlan       `json:"task,omitempty"`
+	OnAbort    *OnAbortPlan    `json:"on_abort,ommitempty"`
+	Ensure     *EnsurePlan     `json:"ensure,omitempty"`
+	OnSuccess  *OnSuccessPlan  `json:"on_success,omitempty"`
+	OnFailure  *OnFailurePlan  `json:"on_failure,omitempty"`
+	Try        *TryPlan        `json:"try,omitempty"`
+	Timeout    *TimeoutPlan    `json:"timeout,omitempty"`
+	Retry      *RetryPlan      `json:"retry,omitempty"`
+
+	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
+	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 
 	// used for 'fly execute'
 	UserArtifact   *UserArtifactPlan   `json:"user_artifact,omitempty"`
@@ -66,6 +71,14 @@ type TryPlan struct {
 
 type AggregatePlan []Plan
 
+// An InParallelPlan runs its steps in parallel, running no more than Limit at
+// a time (or all of them, if Limit is 0).
+type InParallelPlan struct {
+	Steps    []Plan `json:"steps"`
+	Limit    int    `json:"limit,omitempty"`
+	FailFast bool   `json:"fail_fast,omitempty"`
+}
+
 type DoPlan []Plan
 
 type GetPlan struct {
@@ -78,6 +91,8 @@ type GetPlan struct {
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
 
+	Placement PlacementConstraints `json:"placement,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -89,14 +104,17 @@ type PutPlan struct {
 	Params   Params `json:"params,omitempty"`
 	Tags     Tags   `json:"tags,omitempty"`
 
+	Placement PlacementConstraints `json:"placement,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
-	Privileged bool `json:"privileged"`
-	Tags       Tags `json:"tags,omitempty"`
+	Privileged bool                 `json:"privileged"`
+	Tags       Tags                 `json:"tags,omitempty"`
+	Placement  PlacementConstraints `json:"placement,omitempty"`
 
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
@@ -111,6 +129,37 @@ type TaskPlan struct {
 
 type RetryPlan []Plan
 
+type SetPipelinePlan struct {
+	Name     string   `json:"name"`
+	File     string   `json:"file"`
+	Vars     Params   `json:"vars,omitempty"`
+	VarFiles []string `json:"var_files,omitempty"`
+}
+
+// An AcrossPlan runs each of its steps with the var set to the step's value.
+// A step across multiple vars is compiled into nested AcrossPlans, one per
+// var. Sensitive is set when the values were resolved from a ((var)), in which
+// case they are kept out of the public plan.
+type AcrossPlan struct {
+	Var         string          `json:"var"`
+	Steps       []VarScopedPlan `json:"steps"`
+	MaxInFlight int             `json:"max_in_flight,omitempty"`
+	FailFast    bool            `json:"fail_fast,omitempty"`
+	Sensitive   bool            `json:"sensitive,omitempty"`
+}
+
+type VarScopedPlan struct {
+	Step  Plan        `json:"step"`
+	Value interface{} `json:"value"`
+}
+
+type LoadVarPlan struct {
+	Name      string `json:"name"`
+	File      string `json:"file"`
+	Format    string `json:"format,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
 type DependentGetPlan struct {
 	Type     string `json:"type"`
 	Name     string `json:"name,omitempty"`
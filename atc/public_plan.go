@@ -7,6 +7,7 @@ func (plan Plan) Public() *json.RawMessage {
 		ID PlanID `json:"id"`
 
 		Aggregate      *json.RawMessage `json:"aggregate,omitempty"`
+		InParallel     *json.RawMessage `json:"in_parallel,omitempty"`
 		Do             *json.RawMessage `json:"do,omitempty"`
 		Get            *json.RawMessage `json:"get,omitempty"`
 		Put            *json.RawMessage `json:"put,omitempty"`
@@ -21,6 +22,9 @@ func (plan Plan) Public() *json.RawMessage {
 		Retry          *json.RawMessage `json:"retry,omitempty"`
 		UserArtifact   *json.RawMessage `json:"user_artifact,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
+		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
+		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 	}
 
 	public.ID = plan.ID
@@ -29,6 +33,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Aggregate = plan.Aggregate.Public()
 	}
 
+	if plan.InParallel != nil {
+		public.InParallel = plan.InParallel.Public()
+	}
+
 	if plan.Do != nil {
 		public.Do = plan.Do.Public()
 	}
@@ -85,6 +93,18 @@ func (plan Plan) Public() *json.RawMessage {
 		public.DependentGet = plan.DependentGet.Public()
 	}
 
+	if plan.SetPipeline != nil {
+		public.SetPipeline = plan.SetPipeline.Public()
+	}
+
+	if plan.LoadVar != nil {
+		public.LoadVar = plan.LoadVar.Public()
+	}
+
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	return enc(public)
 }
 
@@ -98,6 +118,24 @@ func (plan AggregatePlan) Public() *json.RawMessage {
 	return enc(public)
 }
 
+func (plan InParallelPlan) Public() *json.RawMessage {
+	steps := make([]*json.RawMessage, len(plan.Steps))
+
+	for i := 0; i < len(plan.Steps); i++ {
+		steps[i] = plan.Steps[i].Public()
+	}
+
+	return enc(struct {
+		Steps    []*json.RawMessage `json:"steps"`
+		Limit    int                `json:"limit,omitempty"`
+		FailFast bool               `json:"fail_fast,omitempty"`
+	}{
+		Steps:    steps,
+		Limit:    plan.Limit,
+		FailFast: plan.FailFast,
+	})
+}
+
 func (plan DoPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
@@ -224,6 +262,57 @@ func (plan RetryPlan) Public() *json.RawMessage {
 	return enc(public)
 }
 
+func (plan SetPipelinePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
+// redactedAcrossValue stands in for across values which were resolved from a
+// credential manager, as the public plan can be read by anyone who can see the
+// build.
+const redactedAcrossValue = "((redacted))"
+
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type publicVarScopedPlan struct {
+		Step  *json.RawMessage `json:"step"`
+		Value interface{}      `json:"value"`
+	}
+
+	steps := make([]publicVarScopedPlan, len(plan.Steps))
+	for i, step := range plan.Steps {
+		var value interface{} = step.Value
+		if plan.Sensitive {
+			value = redactedAcrossValue
+		}
+
+		steps[i] = publicVarScopedPlan{
+			Step:  step.Step.Public(),
+			Value: value,
+		}
+	}
+
+	return enc(struct {
+		Var      string                `json:"var"`
+		Steps    []publicVarScopedPlan `json:"steps"`
+		FailFast bool                  `json:"fail_fast,omitempty"`
+	}{
+		Var:      plan.Var,
+		Steps:    steps,
+		FailFast: plan.FailFast,
+	})
+}
+
+func (plan LoadVarPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
 func (plan UserArtifactPlan) Public() *json.RawMessage {
 	return enc(plan)
 }
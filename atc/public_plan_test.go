@@ -295,6 +295,64 @@ var _ = Describe("Plan", func() {
 							Name: "some-name",
 						},
 					},
+
+					atc.Plan{
+						ID: "33",
+						SetPipeline: &atc.SetPipelinePlan{
+							Name:     "some-pipeline",
+							File:     "some-file",
+							Vars:     atc.Params{"some": "vars"},
+							VarFiles: []string{"some-var-file"},
+						},
+					},
+
+					atc.Plan{
+						ID: "34",
+						LoadVar: &atc.LoadVarPlan{
+							Name:      "some-var",
+							File:      "some-file",
+							Format:    "json",
+							Sensitive: true,
+						},
+					},
+
+					atc.Plan{
+						ID: "37",
+						InParallel: &atc.InParallelPlan{
+							Steps: []atc.Plan{
+								atc.Plan{
+									ID: "38",
+									Task: &atc.TaskPlan{
+										Name:       "some-parallel-task",
+										Privileged: true,
+									},
+								},
+							},
+							Limit:    1,
+							FailFast: true,
+						},
+					},
+
+					atc.Plan{
+						ID: "35",
+						Across: &atc.AcrossPlan{
+							Var: "some-var",
+							Steps: []atc.VarScopedPlan{
+								{
+									Step: atc.Plan{
+										ID: "36",
+										Task: &atc.TaskPlan{
+											Name:       "some-task",
+											Privileged: true,
+										},
+									},
+									Value: "some-value",
+								},
+							},
+							MaxInFlight: 2,
+							FailFast:    true,
+						},
+					},
 				},
 			}
 
@@ -510,10 +568,120 @@ var _ = Describe("Plan", func() {
 			"artifact_output": {
 				"name": "some-name"
 			}
+		},
+		{
+			"id": "33",
+			"set_pipeline": {
+				"name": "some-pipeline"
+			}
+		},
+		{
+			"id": "34",
+			"load_var": {
+				"name": "some-var"
+			}
+		},
+		{
+			"id": "37",
+			"in_parallel": {
+				"steps": [
+					{
+						"id": "38",
+						"task": {
+							"name": "some-parallel-task",
+							"privileged": true
+						}
+					}
+				],
+				"limit": 1,
+				"fail_fast": true
+			}
+		},
+		{
+			"id": "35",
+			"across": {
+				"var": "some-var",
+				"steps": [
+					{
+						"value": "some-value",
+						"step": {
+							"id": "36",
+							"task": {
+								"name": "some-task",
+								"privileged": true
+							}
+						}
+					}
+				],
+				"fail_fast": true
+			}
 		}
   ]
 }
 `))
 		})
+
+		It("does not include across values resolved from a var", func() {
+			plan := atc.Plan{
+				ID: "0",
+				Across: &atc.AcrossPlan{
+					Var: "some-var",
+					Steps: []atc.VarScopedPlan{
+						{
+							Step: atc.Plan{
+								ID: "1",
+								Task: &atc.TaskPlan{
+									Name: "some-task",
+								},
+							},
+							Value: "some-secret-value",
+						},
+						{
+							Step: atc.Plan{
+								ID: "2",
+								Task: &atc.TaskPlan{
+									Name: "some-task",
+								},
+							},
+							Value: map[string]interface{}{"password": "some-other-secret"},
+						},
+					},
+					Sensitive: true,
+				},
+			}
+
+			json := plan.Public()
+			Expect(json).ToNot(BeNil())
+			Expect(string(*json)).ToNot(ContainSubstring("some-secret-value"))
+			Expect(string(*json)).ToNot(ContainSubstring("some-other-secret"))
+			Expect([]byte(*json)).To(MatchJSON(`{
+  "id": "0",
+  "across": {
+    "var": "some-var",
+    "steps": [
+      {
+        "value": "((redacted))",
+        "step": {
+          "id": "1",
+          "task": {
+            "name": "some-task",
+            "privileged": false
+          }
+        }
+      },
+      {
+        "value": "((redacted))",
+        "step": {
+          "id": "2",
+          "task": {
+            "name": "some-task",
+            "privileged": false
+          }
+        }
+      }
+    ]
+  }
+}`))
+		})
 	})
 })
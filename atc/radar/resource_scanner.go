@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -188,6 +189,26 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		break
 	}
 
+	// a version pinned in the pipeline's config has always been checked as
+	// usual, so only a pin from the API holds the check back
+	pinnedVersion := savedResource.APIPinnedVersion()
+	if fromVersion == nil && pinnedVersion != nil {
+		_, found, err := scanner.dbPipeline.GetVersionedResourceByVersion(pinnedVersion, resourceName)
+		if err != nil {
+			logger.Error("failed-to-find-pinned-version", err)
+			return interval, err
+		}
+
+		// there is nothing newer worth finding; only check for the pinned
+		// version until it exists
+		if found {
+			logger.Debug("skipping-check-of-pinned-resource")
+			return interval, nil
+		}
+
+		fromVersion = pinnedVersion
+	}
+
 	if fromVersion == nil {
 		vr, _, err := scanner.dbPipeline.GetLatestVersionedResource(resourceName)
 		if err != nil {
@@ -216,7 +237,17 @@ func (scanner *resourceScanner) check(
 	resourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
 	saveGiven bool,
-) error {
+) (err error) {
+	spanCtx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":     scanner.dbPipeline.TeamName(),
+		"pipeline": scanner.dbPipeline.Name(),
+		"resource": savedResource.Name(),
+		"type":     savedResource.Type(),
+	})
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-paused", err)
@@ -259,7 +290,7 @@ func (scanner *resourceScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		spanCtx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigCheckSession, scanner.dbPipeline.TeamID()),
 		db.ContainerMetadata{
@@ -286,9 +317,11 @@ func (scanner *resourceScanner) check(
 		logger.Error("failed-to-read-check-timeout", err)
 		return err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(spanCtx, timeout)
 	defer cancel()
 
+	checkStart := scanner.clock.Now()
+
 	newVersions, err := res.Check(ctx, source, fromVersion)
 	if err == context.DeadlineExceeded {
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
@@ -296,10 +329,12 @@ func (scanner *resourceScanner) check(
 
 	scanner.setResourceCheckError(logger, savedResource, err)
 	metric.ResourceCheck{
-		PipelineName: scanner.dbPipeline.Name(),
-		ResourceName: savedResource.Name(),
-		TeamName:     scanner.dbPipeline.TeamName(),
-		Success:      err == nil,
+		PipelineName:  scanner.dbPipeline.Name(),
+		ResourceName:  savedResource.Name(),
+		TeamName:      scanner.dbPipeline.TeamName(),
+		WorkerName:    res.Container().WorkerName(),
+		CheckDuration: scanner.clock.Since(checkStart),
+		Success:       err == nil,
 	}.Emit(logger)
 
 	if err != nil {
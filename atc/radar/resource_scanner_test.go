@@ -17,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/radar/radarfakes"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
 
 	. "github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
@@ -138,6 +139,10 @@ var _ = Describe("ResourceScanner", func() {
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			fakeContainer := new(workerfakes.FakeContainer)
+			fakeContainer.WorkerNameReturns("some-worker")
+			fakeResource.ContainerReturns(fakeContainer)
 		})
 
 		JustBeforeEach(func() {
@@ -498,6 +503,10 @@ var _ = Describe("ResourceScanner", func() {
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			fakeContainer := new(workerfakes.FakeContainer)
+			fakeContainer.WorkerNameReturns("some-worker")
+			fakeResource.ContainerReturns(fakeContainer)
 		})
 
 		JustBeforeEach(func() {
@@ -814,6 +823,80 @@ var _ = Describe("ResourceScanner", func() {
 				})
 			})
 
+			Context("when the resource has a version pinned in its config", func() {
+				BeforeEach(func() {
+					fakeDBResource.PinnedVersionReturns(atc.Version{"version": "2"})
+					fakeDBResource.CurrentPinnedVersionReturns(atc.Version{"version": "2"})
+					fakeDBPipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{ID: 2}, true, nil)
+					fakeDBPipeline.GetLatestVersionedResourceReturns(
+						db.SavedVersionedResource{
+							ID: 3,
+							VersionedResource: db.VersionedResource{
+								Version: db.ResourceVersion{"version": "3"},
+							},
+						}, true, nil)
+				})
+
+				It("checks from the latest version as usual", func() {
+					Expect(scanErr).NotTo(HaveOccurred())
+					Expect(fakeResource.CheckCallCount()).To(Equal(1))
+
+					_, _, version := fakeResource.CheckArgsForCall(0)
+					Expect(version).To(Equal(atc.Version{"version": "3"}))
+				})
+			})
+
+			Context("when the resource has a version pinned through the API", func() {
+				BeforeEach(func() {
+					fakeDBResource.APIPinnedVersionReturns(atc.Version{"version": "2"})
+					fakeDBResource.CurrentPinnedVersionReturns(atc.Version{"version": "2"})
+				})
+
+				Context("when the pinned version has been saved", func() {
+					BeforeEach(func() {
+						fakeDBPipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{ID: 2}, true, nil)
+					})
+
+					It("looks up the pinned version", func() {
+						version, resourceName := fakeDBPipeline.GetVersionedResourceByVersionArgsForCall(0)
+						Expect(version).To(Equal(atc.Version{"version": "2"}))
+						Expect(resourceName).To(Equal("some-resource"))
+					})
+
+					It("does not check", func() {
+						Expect(scanErr).NotTo(HaveOccurred())
+						Expect(fakeResource.CheckCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the pinned version has not been saved", func() {
+					BeforeEach(func() {
+						fakeDBPipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{}, false, nil)
+					})
+
+					It("checks from the pinned version", func() {
+						_, _, version := fakeResource.CheckArgsForCall(0)
+						Expect(version).To(Equal(atc.Version{"version": "2"}))
+					})
+				})
+
+				Context("when looking up the pinned version fails", func() {
+					disaster := errors.New("nope")
+
+					BeforeEach(func() {
+						fakeDBPipeline.GetVersionedResourceByVersionReturns(db.SavedVersionedResource{}, false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scanErr).To(Equal(disaster))
+					})
+
+					It("does not check", func() {
+						Expect(fakeResource.CheckCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when the check returns versions", func() {
 				var checkedFrom chan atc.Version
 
@@ -917,6 +1000,10 @@ var _ = Describe("ResourceScanner", func() {
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			fakeContainer := new(workerfakes.FakeContainer)
+			fakeContainer.WorkerNameReturns("some-worker")
+			fakeResource.ContainerReturns(fakeContainer)
 			fromVersion = nil
 		})
 
@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -189,7 +190,17 @@ func (scanner *resourceTypeScanner) check(
 	versionedResourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
 	saveGiven bool,
-) error {
+) (err error) {
+	spanCtx, span := tracing.StartSpan(context.Background(), "check", tracing.Attrs{
+		"team":          scanner.dbPipeline.TeamName(),
+		"pipeline":      scanner.dbPipeline.Name(),
+		"resource_type": savedResourceType.Name(),
+		"type":          savedResourceType.Type(),
+	})
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-paused", err)
@@ -210,7 +221,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		spanCtx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigCheckSession, scanner.dbPipeline.TeamID()),
 		db.ContainerMetadata{
@@ -225,7 +236,7 @@ func (scanner *resourceTypeScanner) check(
 		return err
 	}
 
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
+	newVersions, err := res.Check(spanCtx, source, fromVersion)
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
 			logger.Info("check-failed", lager.Data{"exit-status": rErr.ExitStatus})
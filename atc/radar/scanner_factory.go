@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
@@ -19,6 +20,7 @@ type ScannerFactory interface {
 }
 
 type scannerFactory struct {
+	logger                            lager.Logger
 	resourceFactory                   resource.ResourceFactory
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory
 	resourceTypeCheckingInterval      time.Duration
@@ -34,6 +36,7 @@ var ContainerExpiries = db.ContainerOwnerExpiries{
 }
 
 func NewScannerFactory(
+	logger lager.Logger,
 	resourceFactory resource.ResourceFactory,
 	resourceConfigCheckSessionFactory db.ResourceConfigCheckSessionFactory,
 	resourceTypeCheckingInterval time.Duration,
@@ -42,6 +45,7 @@ func NewScannerFactory(
 	variablesFactory creds.VariablesFactory,
 ) ScannerFactory {
 	return &scannerFactory{
+		logger:                            logger,
 		resourceFactory:                   resourceFactory,
 		resourceConfigCheckSessionFactory: resourceConfigCheckSessionFactory,
 		resourceCheckingInterval:          resourceCheckingInterval,
@@ -52,7 +56,7 @@ func NewScannerFactory(
 }
 
 func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
-	variables := f.variablesFactory.NewVariables(dbPipeline.TeamName(), dbPipeline.Name())
+	variables := creds.NewPipelineVariables(f.logger, f.variablesFactory, dbPipeline.TeamName(), dbPipeline.Name(), dbPipeline.VarSources())
 
 	resourceTypeScanner := NewResourceTypeScanner(
 		clock.NewClock(),
@@ -77,7 +81,7 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 }
 
 func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner {
-	variables := f.variablesFactory.NewVariables(dbPipeline.TeamName(), dbPipeline.Name())
+	variables := creds.NewPipelineVariables(f.logger, f.variablesFactory, dbPipeline.TeamName(), dbPipeline.Name(), dbPipeline.VarSources())
 
 	return NewResourceTypeScanner(
 		clock.NewClock(),
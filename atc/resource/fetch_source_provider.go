@@ -18,6 +18,7 @@ type FetchSourceProviderFactory interface {
 		session Session,
 		metadata Metadata,
 		tags atc.Tags,
+		placement atc.PlacementConstraints,
 		teamID int,
 		resourceTypes creds.VersionedResourceTypes,
 		resourceInstance ResourceInstance,
@@ -59,6 +60,7 @@ func (f *fetchSourceProviderFactory) NewFetchSourceProvider(
 	session Session,
 	metadata Metadata,
 	tags atc.Tags,
+	placement atc.PlacementConstraints,
 	teamID int,
 	resourceTypes creds.VersionedResourceTypes,
 	resourceInstance ResourceInstance,
@@ -69,6 +71,7 @@ func (f *fetchSourceProviderFactory) NewFetchSourceProvider(
 		session:                session,
 		metadata:               metadata,
 		tags:                   tags,
+		placement:              placement,
 		teamID:                 teamID,
 		resourceTypes:          resourceTypes,
 		resourceInstance:       resourceInstance,
@@ -83,6 +86,7 @@ type fetchSourceProvider struct {
 	session                Session
 	metadata               Metadata
 	tags                   atc.Tags
+	placement              atc.PlacementConstraints
 	teamID                 int
 	resourceTypes          creds.VersionedResourceTypes
 	resourceInstance       ResourceInstance
@@ -96,6 +100,7 @@ func (f *fetchSourceProvider) Get() (FetchSource, error) {
 		ResourceType: string(f.resourceInstance.ResourceType()),
 		Tags:         f.tags,
 		TeamID:       f.teamID,
+		Placement:    f.placement,
 	}
 
 	chosenWorker, err := f.workerClient.Satisfying(f.logger.Session("fetch-source-provider"), resourceSpec, f.resourceTypes)
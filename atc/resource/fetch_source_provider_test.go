@@ -29,6 +29,7 @@ var _ = Describe("FetchSourceProvider", func() {
 		metadata                 = resource.EmptyMetadata{}
 		session                  = resource.Session{}
 		tags                     atc.Tags
+		placement                atc.PlacementConstraints
 		resourceTypes            creds.VersionedResourceTypes
 		teamID                   = 3
 		fakeResourceCacheFactory *dbfakes.FakeResourceCacheFactory
@@ -43,6 +44,9 @@ var _ = Describe("FetchSourceProvider", func() {
 		logger = lagertest.NewTestLogger("test")
 		resourceInstance = new(resourcefakes.FakeResourceInstance)
 		tags = atc.Tags{"some", "tags"}
+		placement = atc.PlacementConstraints{
+			{Key: "os", Operator: atc.PlacementOperatorIn, Values: []string{"linux"}},
+		}
 
 		variables := template.StaticVariables{
 			"secret-repository": "repository",
@@ -69,6 +73,7 @@ var _ = Describe("FetchSourceProvider", func() {
 			session,
 			metadata,
 			tags,
+			placement,
 			teamID,
 			resourceTypes,
 			resourceInstance,
@@ -86,6 +91,7 @@ var _ = Describe("FetchSourceProvider", func() {
 				ResourceType: "some-resource-type",
 				Tags:         tags,
 				TeamID:       teamID,
+				Placement:    placement,
 			}))
 			Expect(actualResourceTypes).To(Equal(resourceTypes))
 		})
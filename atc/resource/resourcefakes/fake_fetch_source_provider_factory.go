@@ -12,17 +12,18 @@ import (
 )
 
 type FakeFetchSourceProviderFactory struct {
-	NewFetchSourceProviderStub        func(lager.Logger, resource.Session, resource.Metadata, atc.Tags, int, creds.VersionedResourceTypes, resource.ResourceInstance, worker.ImageFetchingDelegate) resource.FetchSourceProvider
+	NewFetchSourceProviderStub        func(lager.Logger, resource.Session, resource.Metadata, atc.Tags, atc.PlacementConstraints, int, creds.VersionedResourceTypes, resource.ResourceInstance, worker.ImageFetchingDelegate) resource.FetchSourceProvider
 	newFetchSourceProviderMutex       sync.RWMutex
 	newFetchSourceProviderArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 resource.Session
 		arg3 resource.Metadata
 		arg4 atc.Tags
-		arg5 int
-		arg6 creds.VersionedResourceTypes
-		arg7 resource.ResourceInstance
-		arg8 worker.ImageFetchingDelegate
+		arg5 atc.PlacementConstraints
+		arg6 int
+		arg7 creds.VersionedResourceTypes
+		arg8 resource.ResourceInstance
+		arg9 worker.ImageFetchingDelegate
 	}
 	newFetchSourceProviderReturns struct {
 		result1 resource.FetchSourceProvider
@@ -34,7 +35,7 @@ type FakeFetchSourceProviderFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProvider(arg1 lager.Logger, arg2 resource.Session, arg3 resource.Metadata, arg4 atc.Tags, arg5 int, arg6 creds.VersionedResourceTypes, arg7 resource.ResourceInstance, arg8 worker.ImageFetchingDelegate) resource.FetchSourceProvider {
+func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProvider(arg1 lager.Logger, arg2 resource.Session, arg3 resource.Metadata, arg4 atc.Tags, arg5 atc.PlacementConstraints, arg6 int, arg7 creds.VersionedResourceTypes, arg8 resource.ResourceInstance, arg9 worker.ImageFetchingDelegate) resource.FetchSourceProvider {
 	fake.newFetchSourceProviderMutex.Lock()
 	ret, specificReturn := fake.newFetchSourceProviderReturnsOnCall[len(fake.newFetchSourceProviderArgsForCall)]
 	fake.newFetchSourceProviderArgsForCall = append(fake.newFetchSourceProviderArgsForCall, struct {
@@ -42,15 +43,16 @@ func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProvider(arg1 lager.Lo
 		arg2 resource.Session
 		arg3 resource.Metadata
 		arg4 atc.Tags
-		arg5 int
-		arg6 creds.VersionedResourceTypes
-		arg7 resource.ResourceInstance
-		arg8 worker.ImageFetchingDelegate
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
-	fake.recordInvocation("NewFetchSourceProvider", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
+		arg5 atc.PlacementConstraints
+		arg6 int
+		arg7 creds.VersionedResourceTypes
+		arg8 resource.ResourceInstance
+		arg9 worker.ImageFetchingDelegate
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
+	fake.recordInvocation("NewFetchSourceProvider", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
 	fake.newFetchSourceProviderMutex.Unlock()
 	if fake.NewFetchSourceProviderStub != nil {
-		return fake.NewFetchSourceProviderStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+		return fake.NewFetchSourceProviderStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
 	}
 	if specificReturn {
 		return ret.result1
@@ -65,11 +67,11 @@ func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderCallCount() in
 	return len(fake.newFetchSourceProviderArgsForCall)
 }
 
-func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderArgsForCall(i int) (lager.Logger, resource.Session, resource.Metadata, atc.Tags, int, creds.VersionedResourceTypes, resource.ResourceInstance, worker.ImageFetchingDelegate) {
+func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderArgsForCall(i int) (lager.Logger, resource.Session, resource.Metadata, atc.Tags, atc.PlacementConstraints, int, creds.VersionedResourceTypes, resource.ResourceInstance, worker.ImageFetchingDelegate) {
 	fake.newFetchSourceProviderMutex.RLock()
 	defer fake.newFetchSourceProviderMutex.RUnlock()
 	argsForCall := fake.newFetchSourceProviderArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
 }
 
 func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderReturns(result1 resource.FetchSourceProvider) {
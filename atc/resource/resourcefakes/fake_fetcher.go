@@ -13,18 +13,19 @@ import (
 )
 
 type FakeFetcher struct {
-	FetchStub        func(context.Context, lager.Logger, resource.Session, atc.Tags, int, creds.VersionedResourceTypes, resource.ResourceInstance, resource.Metadata, worker.ImageFetchingDelegate) (resource.VersionedSource, error)
+	FetchStub        func(context.Context, lager.Logger, resource.Session, atc.Tags, atc.PlacementConstraints, int, creds.VersionedResourceTypes, resource.ResourceInstance, resource.Metadata, worker.ImageFetchingDelegate) (resource.VersionedSource, error)
 	fetchMutex       sync.RWMutex
 	fetchArgsForCall []struct {
-		arg1 context.Context
-		arg2 lager.Logger
-		arg3 resource.Session
-		arg4 atc.Tags
-		arg5 int
-		arg6 creds.VersionedResourceTypes
-		arg7 resource.ResourceInstance
-		arg8 resource.Metadata
-		arg9 worker.ImageFetchingDelegate
+		arg1  context.Context
+		arg2  lager.Logger
+		arg3  resource.Session
+		arg4  atc.Tags
+		arg5  atc.PlacementConstraints
+		arg6  int
+		arg7  creds.VersionedResourceTypes
+		arg8  resource.ResourceInstance
+		arg9  resource.Metadata
+		arg10 worker.ImageFetchingDelegate
 	}
 	fetchReturns struct {
 		result1 resource.VersionedSource
@@ -38,24 +39,25 @@ type FakeFetcher struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFetcher) Fetch(arg1 context.Context, arg2 lager.Logger, arg3 resource.Session, arg4 atc.Tags, arg5 int, arg6 creds.VersionedResourceTypes, arg7 resource.ResourceInstance, arg8 resource.Metadata, arg9 worker.ImageFetchingDelegate) (resource.VersionedSource, error) {
+func (fake *FakeFetcher) Fetch(arg1 context.Context, arg2 lager.Logger, arg3 resource.Session, arg4 atc.Tags, arg5 atc.PlacementConstraints, arg6 int, arg7 creds.VersionedResourceTypes, arg8 resource.ResourceInstance, arg9 resource.Metadata, arg10 worker.ImageFetchingDelegate) (resource.VersionedSource, error) {
 	fake.fetchMutex.Lock()
 	ret, specificReturn := fake.fetchReturnsOnCall[len(fake.fetchArgsForCall)]
 	fake.fetchArgsForCall = append(fake.fetchArgsForCall, struct {
-		arg1 context.Context
-		arg2 lager.Logger
-		arg3 resource.Session
-		arg4 atc.Tags
-		arg5 int
-		arg6 creds.VersionedResourceTypes
-		arg7 resource.ResourceInstance
-		arg8 resource.Metadata
-		arg9 worker.ImageFetchingDelegate
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
-	fake.recordInvocation("Fetch", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9})
+		arg1  context.Context
+		arg2  lager.Logger
+		arg3  resource.Session
+		arg4  atc.Tags
+		arg5  atc.PlacementConstraints
+		arg6  int
+		arg7  creds.VersionedResourceTypes
+		arg8  resource.ResourceInstance
+		arg9  resource.Metadata
+		arg10 worker.ImageFetchingDelegate
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
+	fake.recordInvocation("Fetch", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
 	fake.fetchMutex.Unlock()
 	if fake.FetchStub != nil {
-		return fake.FetchStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+		return fake.FetchStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -70,11 +72,11 @@ func (fake *FakeFetcher) FetchCallCount() int {
 	return len(fake.fetchArgsForCall)
 }
 
-func (fake *FakeFetcher) FetchArgsForCall(i int) (context.Context, lager.Logger, resource.Session, atc.Tags, int, creds.VersionedResourceTypes, resource.ResourceInstance, resource.Metadata, worker.ImageFetchingDelegate) {
+func (fake *FakeFetcher) FetchArgsForCall(i int) (context.Context, lager.Logger, resource.Session, atc.Tags, atc.PlacementConstraints, int, creds.VersionedResourceTypes, resource.ResourceInstance, resource.Metadata, worker.ImageFetchingDelegate) {
 	fake.fetchMutex.RLock()
 	defer fake.fetchMutex.RUnlock()
 	argsForCall := fake.fetchArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10
 }
 
 func (fake *FakeFetcher) FetchReturns(result1 resource.VersionedSource, result2 error) {
@@ -1,14 +1,20 @@
 package scheduler
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/scheduler/maxinflight"
 )
 
+var errOverriddenInputsUnsatisfiable = errors.New("the input versions the build was triggered with can no longer be used, e.g. because they have been disabled")
+
 //go:generate counterfeiter . BuildStarter
 
 type BuildStarter interface {
@@ -18,6 +24,7 @@ type BuildStarter interface {
 		resources db.Resources,
 		resourceTypes atc.VersionedResourceTypes,
 		nextPendingBuilds []db.Build,
+		queueUsage *db.QueueUsage,
 	) error
 }
 
@@ -34,6 +41,7 @@ func NewBuildStarter(
 	scanner Scanner,
 	inputMapper inputmapper.InputMapper,
 	execEngine engine.Engine,
+	buildQueue db.BuildQueue,
 ) BuildStarter {
 	return &buildStarter{
 		pipeline:           pipeline,
@@ -42,6 +50,7 @@ func NewBuildStarter(
 		scanner:            scanner,
 		inputMapper:        inputMapper,
 		execEngine:         execEngine,
+		buildQueue:         buildQueue,
 	}
 }
 
@@ -52,6 +61,7 @@ type buildStarter struct {
 	execEngine         engine.Engine
 	scanner            Scanner
 	inputMapper        inputmapper.InputMapper
+	buildQueue         db.BuildQueue
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -60,9 +70,10 @@ func (s *buildStarter) TryStartPendingBuildsForJob(
 	resources db.Resources,
 	resourceTypes atc.VersionedResourceTypes,
 	nextPendingBuildsForJob []db.Build,
+	queueUsage *db.QueueUsage,
 ) error {
 	for _, nextPendingBuild := range nextPendingBuildsForJob {
-		started, err := s.tryStartNextPendingBuild(logger, nextPendingBuild, job, resources, resourceTypes)
+		started, err := s.tryStartNextPendingBuild(logger, nextPendingBuild, job, resources, resourceTypes, queueUsage)
 		if err != nil {
 			return err
 		}
@@ -81,6 +92,7 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	job db.Job,
 	resources db.Resources,
 	resourceTypes atc.VersionedResourceTypes,
+	queueUsage *db.QueueUsage,
 ) (bool, error) {
 	logger = logger.Session("try-start-next-pending-build", lager.Data{
 		"build-id":   nextPendingBuild.ID(),
@@ -92,9 +104,14 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, err
 	}
 	if reachedMaxInFlight {
-		return false, nil
+		return false, s.withdraw(logger, nextPendingBuild)
 	}
 
+	var buildInputs []db.BuildInput
+	var found bool
+
+	overrides := nextPendingBuild.InputOverrides()
+
 	if nextPendingBuild.IsManuallyTriggered() {
 		jobBuildInputs := job.Config().Inputs()
 		for _, input := range jobBuildInputs {
@@ -115,9 +132,30 @@ func (s *buildStarter) tryStartNextPendingBuild(
 			return false, err
 		}
 
-		_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
-		if err != nil {
-			return false, err
+		if len(overrides.Inputs) > 0 {
+			buildInputs, found, err = s.overriddenBuildInputs(logger, versions, job, resources, overrides)
+			if err != nil {
+				return false, err
+			}
+
+			if !found {
+				// the overrides were valid when the build was triggered, but e.g.
+				// a version has since been disabled; the build would never start,
+				// so error it rather than holding up every build behind it, saving
+				// the reason as the build has no other output to explain it
+				err := nextPendingBuild.FinishWithError(errOverriddenInputsUnsatisfiable)
+				if err != nil {
+					logger.Error("failed-to-mark-build-as-errored", err)
+					return false, err
+				}
+
+				return true, nil
+			}
+		} else {
+			_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
+			if err != nil {
+				return false, err
+			}
 		}
 
 		dbResourceTypes, err := s.pipeline.ResourceTypes()
@@ -127,13 +165,16 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		resourceTypes = dbResourceTypes.Deserialize()
 	}
 
-	buildInputs, found, err := job.GetNextBuildInputs()
-	if err != nil {
-		logger.Error("failed-to-get-next-build-inputs", err)
-		return false, err
+	if len(overrides.Inputs) == 0 {
+		buildInputs, found, err = job.GetNextBuildInputs()
+		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
+			return false, err
+		}
 	}
+
 	if !found {
-		return false, nil
+		return false, s.withdraw(logger, nextPendingBuild)
 	}
 
 	pipelinePaused, err := s.pipeline.CheckPaused()
@@ -142,10 +183,36 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, err
 	}
 	if pipelinePaused {
-		return false, nil
+		return false, s.withdraw(logger, nextPendingBuild)
 	}
 
 	if job.Paused() {
+		return false, s.withdraw(logger, nextPendingBuild)
+	}
+
+	admission, err := s.buildQueue.Admit(nextPendingBuild, queueUsage)
+	if err != nil {
+		logger.Error("failed-to-admit-build", err)
+		return false, err
+	}
+
+	metric.TeamActiveBuilds{
+		TeamName:     nextPendingBuild.TeamName(),
+		ActiveBuilds: admission.TeamActiveBuilds,
+	}.Emit(logger)
+
+	if admission.TeamQuotaReached {
+		logger.Debug("team-quota-reached")
+
+		metric.TeamQuotaReached{
+			TeamName: nextPendingBuild.TeamName(),
+		}.Emit(logger)
+
+		return false, nil
+	}
+
+	if !admission.Admitted {
+		logger.Debug("waiting-in-build-queue")
 		return false, nil
 	}
 
@@ -197,3 +264,56 @@ func (s *buildStarter) tryStartNextPendingBuild(
 
 	return true, nil
 }
+
+// withdraw takes a build which can't be scheduled yet out of the build queue,
+// so that it doesn't hold a place ahead of builds which can.
+func (s *buildStarter) withdraw(logger lager.Logger, build db.Build) error {
+	err := s.buildQueue.Withdraw(build)
+	if err != nil {
+		logger.Error("failed-to-withdraw-build", err)
+		return err
+	}
+
+	return nil
+}
+
+// overriddenBuildInputs determines the inputs of a build which was triggered
+// with some of its input versions chosen by hand.
+func (s *buildStarter) overriddenBuildInputs(
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	resources db.Resources,
+	overrides atc.InputOverrides,
+) ([]db.BuildInput, bool, error) {
+	inputMapping, found, err := s.inputMapper.MapInputsWithOverrides(logger, versions, job, resources, overrides)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		logger.Debug("input-overrides-not-satisfiable")
+		return nil, false, nil
+	}
+
+	buildInputs := []db.BuildInput{}
+	for name, inputVersion := range inputMapping {
+		versionedResource, found, err := s.pipeline.VersionedResource(inputVersion.VersionID)
+		if err != nil {
+			logger.Error("failed-to-get-versioned-resource", err)
+			return nil, false, err
+		}
+
+		if !found {
+			return nil, false, nil
+		}
+
+		buildInputs = append(buildInputs, db.BuildInput{
+			Name:              name,
+			VersionedResource: versionedResource.VersionedResource,
+			FirstOccurrence:   inputVersion.FirstOccurrence,
+		})
+	}
+
+	return buildInputs, true, nil
+}
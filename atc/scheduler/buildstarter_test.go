@@ -29,6 +29,8 @@ var _ = Describe("BuildStarter", func() {
 		pendingBuilds   []db.Build
 		fakeScanner     *schedulerfakes.FakeScanner
 		fakeInputMapper *inputmapperfakes.FakeInputMapper
+		fakeBuildQueue  *dbfakes.FakeBuildQueue
+		queueUsage      *db.QueueUsage
 
 		buildStarter scheduler.BuildStarter
 
@@ -42,8 +44,11 @@ var _ = Describe("BuildStarter", func() {
 		fakeEngine = new(enginefakes.FakeEngine)
 		fakeScanner = new(schedulerfakes.FakeScanner)
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
+		fakeBuildQueue = new(dbfakes.FakeBuildQueue)
+		fakeBuildQueue.AdmitReturns(db.Admission{Admitted: true}, nil)
+		queueUsage = new(db.QueueUsage)
 
-		buildStarter = scheduler.NewBuildStarter(fakePipeline, fakeUpdater, fakeFactory, fakeScanner, fakeInputMapper, fakeEngine)
+		buildStarter = scheduler.NewBuildStarter(fakePipeline, fakeUpdater, fakeFactory, fakeScanner, fakeInputMapper, fakeEngine, fakeBuildQueue)
 
 		disaster = errors.New("bad thing")
 	})
@@ -87,6 +92,7 @@ var _ = Describe("BuildStarter", func() {
 					db.Resources{resource},
 					versionedResourceTypes,
 					pendingBuilds,
+					queueUsage,
 				)
 			})
 
@@ -279,6 +285,103 @@ var _ = Describe("BuildStarter", func() {
 							})
 
 						})
+
+						Context("when the build has input overrides", func() {
+							var overrides atc.InputOverrides
+
+							BeforeEach(func() {
+								overrides = atc.InputOverrides{
+									Inputs:       map[string]atc.Version{"input-1": {"ref": "v1"}},
+									IgnorePassed: true,
+								}
+								createdBuild.InputOverridesReturns(overrides)
+
+								fakePipeline.CheckPausedReturns(false, nil)
+								createdBuild.ScheduleReturns(true, nil)
+								fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+							})
+
+							Context("when the inputs can be resolved", func() {
+								BeforeEach(func() {
+									fakeInputMapper.MapInputsWithOverridesReturns(algorithm.InputMapping{
+										"input-1": algorithm.InputVersion{VersionID: 1, FirstOccurrence: false},
+									}, true, nil)
+
+									fakePipeline.VersionedResourceReturns(db.SavedVersionedResource{
+										ID: 1,
+										VersionedResource: db.VersionedResource{
+											Resource: "input-1",
+											Type:     "git",
+											Version:  db.ResourceVersion{"ref": "v1"},
+										},
+									}, true, nil)
+								})
+
+								It("maps the inputs with the overrides", func() {
+									Expect(fakeInputMapper.MapInputsWithOverridesCallCount()).To(Equal(1))
+									_, actualVersionsDB, actualJob, _, actualOverrides := fakeInputMapper.MapInputsWithOverridesArgsForCall(0)
+									Expect(actualVersionsDB).To(Equal(versionsDB))
+									Expect(actualJob.Name()).To(Equal(job.Name()))
+									Expect(actualOverrides).To(Equal(overrides))
+								})
+
+								It("does not touch the job's next input mapping", func() {
+									Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(Equal(0))
+									Expect(job.GetNextBuildInputsCallCount()).To(Equal(0))
+								})
+
+								It("uses the overridden inputs", func() {
+									Expect(fakePipeline.VersionedResourceArgsForCall(0)).To(Equal(1))
+									Expect(createdBuild.UseInputsCallCount()).To(Equal(1))
+									Expect(createdBuild.UseInputsArgsForCall(0)).To(Equal([]db.BuildInput{
+										{
+											Name: "input-1",
+											VersionedResource: db.VersionedResource{
+												Resource: "input-1",
+												Type:     "git",
+												Version:  db.ResourceVersion{"ref": "v1"},
+											},
+											FirstOccurrence: false,
+										},
+									}))
+								})
+							})
+
+							Context("when the inputs cannot be resolved", func() {
+								var nextBuild *dbfakes.FakeBuild
+
+								BeforeEach(func() {
+									fakeInputMapper.MapInputsWithOverridesReturns(nil, false, nil)
+
+									nextBuild = new(dbfakes.FakeBuild)
+									nextBuild.IDReturns(67)
+									pendingBuilds = append(pendingBuilds, nextBuild)
+								})
+
+								It("errors the build without scheduling it", func() {
+									Expect(tryStartErr).NotTo(HaveOccurred())
+									Expect(createdBuild.ScheduleCallCount()).To(Equal(0))
+									Expect(createdBuild.FinishWithErrorCallCount()).To(Equal(1))
+									Expect(createdBuild.FinishWithErrorArgsForCall(0)).To(MatchError(ContainSubstring("can no longer be used")))
+								})
+
+								It("moves on to the next pending build", func() {
+									Expect(fakeUpdater.UpdateMaxInFlightReachedCallCount()).To(Equal(2))
+									_, _, actualBuildID := fakeUpdater.UpdateMaxInFlightReachedArgsForCall(1)
+									Expect(actualBuildID).To(Equal(67))
+								})
+							})
+
+							Context("when mapping the inputs fails", func() {
+								BeforeEach(func() {
+									fakeInputMapper.MapInputsWithOverridesReturns(nil, false, disaster)
+								})
+
+								It("returns the error", func() {
+									Expect(tryStartErr).To(Equal(disaster))
+								})
+							})
+						})
 					})
 				})
 			})
@@ -304,6 +407,7 @@ var _ = Describe("BuildStarter", func() {
 						},
 					},
 					pendingBuilds,
+					queueUsage,
 				)
 			})
 
@@ -349,6 +453,14 @@ var _ = Describe("BuildStarter", func() {
 				})
 			}
 
+			itWithdrewTheFirstBuildFromTheQueue := func() {
+				It("withdrew the first build from the build queue", func() {
+					Expect(fakeBuildQueue.AdmitCallCount()).To(BeZero())
+					Expect(fakeBuildQueue.WithdrawCallCount()).To(Equal(1))
+					Expect(fakeBuildQueue.WithdrawArgsForCall(0).ID()).To(Equal(99))
+				})
+			}
+
 			Context("when the stars align", func() {
 				BeforeEach(func() {
 					job.PausedReturns(false)
@@ -578,6 +690,7 @@ var _ = Describe("BuildStarter", func() {
 						})
 
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+						itWithdrewTheFirstBuildFromTheQueue()
 					})
 
 					Context("when getting the next build inputs fails", func() {
@@ -595,6 +708,7 @@ var _ = Describe("BuildStarter", func() {
 						})
 
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+						itWithdrewTheFirstBuildFromTheQueue()
 						itUpdatedMaxInFlightForTheFirstBuild()
 					})
 
@@ -613,6 +727,7 @@ var _ = Describe("BuildStarter", func() {
 						})
 
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+						itWithdrewTheFirstBuildFromTheQueue()
 						itUpdatedMaxInFlightForTheFirstBuild()
 					})
 
@@ -621,6 +736,54 @@ var _ = Describe("BuildStarter", func() {
 							job.PausedReturns(true)
 						})
 
+						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+						itWithdrewTheFirstBuildFromTheQueue()
+						itUpdatedMaxInFlightForTheFirstBuild()
+					})
+
+					Context("when withdrawing the build from the queue fails", func() {
+						BeforeEach(func() {
+							job.PausedReturns(true)
+							fakeBuildQueue.WithdrawReturns(disaster)
+						})
+
+						itReturnsTheError()
+						itUpdatedMaxInFlightForTheFirstBuild()
+					})
+
+					Context("when admitting the build to the queue fails", func() {
+						BeforeEach(func() {
+							fakeBuildQueue.AdmitReturns(db.Admission{}, disaster)
+						})
+
+						itReturnsTheError()
+						itUpdatedMaxInFlightForTheFirstBuild()
+					})
+
+					Context("when the build has to wait in the queue", func() {
+						BeforeEach(func() {
+							fakeBuildQueue.AdmitReturns(db.Admission{}, nil)
+						})
+
+						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+						itUpdatedMaxInFlightForTheFirstBuild()
+
+						It("asked the queue about the first build", func() {
+							Expect(fakeBuildQueue.AdmitCallCount()).To(Equal(1))
+							build, usage := fakeBuildQueue.AdmitArgsForCall(0)
+							Expect(build.ID()).To(Equal(99))
+							Expect(usage).To(BeIdenticalTo(queueUsage))
+						})
+					})
+
+					Context("when the build's team has reached its quota", func() {
+						BeforeEach(func() {
+							fakeBuildQueue.AdmitReturns(db.Admission{
+								TeamQuotaReached: true,
+								TeamActiveBuilds: 5,
+							}, nil)
+						})
+
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
 						itUpdatedMaxInFlightForTheFirstBuild()
 					})
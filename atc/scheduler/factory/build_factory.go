@@ -2,8 +2,10 @@ package factory
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -18,12 +20,14 @@ type BuildFactory interface {
 type buildFactory struct {
 	PipelineID  int
 	planFactory atc.PlanFactory
+	variables   creds.Variables
 }
 
-func NewBuildFactory(pipelineID int, planFactory atc.PlanFactory) BuildFactory {
+func NewBuildFactory(pipelineID int, planFactory atc.PlanFactory, variables creds.Variables) BuildFactory {
 	return &buildFactory{
 		PipelineID:  pipelineID,
 		planFactory: planFactory,
+		variables:   variables,
 	}
 }
 
@@ -99,6 +103,16 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		acrossVars := planConfig.Across
+		failFast := planConfig.FailFast
+
+		planConfig.Across = nil
+		planConfig.FailFast = false
+
+		return factory.across(acrossVars, failFast, planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -131,6 +145,65 @@ func (factory *buildFactory) constructPlanFromConfig(
 	})
 }
 
+// across constructs the step once for every combination of the vars' values,
+// nesting an AcrossPlan for each var so that each var's max_in_flight applies
+// to its own values.
+func (factory *buildFactory) across(
+	acrossVars []atc.AcrossVarConfig,
+	failFast bool,
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	if len(acrossVars) == 0 {
+		return factory.constructPlanFromConfig(planConfig, resources, resourceTypes, inputs)
+	}
+
+	acrossVar := acrossVars[0]
+
+	values, sensitive, err := factory.acrossValues(acrossVar)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	across := atc.AcrossPlan{
+		Var:         acrossVar.Var,
+		MaxInFlight: acrossVar.MaxInFlight,
+		FailFast:    failFast,
+		Sensitive:   sensitive,
+	}
+
+	for _, value := range values {
+		step, err := factory.across(acrossVars[1:], failFast, planConfig, resources, resourceTypes, inputs)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		across.Steps = append(across.Steps, atc.VarScopedPlan{
+			Step:  step,
+			Value: value,
+		})
+	}
+
+	return factory.planFactory.NewPlan(across), nil
+}
+
+// acrossValues returns the values of the across var, and whether they were
+// resolved from a ((var)) and so must not be shown in the public plan.
+func (factory *buildFactory) acrossValues(acrossVar atc.AcrossVarConfig) ([]interface{}, bool, error) {
+	if values, ok := acrossVar.Values.([]interface{}); ok {
+		return values, false, nil
+	}
+
+	values, err := creds.NewList(factory.variables, acrossVar.Values).Evaluate()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to evaluate values of across var '%s': %s", acrossVar.Var, err)
+	}
+
+	return values, true, nil
+}
+
 func (factory *buildFactory) constructUnhookedPlan(
 	planConfig atc.PlanConfig,
 	resources atc.ResourceConfigs,
@@ -173,6 +246,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:   planConfig.Params,
 			Tags:     planConfig.Tags,
 
+			Placement: planConfig.Placement,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -186,6 +261,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Tags:   planConfig.Tags,
 			Source: resource.Source,
 
+			Placement: planConfig.Placement,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -223,6 +300,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Version:  &version,
 			Tags:     planConfig.Tags,
 
+			Placement: planConfig.Placement,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -233,6 +312,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Config:            planConfig.TaskConfig,
 			ConfigPath:        planConfig.TaskConfigPath,
 			Tags:              planConfig.Tags,
+			Placement:         planConfig.Placement,
 			Params:            planConfig.Params,
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
@@ -240,6 +320,22 @@ func (factory *buildFactory) constructUnhookedPlan(
 
 			VersionedResourceTypes: resourceTypes,
 		})
+	case planConfig.SetPipeline != "":
+		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
+			Name:     planConfig.SetPipeline,
+			File:     planConfig.TaskConfigPath,
+			Vars:     planConfig.Vars,
+			VarFiles: planConfig.VarFiles,
+		})
+
+	case planConfig.LoadVar != "":
+		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
+			Name:      planConfig.LoadVar,
+			File:      planConfig.TaskConfigPath,
+			Format:    planConfig.Format,
+			Sensitive: planConfig.Sensitive,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
@@ -273,6 +369,28 @@ func (factory *buildFactory) constructUnhookedPlan(
 		}
 
 		plan = factory.planFactory.NewPlan(aggregate)
+
+	case planConfig.InParallel != nil:
+		inParallel := atc.InParallelPlan{
+			Limit:    planConfig.InParallel.Limit,
+			FailFast: planConfig.InParallel.FailFast,
+		}
+
+		for _, planConfig := range planConfig.InParallel.Steps {
+			nextStep, err := factory.constructPlanFromConfig(
+				planConfig,
+				resources,
+				resourceTypes,
+				inputs,
+			)
+			if err != nil {
+				return atc.Plan{}, err
+			}
+
+			inParallel.Steps = append(inParallel.Steps, nextStep)
+		}
+
+		plan = factory.planFactory.NewPlan(inParallel)
 	}
 
 	if planConfig.Timeout != "" {
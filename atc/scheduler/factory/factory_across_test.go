@@ -0,0 +1,184 @@
+package factory_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory, template.StaticVariables{
+			"platforms": []interface{}{"linux", "darwin"},
+		})
+	})
+
+	Context("when a step is run across a static list of values", func() {
+		It("builds a plan for each value", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "go_version",
+								Values:      []interface{}{"1.10", "1.11"},
+								MaxInFlight: 1,
+							},
+						},
+						FailFast: true,
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			task110 := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:       "some-task",
+				ConfigPath: "some-artifact/task.yml",
+			})
+
+			task111 := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:       "some-task",
+				ConfigPath: "some-artifact/task.yml",
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var:         "go_version",
+				MaxInFlight: 1,
+				FailFast:    true,
+				Steps: []atc.VarScopedPlan{
+					{Step: task110, Value: "1.10"},
+					{Step: task111, Value: "1.11"},
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a step is run across multiple vars", func() {
+		It("builds a nested plan for every combination, resolving values from vars", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go_version",
+								Values: []interface{}{"1.10"},
+							},
+							{
+								Var:    "platform",
+								Values: "((platforms))",
+							},
+						},
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskLinux := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:       "some-task",
+				ConfigPath: "some-artifact/task.yml",
+			})
+
+			taskDarwin := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:       "some-task",
+				ConfigPath: "some-artifact/task.yml",
+			})
+
+			platforms := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var: "platform",
+				Steps: []atc.VarScopedPlan{
+					{Step: taskLinux, Value: "linux"},
+					{Step: taskDarwin, Value: "darwin"},
+				},
+				Sensitive: true,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var: "go_version",
+				Steps: []atc.VarScopedPlan{
+					{Step: platforms, Value: "1.10"},
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when the values var does not resolve", func() {
+		It("returns an error", func() {
+			_, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "platform",
+								Values: "((missing))",
+							},
+						},
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to evaluate values of across var 'platform'"))
+		})
+	})
+
+	Context("when the step has hooks", func() {
+		It("runs the hooks for each combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go_version",
+								Values: []interface{}{"1.10"},
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Task: "some-failure-task",
+						},
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var: "go_version",
+				Steps: []atc.VarScopedPlan{
+					{
+						Step: expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+							Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+								Name: "some-task",
+							}),
+							Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+								Name: "some-failure-task",
+							}),
+						}),
+						Value: "1.10",
+					},
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory LoadVar Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory, nil)
+	})
+
+	Context("when there is a load_var step followed by a task", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						LoadVar:        "version",
+						TaskConfigPath: "some-artifact/version",
+						Format:         "trim",
+						Sensitive:      true,
+					},
+					{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Params: atc.Params{
+							"VERSION": "((.:version))",
+						},
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.DoPlan{
+				expectedPlanFactory.NewPlan(atc.LoadVarPlan{
+					Name:      "version",
+					File:      "some-artifact/version",
+					Format:    "trim",
+					Sensitive: true,
+				}),
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:       "some-task",
+					ConfigPath: "some-artifact/task.yml",
+					Params: atc.Params{
+						"VERSION": "((.:version))",
+					},
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
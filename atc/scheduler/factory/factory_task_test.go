@@ -24,7 +24,7 @@ var _ = Describe("Factory Task", func() {
 		BeforeEach(func() {
 			actualPlanFactory = atc.NewPlanFactory(123)
 			expectedPlanFactory = atc.NewPlanFactory(123)
-			buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+			buildFactory = factory.NewBuildFactory(42, actualPlanFactory, nil)
 
 			resources = atc.ResourceConfigs{
 				{
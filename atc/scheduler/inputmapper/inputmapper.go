@@ -17,6 +17,14 @@ type InputMapper interface {
 		job db.Job,
 		resources db.Resources,
 	) (algorithm.InputMapping, error)
+
+	MapInputsWithOverrides(
+		logger lager.Logger,
+		versions *algorithm.VersionsDB,
+		job db.Job,
+		resources db.Resources,
+		overrides atc.InputOverrides,
+	) (algorithm.InputMapping, bool, error)
 }
 
 func NewInputMapper(pipeline db.Pipeline, transformer inputconfig.Transformer) InputMapper {
@@ -36,20 +44,7 @@ func (i *inputMapper) SaveNextInputMapping(
 ) (algorithm.InputMapping, error) {
 	logger = logger.Session("save-next-input-mapping")
 
-	inputConfigs := job.Config().Inputs()
-
-	for i, inputConfig := range inputConfigs {
-		resource, found := resources.Lookup(inputConfig.Resource)
-
-		if !found {
-			logger.Debug("failed-to-find-resource")
-			continue
-		}
-
-		if len(resource.PinnedVersion()) != 0 {
-			inputConfigs[i].Version = &atc.VersionConfig{Pinned: resource.PinnedVersion()}
-		}
-	}
+	inputConfigs := pinnedInputConfigs(logger, job, resources)
 
 	algorithmInputConfigs, err := i.transformer.TransformInputConfigs(versions, job.Name(), inputConfigs)
 	if err != nil {
@@ -72,8 +67,13 @@ func (i *inputMapper) SaveNextInputMapping(
 	}
 
 	if len(independentMapping) < len(inputConfigs) {
+		err := saveUnsatisfiedInputs(logger, versions, job, inputConfigs, algorithmInputConfigs)
+		if err != nil {
+			return nil, err
+		}
+
 		// this is necessary to prevent builds from running with missing pinned versions
-		err := job.DeleteNextInputMapping()
+		err = job.DeleteNextInputMapping()
 		if err != nil {
 			logger.Error("failed-to-delete-next-input-mapping-after-missing-pending", err)
 		}
@@ -83,7 +83,12 @@ func (i *inputMapper) SaveNextInputMapping(
 
 	resolvedMapping, ok := algorithmInputConfigs.Resolve(versions)
 	if !ok {
-		err := job.DeleteNextInputMapping()
+		err := saveUnsatisfiedInputs(logger, versions, job, inputConfigs, algorithmInputConfigs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = job.DeleteNextInputMapping()
 		if err != nil {
 			logger.Error("failed-to-delete-next-input-mapping-after-failed-resolve", err)
 		}
@@ -91,6 +96,12 @@ func (i *inputMapper) SaveNextInputMapping(
 		return nil, err
 	}
 
+	err = job.SaveUnsatisfiedInputs(algorithm.UnsatisfiedInputs{})
+	if err != nil {
+		logger.Error("failed-to-clear-unsatisfied-inputs", err)
+		return nil, err
+	}
+
 	err = job.SaveNextInputMapping(resolvedMapping)
 	if err != nil {
 		logger.Error("failed-to-save-next-input-mapping", err)
@@ -99,3 +110,101 @@ func (i *inputMapper) SaveNextInputMapping(
 
 	return resolvedMapping, nil
 }
+
+// MapInputsWithOverrides resolves the inputs of a single build, with some of
+// them set to the given versions. Unlike SaveNextInputMapping, the job's next
+// input mapping is left alone.
+func (i *inputMapper) MapInputsWithOverrides(
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	resources db.Resources,
+	overrides atc.InputOverrides,
+) (algorithm.InputMapping, bool, error) {
+	logger = logger.Session("map-inputs-with-overrides")
+
+	inputConfigs := pinnedInputConfigs(logger, job, resources)
+
+	for i, inputConfig := range inputConfigs {
+		version, found := overrides.Inputs[inputConfig.Name]
+		if !found {
+			continue
+		}
+
+		inputConfigs[i].Version = &atc.VersionConfig{Pinned: version}
+
+		if overrides.IgnorePassed {
+			inputConfigs[i].Passed = nil
+		}
+	}
+
+	algorithmInputConfigs, err := i.transformer.TransformInputConfigs(versions, job.Name(), inputConfigs)
+	if err != nil {
+		logger.Error("failed-to-get-algorithm-input-configs", err)
+		return nil, false, err
+	}
+
+	if len(algorithmInputConfigs) < len(inputConfigs) {
+		// a pinned or overridden version does not exist (yet)
+		return nil, false, nil
+	}
+
+	mapping, ok := algorithmInputConfigs.Resolve(versions)
+	return mapping, ok, nil
+}
+
+// saveUnsatisfiedInputs records why the job's inputs could not be mapped,
+// so that it can be explained why no build is being scheduled.
+func saveUnsatisfiedInputs(
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	inputConfigs []atc.JobInput,
+	algorithmInputConfigs algorithm.InputConfigs,
+) error {
+	unsatisfied := algorithmInputConfigs.Unsatisfied(versions)
+
+	for _, inputConfig := range inputConfigs {
+		transformed := false
+		for _, algorithmInputConfig := range algorithmInputConfigs {
+			if algorithmInputConfig.Name == inputConfig.Name {
+				transformed = true
+				break
+			}
+		}
+
+		if !transformed {
+			// the transformer skips inputs whose pinned version does not exist
+			unsatisfied[inputConfig.Name] = algorithm.UnsatisfiedInput{
+				Reason: algorithm.UnsatisfiedPinnedVersionUnavailable,
+			}
+		}
+	}
+
+	err := job.SaveUnsatisfiedInputs(unsatisfied)
+	if err != nil {
+		logger.Error("failed-to-save-unsatisfied-inputs", err)
+		return err
+	}
+
+	return nil
+}
+
+func pinnedInputConfigs(logger lager.Logger, job db.Job, resources db.Resources) []atc.JobInput {
+	inputConfigs := job.Config().Inputs()
+
+	for i, inputConfig := range inputConfigs {
+		resource, found := resources.Lookup(inputConfig.Resource)
+
+		if !found {
+			logger.Debug("failed-to-find-resource")
+			continue
+		}
+
+		if len(resource.CurrentPinnedVersion()) != 0 {
+			inputConfigs[i].Version = &atc.VersionConfig{Pinned: resource.CurrentPinnedVersion()}
+		}
+	}
+
+	return inputConfigs
+}
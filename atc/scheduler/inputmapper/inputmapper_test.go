@@ -193,6 +193,11 @@ var _ = Describe("Inputmapper", func() {
 						It("didn't delete the mapping", func() {
 							Expect(fakeJob.DeleteNextInputMappingCallCount()).To(BeZero())
 						})
+
+						It("clears the unsatisfied inputs", func() {
+							Expect(fakeJob.SaveUnsatisfiedInputsCallCount()).To(Equal(1))
+							Expect(fakeJob.SaveUnsatisfiedInputsArgsForCall(0)).To(BeEmpty())
+						})
 					})
 				})
 			})
@@ -236,6 +241,16 @@ var _ = Describe("Inputmapper", func() {
 				})
 			})
 
+			Context("when saving the unsatisfied inputs fails", func() {
+				BeforeEach(func() {
+					fakeJob.SaveUnsatisfiedInputsReturns(disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(mappingErr).To(Equal(disaster))
+				})
+			})
+
 			Context("when deleting the next input mapping succeeds", func() {
 				BeforeEach(func() {
 					fakeJob.DeleteNextInputMappingReturns(nil)
@@ -254,6 +269,14 @@ var _ = Describe("Inputmapper", func() {
 					Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
 				})
 
+				It("saved the passed constraints as unsatisfied", func() {
+					Expect(fakeJob.SaveUnsatisfiedInputsCallCount()).To(Equal(1))
+					Expect(fakeJob.SaveUnsatisfiedInputsArgsForCall(0)).To(Equal(algorithm.UnsatisfiedInputs{
+						"a": {Reason: algorithm.UnsatisfiedPassedConstraints, PassedJobs: []string{"upstream"}},
+						"b": {Reason: algorithm.UnsatisfiedPassedConstraints, PassedJobs: []string{"upstream"}},
+					}))
+				})
+
 				It("returns an empty mapping and no error", func() {
 					Expect(mappingErr).NotTo(HaveOccurred())
 					Expect(inputMapping).To(BeEmpty())
@@ -302,6 +325,13 @@ var _ = Describe("Inputmapper", func() {
 				Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
 			})
 
+			It("saved the input without versions as unsatisfied", func() {
+				Expect(fakeJob.SaveUnsatisfiedInputsCallCount()).To(Equal(1))
+				Expect(fakeJob.SaveUnsatisfiedInputsArgsForCall(0)).To(Equal(algorithm.UnsatisfiedInputs{
+					"no-versions": {Reason: algorithm.UnsatisfiedNoVersions},
+				}))
+			})
+
 			It("returns an empty mapping and no error", func() {
 				Expect(mappingErr).NotTo(HaveOccurred())
 				Expect(inputMapping).To(BeEmpty())
@@ -343,6 +373,13 @@ var _ = Describe("Inputmapper", func() {
 				Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
 			})
 
+			It("saved the pinned input as unsatisfied", func() {
+				Expect(fakeJob.SaveUnsatisfiedInputsCallCount()).To(Equal(1))
+				Expect(fakeJob.SaveUnsatisfiedInputsArgsForCall(0)).To(Equal(algorithm.UnsatisfiedInputs{
+					"a": {Reason: algorithm.UnsatisfiedPinnedVersionUnavailable},
+				}))
+			})
+
 			It("returns an empty mapping and no error", func() {
 				Expect(mappingErr).NotTo(HaveOccurred())
 				Expect(inputMapping).To(BeEmpty())
@@ -393,7 +430,7 @@ var _ = Describe("Inputmapper", func() {
 
 				fakeResource := new(dbfakes.FakeResource)
 				fakeResource.NameReturns("a")
-				fakeResource.PinnedVersionReturns(map[string]string{"ref": "abc"})
+				fakeResource.CurrentPinnedVersionReturns(atc.Version{"ref": "abc"})
 
 				resources = db.Resources{fakeResource}
 			})
@@ -433,4 +470,131 @@ var _ = Describe("Inputmapper", func() {
 			})
 		})
 	})
+
+	Describe("MapInputsWithOverrides", func() {
+		var (
+			versionsDB   *algorithm.VersionsDB
+			fakeJob      *dbfakes.FakeJob
+			overrides    atc.InputOverrides
+			inputMapping algorithm.InputMapping
+			found        bool
+			mappingErr   error
+		)
+
+		BeforeEach(func() {
+			versionsDB = &algorithm.VersionsDB{
+				JobIDs:      map[string]int{"some-job": 1, "upstream": 2},
+				ResourceIDs: map[string]int{"a": 11, "b": 12},
+				ResourceVersions: []algorithm.ResourceVersion{
+					{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+					{VersionID: 2, ResourceID: 11, CheckOrder: 2},
+					{VersionID: 3, ResourceID: 12, CheckOrder: 1},
+				},
+			}
+
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{Get: "a", Resource: "a", Passed: []string{"upstream"}},
+					{Get: "b", Resource: "b"},
+				},
+			})
+
+			overrides = atc.InputOverrides{
+				Inputs: map[string]atc.Version{"a": {"ref": "v1"}},
+			}
+
+			fakeTransformer.TransformInputConfigsReturns(algorithm.InputConfigs{
+				{Name: "a", ResourceID: 11, PinnedVersionID: 1, JobID: 1},
+				{Name: "b", ResourceID: 12, JobID: 1},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			inputMapping, found, mappingErr = inputMapper.MapInputsWithOverrides(
+				lagertest.NewTestLogger("test"),
+				versionsDB,
+				fakeJob,
+				db.Resources{},
+				overrides,
+			)
+		})
+
+		It("pins the overridden inputs and keeps their passed constraints", func() {
+			Expect(fakeTransformer.TransformInputConfigsCallCount()).To(Equal(1))
+			_, _, actualJobInputs := fakeTransformer.TransformInputConfigsArgsForCall(0)
+			Expect(actualJobInputs).To(ConsistOf(
+				atc.JobInput{
+					Name:     "a",
+					Resource: "a",
+					Passed:   []string{"upstream"},
+					Version:  &atc.VersionConfig{Pinned: atc.Version{"ref": "v1"}},
+				},
+				atc.JobInput{
+					Name:     "b",
+					Resource: "b",
+				},
+			))
+		})
+
+		It("resolves the inputs", func() {
+			Expect(mappingErr).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(inputMapping).To(Equal(algorithm.InputMapping{
+				"a": algorithm.InputVersion{VersionID: 1, FirstOccurrence: true},
+				"b": algorithm.InputVersion{VersionID: 3, FirstOccurrence: true},
+			}))
+		})
+
+		It("does not save any input mapping", func() {
+			Expect(fakeJob.SaveIndependentInputMappingCallCount()).To(Equal(0))
+			Expect(fakeJob.SaveNextInputMappingCallCount()).To(Equal(0))
+			Expect(fakeJob.DeleteNextInputMappingCallCount()).To(Equal(0))
+		})
+
+		Context("when passed constraints are ignored", func() {
+			BeforeEach(func() {
+				overrides.IgnorePassed = true
+			})
+
+			It("drops the passed constraints of the overridden inputs", func() {
+				_, _, actualJobInputs := fakeTransformer.TransformInputConfigsArgsForCall(0)
+				Expect(actualJobInputs).To(ConsistOf(
+					atc.JobInput{
+						Name:     "a",
+						Resource: "a",
+						Version:  &atc.VersionConfig{Pinned: atc.Version{"ref": "v1"}},
+					},
+					atc.JobInput{
+						Name:     "b",
+						Resource: "b",
+					},
+				))
+			})
+		})
+
+		Context("when an overridden version is missing", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(algorithm.InputConfigs{
+					{Name: "b", ResourceID: 12, JobID: 1},
+				}, nil)
+			})
+
+			It("does not resolve the inputs", func() {
+				Expect(mappingErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when transforming the input configs fails", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(mappingErr).To(Equal(disaster))
+			})
+		})
+	})
 })
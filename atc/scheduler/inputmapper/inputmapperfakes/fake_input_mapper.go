@@ -5,12 +5,32 @@ import (
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
+	atc "github.com/concourse/concourse/atc"
 	db "github.com/concourse/concourse/atc/db"
 	algorithm "github.com/concourse/concourse/atc/db/algorithm"
 	inputmapper "github.com/concourse/concourse/atc/scheduler/inputmapper"
 )
 
 type FakeInputMapper struct {
+	MapInputsWithOverridesStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources, atc.InputOverrides) (algorithm.InputMapping, bool, error)
+	mapInputsWithOverridesMutex       sync.RWMutex
+	mapInputsWithOverridesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 *algorithm.VersionsDB
+		arg3 db.Job
+		arg4 db.Resources
+		arg5 atc.InputOverrides
+	}
+	mapInputsWithOverridesReturns struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}
+	mapInputsWithOverridesReturnsOnCall map[int]struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}
 	SaveNextInputMappingStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -31,6 +51,66 @@ type FakeInputMapper struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeInputMapper) MapInputsWithOverrides(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources, arg5 atc.InputOverrides) (algorithm.InputMapping, bool, error) {
+	fake.mapInputsWithOverridesMutex.Lock()
+	ret, specificReturn := fake.mapInputsWithOverridesReturnsOnCall[len(fake.mapInputsWithOverridesArgsForCall)]
+	fake.mapInputsWithOverridesArgsForCall = append(fake.mapInputsWithOverridesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 *algorithm.VersionsDB
+		arg3 db.Job
+		arg4 db.Resources
+		arg5 atc.InputOverrides
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("MapInputsWithOverrides", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.mapInputsWithOverridesMutex.Unlock()
+	if fake.MapInputsWithOverridesStub != nil {
+		return fake.MapInputsWithOverridesStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.mapInputsWithOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInputMapper) MapInputsWithOverridesCallCount() int {
+	fake.mapInputsWithOverridesMutex.RLock()
+	defer fake.mapInputsWithOverridesMutex.RUnlock()
+	return len(fake.mapInputsWithOverridesArgsForCall)
+}
+
+func (fake *FakeInputMapper) MapInputsWithOverridesArgsForCall(i int) (lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources, atc.InputOverrides) {
+	fake.mapInputsWithOverridesMutex.RLock()
+	defer fake.mapInputsWithOverridesMutex.RUnlock()
+	argsForCall := fake.mapInputsWithOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInputMapper) MapInputsWithOverridesReturns(result1 algorithm.InputMapping, result2 bool, result3 error) {
+	fake.MapInputsWithOverridesStub = nil
+	fake.mapInputsWithOverridesReturns = struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInputMapper) MapInputsWithOverridesReturnsOnCall(i int, result1 algorithm.InputMapping, result2 bool, result3 error) {
+	fake.MapInputsWithOverridesStub = nil
+	if fake.mapInputsWithOverridesReturnsOnCall == nil {
+		fake.mapInputsWithOverridesReturnsOnCall = make(map[int]struct {
+			result1 algorithm.InputMapping
+			result2 bool
+			result3 error
+		})
+	}
+	fake.mapInputsWithOverridesReturnsOnCall[i] = struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInputMapper) SaveNextInputMapping(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources) (algorithm.InputMapping, error) {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -90,6 +170,8 @@ func (fake *FakeInputMapper) SaveNextInputMappingReturnsOnCall(i int, result1 al
 func (fake *FakeInputMapper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.mapInputsWithOverridesMutex.RLock()
+	defer fake.mapInputsWithOverridesMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
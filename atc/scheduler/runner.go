@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"errors"
 	"os"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 //go:generate counterfeiter . BuildScheduler
@@ -28,6 +30,15 @@ type BuildScheduler interface {
 		job db.Job,
 		resources db.Resources,
 		resourceTypes atc.VersionedResourceTypes,
+		overrides atc.InputOverrides,
+	) (db.Build, Waiter, error)
+
+	RerunImmediately(
+		logger lager.Logger,
+		job db.Job,
+		buildToRerun db.Build,
+		resources db.Resources,
+		resourceTypes atc.VersionedResourceTypes,
 	) (db.Build, Waiter, error)
 
 	SaveNextInputMapping(logger lager.Logger, job db.Job, resource db.Resources) error
@@ -73,7 +84,7 @@ dance:
 	return nil
 }
 
-func (runner *Runner) tick(logger lager.Logger) error {
+func (runner *Runner) tick(logger lager.Logger) (err error) {
 	if runner.Noop {
 		return nil
 	}
@@ -90,6 +101,14 @@ func (runner *Runner) tick(logger lager.Logger) error {
 
 	defer schedulingLock.Release()
 
+	_, span := tracing.StartSpan(context.Background(), "schedule", tracing.Attrs{
+		"team":     runner.Pipeline.TeamName(),
+		"pipeline": runner.Pipeline.Name(),
+	})
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	start := time.Now()
 
 	defer func() {
@@ -10,8 +10,12 @@ import (
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	. "github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracetest"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/ginkgomon"
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/kv/value"
 
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -156,6 +160,39 @@ var _ = Describe("Runner", func() {
 		})
 	})
 
+	Context("when tracing is configured", func() {
+		var provider *tracetest.Provider
+
+		BeforeEach(func() {
+			provider = tracetest.NewProvider()
+			tracing.ConfigureTraceProvider(provider)
+		})
+
+		AfterEach(func() {
+			tracing.Configured = false
+		})
+
+		It("records a span for each tick", func() {
+			Eventually(provider.Spans).ShouldNot(BeEmpty())
+
+			span := provider.Spans()[0]
+			Expect(span.Name()).To(Equal("schedule"))
+			Expect(span.Attributes()).To(HaveKeyWithValue(kv.Key("pipeline"), value.String("some-pipeline")))
+		})
+
+		Context("when it can't get the lock", func() {
+			BeforeEach(func() {
+				fakePipeline.AcquireSchedulingLockReturns(nil, false, nil)
+			})
+
+			It("does not record a span", func() {
+				Eventually(fakePipeline.AcquireSchedulingLockCallCount).Should(Equal(2))
+
+				Expect(provider.Spans()).To(BeEmpty())
+			})
+		})
+	})
+
 	Context("when getting the lock blows up", func() {
 		BeforeEach(func() {
 			fakePipeline.AcquireSchedulingLockReturns(nil, false, errors.New(":3"))
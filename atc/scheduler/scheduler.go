@@ -1,9 +1,12 @@
 package scheduler
 
 import (
+	"encoding/binary"
+	"hash/fnv"
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -15,7 +18,9 @@ type Scheduler struct {
 	Pipeline     db.Pipeline
 	InputMapper  inputmapper.InputMapper
 	BuildStarter BuildStarter
+	BuildQueue   db.BuildQueue
 	Scanner      Scanner
+	Clock        clock.Clock
 }
 
 //go:generate counterfeiter . Scanner
@@ -35,7 +40,12 @@ func (s *Scheduler) Schedule(
 
 	for _, job := range jobs {
 		jStart := time.Now()
-		err := s.ensurePendingBuildExists(logger, versions, job, resources)
+		err := s.ensureScheduledBuildExists(logger, job)
+		if err != nil {
+			return jobSchedulingTime, err
+		}
+
+		err = s.ensurePendingBuildExists(logger, versions, job, resources)
 		jobSchedulingTime[job.Name()] = time.Since(jStart)
 
 		if err != nil {
@@ -49,6 +59,17 @@ func (s *Scheduler) Schedule(
 		return jobSchedulingTime, err
 	}
 
+	if len(nextPendingBuilds) == 0 {
+		return jobSchedulingTime, nil
+	}
+
+	// the teams' active builds are counted once for every job's builds
+	queueUsage, err := s.BuildQueue.Usage()
+	if err != nil {
+		logger.Error("failed-to-get-build-queue-usage", err)
+		return jobSchedulingTime, err
+	}
+
 	for _, job := range jobs {
 		jStart := time.Now()
 		nextPendingBuildsForJob, ok := nextPendingBuilds[job.Name()]
@@ -56,7 +77,7 @@ func (s *Scheduler) Schedule(
 			continue
 		}
 
-		err := s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuildsForJob)
+		err := s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuildsForJob, queueUsage)
 		jobSchedulingTime[job.Name()] = jobSchedulingTime[job.Name()] + time.Since(jStart)
 
 		if err != nil {
@@ -96,6 +117,93 @@ func (s *Scheduler) ensurePendingBuildExists(
 	return nil
 }
 
+// ensureScheduledBuildExists creates a build for the most recent activation
+// of the job's schedule which hasn't been built yet. Activations missed while
+// the ATC was down or the job was paused are coalesced into a single build.
+func (s *Scheduler) ensureScheduledBuildExists(logger lager.Logger, job db.Job) error {
+	config := job.Config().Schedule
+	if config == nil || job.Paused() {
+		return nil
+	}
+
+	logger = logger.Session("ensure-scheduled-build", lager.Data{"job": job.Name()})
+
+	schedule, err := config.CronSchedule()
+	if err != nil {
+		logger.Error("failed-to-parse-schedule", err)
+		return nil
+	}
+
+	jitter, err := config.JitterDuration()
+	if err != nil {
+		logger.Error("failed-to-parse-jitter", err)
+		return nil
+	}
+
+	now := s.Clock.Now()
+
+	lastScheduled := job.LastScheduled()
+	if lastScheduled.IsZero() {
+		err := job.InitializeLastScheduled(now)
+		if err != nil {
+			logger.Error("failed-to-initialize-last-scheduled", err)
+			return err
+		}
+
+		return nil
+	}
+
+	next := schedule.Next(lastScheduled)
+	if next.IsZero() || next.After(now) {
+		return nil
+	}
+
+	for {
+		following := schedule.Next(next)
+		if following.IsZero() || following.After(now) {
+			break
+		}
+
+		next = following
+	}
+
+	if now.Before(next.Add(jitterFor(job.ID(), next, jitter))) {
+		return nil
+	}
+
+	build, created, err := job.CreateScheduledBuild(lastScheduled, next)
+	if err != nil {
+		logger.Error("failed-to-create-scheduled-build", err)
+		return err
+	}
+
+	if created {
+		logger.Info("created-scheduled-build", lager.Data{
+			"build":     build.Name(),
+			"scheduled": next,
+		})
+	}
+
+	return nil
+}
+
+// jitterFor picks a delay in [0, jitter) which is stable for a given job and
+// activation, so that every ATC agrees on when the build is due.
+func jitterFor(jobID int, activation time.Time, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, uint64(jobID))
+	binary.BigEndian.PutUint64(buf[8:], uint64(activation.Unix()))
+
+	hash := fnv.New64a()
+	hash.Write(buf)
+
+	return time.Duration(hash.Sum64() % uint64(jitter))
+}
+
 type Waiter interface {
 	Wait()
 }
@@ -105,14 +213,54 @@ func (s *Scheduler) TriggerImmediately(
 	job db.Job,
 	resources db.Resources,
 	resourceTypes atc.VersionedResourceTypes,
+	overrides atc.InputOverrides,
 ) (db.Build, Waiter, error) {
 	logger = logger.Session("trigger-immediately", lager.Data{"job_name": job.Name()})
 
-	build, err := job.CreateBuild()
+	var build db.Build
+	var err error
+	if len(overrides.Inputs) > 0 {
+		build, err = job.CreateBuildWithInputOverrides(overrides)
+	} else {
+		build, err = job.CreateBuild()
+	}
 	if err != nil {
 		logger.Error("failed-to-create-job-build", err)
 		return nil, nil, err
 	}
+
+	return build, s.startPendingBuilds(logger, job, resources, resourceTypes), nil
+}
+
+// RerunImmediately creates a build which reuses the inputs of the given build
+// and tries to start it right away.
+func (s *Scheduler) RerunImmediately(
+	logger lager.Logger,
+	job db.Job,
+	buildToRerun db.Build,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+) (db.Build, Waiter, error) {
+	logger = logger.Session("rerun-immediately", lager.Data{
+		"job_name":   job.Name(),
+		"build_name": buildToRerun.Name(),
+	})
+
+	build, err := job.RerunBuild(buildToRerun)
+	if err != nil {
+		logger.Error("failed-to-create-rerun-build", err)
+		return nil, nil, err
+	}
+
+	return build, s.startPendingBuilds(logger, job, resources, resourceTypes), nil
+}
+
+func (s *Scheduler) startPendingBuilds(
+	logger lager.Logger,
+	job db.Job,
+	resources db.Resources,
+	resourceTypes atc.VersionedResourceTypes,
+) Waiter {
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
 
@@ -125,14 +273,20 @@ func (s *Scheduler) TriggerImmediately(
 			return
 		}
 
-		err = s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuilds)
+		queueUsage, err := s.BuildQueue.Usage()
+		if err != nil {
+			logger.Error("failed-to-get-build-queue-usage", err)
+			return
+		}
+
+		err = s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuilds, queueUsage)
 		if err != nil {
 			logger.Error("failed-to-start-next-pending-build-for-job", err, lager.Data{"job-name": job.Name()})
 			return
 		}
 	}()
 
-	return build, wg, nil
+	return wg
 }
 
 func (s *Scheduler) SaveNextInputMapping(logger lager.Logger, job db.Job, resources db.Resources) error {
@@ -2,7 +2,9 @@ package scheduler_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -21,6 +23,8 @@ var _ = Describe("Scheduler", func() {
 		fakeInputMapper  *inputmapperfakes.FakeInputMapper
 		fakeBuildStarter *schedulerfakes.FakeBuildStarter
 		fakeScanner      *schedulerfakes.FakeScanner
+		fakeClock        *fakeclock.FakeClock
+		fakeBuildQueue   *dbfakes.FakeBuildQueue
 
 		scheduler *Scheduler
 
@@ -32,12 +36,16 @@ var _ = Describe("Scheduler", func() {
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
 		fakeBuildStarter = new(schedulerfakes.FakeBuildStarter)
 		fakeScanner = new(schedulerfakes.FakeScanner)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2018, 1, 1, 10, 0, 30, 0, time.UTC))
+		fakeBuildQueue = new(dbfakes.FakeBuildQueue)
 
 		scheduler = &Scheduler{
 			Pipeline:     fakePipeline,
 			InputMapper:  fakeInputMapper,
 			BuildStarter: fakeBuildStarter,
 			Scanner:      fakeScanner,
+			Clock:        fakeClock,
+			BuildQueue:   fakeBuildQueue,
 		}
 
 		disaster = errors.New("bad thing")
@@ -53,6 +61,7 @@ var _ = Describe("Scheduler", func() {
 			nextPendingBuilds      []db.Build
 			nextPendingBuildsJob1  []db.Build
 			nextPendingBuildsJob2  []db.Build
+			queueUsage             *db.QueueUsage
 			scheduleErr            error
 			versionedResourceTypes atc.VersionedResourceTypes
 		)
@@ -67,6 +76,9 @@ var _ = Describe("Scheduler", func() {
 				"some-job-2": nextPendingBuildsJob2,
 			}, nil)
 
+			queueUsage = new(db.QueueUsage)
+			fakeBuildQueue.UsageReturns(queueUsage, nil)
+
 			versionedResourceTypes = atc.VersionedResourceTypes{
 				{
 					ResourceType: atc.ResourceType{Name: "some-resource-type"},
@@ -94,6 +106,123 @@ var _ = Describe("Scheduler", func() {
 			}
 		})
 
+		Context("when the job has a schedule", func() {
+			BeforeEach(func() {
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.IDReturns(1)
+				fakeJob.NameReturns("some-job")
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Name:     "some-job",
+					Schedule: &atc.JobSchedule{Cron: "*/15 * * * *"},
+				})
+				fakeJob.CreateScheduledBuildReturns(new(dbfakes.FakeBuild), true, nil)
+
+				fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+
+				fakeJobs = []db.Job{fakeJob}
+			})
+
+			Context("when the job has never been scheduled", func() {
+				It("starts evaluating the schedule from now", func() {
+					Expect(fakeJob.InitializeLastScheduledCallCount()).To(Equal(1))
+					Expect(fakeJob.InitializeLastScheduledArgsForCall(0)).To(Equal(fakeClock.Now()))
+				})
+
+				It("does not create a build", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+				})
+
+				Context("when initializing fails", func() {
+					BeforeEach(func() {
+						fakeJob.InitializeLastScheduledReturns(disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(disaster))
+					})
+				})
+			})
+
+			Context("when the next activation has not been reached", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC))
+				})
+
+				It("does not create a build", func() {
+					Expect(fakeJob.InitializeLastScheduledCallCount()).To(BeZero())
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the next activation has been reached", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(time.Date(2018, 1, 1, 9, 45, 0, 0, time.UTC))
+				})
+
+				It("creates a build for it", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+
+					from, to := fakeJob.CreateScheduledBuildArgsForCall(0)
+					Expect(from).To(Equal(time.Date(2018, 1, 1, 9, 45, 0, 0, time.UTC)))
+					Expect(to).To(Equal(time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC)))
+				})
+
+				Context("when the job is paused", func() {
+					BeforeEach(func() {
+						fakeJob.PausedReturns(true)
+					})
+
+					It("does not create a build", func() {
+						Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when creating the build fails", func() {
+					BeforeEach(func() {
+						fakeJob.CreateScheduledBuildReturns(nil, false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(disaster))
+					})
+				})
+
+				Context("when the schedule has a jitter", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name:     "some-job",
+							Schedule: &atc.JobSchedule{Cron: "*/15 * * * *", Jitter: "10m"},
+						})
+					})
+
+					It("waits until the jitter has elapsed", func() {
+						Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+
+						fakeClock.Increment(10 * time.Minute)
+
+						_, err := scheduler.Schedule(lagertest.NewTestLogger("test"), versionsDB, fakeJobs, db.Resources{fakeResource}, versionedResourceTypes)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when several activations were missed", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(time.Date(2018, 1, 1, 8, 0, 0, 0, time.UTC))
+				})
+
+				It("creates a single build for the latest one", func() {
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+
+					from, to := fakeJob.CreateScheduledBuildArgsForCall(0)
+					Expect(from).To(Equal(time.Date(2018, 1, 1, 8, 0, 0, 0, time.UTC)))
+					Expect(to).To(Equal(time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC)))
+				})
+			})
+		})
+
 		Context("when the job has no inputs", func() {
 			BeforeEach(func() {
 				fakeJob = new(dbfakes.FakeJob)
@@ -142,11 +271,12 @@ var _ = Describe("Scheduler", func() {
 
 					It("started all pending builds for the right job", func() {
 						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
-						_, actualJob, actualResources, actualResourceTypes, actualPendingBuilds := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+						_, actualJob, actualResources, actualResourceTypes, actualPendingBuilds, actualQueueUsage := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
 						Expect(actualJob.Name()).To(Equal(fakeJob.Name()))
 						Expect(actualResources).To(Equal(db.Resources{fakeResource}))
 						Expect(actualResourceTypes).To(Equal(versionedResourceTypes))
 						Expect(actualPendingBuilds).To(Equal(nextPendingBuildsJob1))
+						Expect(actualQueueUsage).To(BeIdenticalTo(queueUsage))
 					})
 				})
 
@@ -159,6 +289,15 @@ var _ = Describe("Scheduler", func() {
 						Expect(scheduleErr).NotTo(HaveOccurred())
 					})
 
+					It("counted the build queue usage once for every job", func() {
+						Expect(fakeBuildQueue.UsageCallCount()).To(Equal(1))
+						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(2))
+						_, _, _, _, _, usage1 := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+						_, _, _, _, _, usage2 := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(1)
+						Expect(usage1).To(BeIdenticalTo(queueUsage))
+						Expect(usage2).To(BeIdenticalTo(queueUsage))
+					})
+
 					It("didn't create a pending build", func() {
 						//TODO: create a positive test case for this
 						Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(BeZero())
@@ -257,11 +396,14 @@ var _ = Describe("Scheduler", func() {
 		var (
 			fakeJob           *dbfakes.FakeJob
 			fakeResource      *dbfakes.FakeResource
+			overrides         atc.InputOverrides
 			triggerErr        error
 			nextPendingBuilds []db.Build
 		)
 
 		BeforeEach(func() {
+			overrides = atc.InputOverrides{}
+
 			fakeJob = new(dbfakes.FakeJob)
 			fakeJob.NameReturns("some-job")
 			fakeJob.ConfigReturns(atc.JobConfig{Plan: atc.PlanSequence{{Get: "input-1"}, {Get: "input-2"}}})
@@ -282,6 +424,7 @@ var _ = Describe("Scheduler", func() {
 						Version:      atc.Version{"some": "version"},
 					},
 				},
+				overrides,
 			)
 			if waiter != nil {
 				waiter.Wait()
@@ -311,6 +454,22 @@ var _ = Describe("Scheduler", func() {
 				Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
 			})
 
+			Context("when input versions are overridden", func() {
+				BeforeEach(func() {
+					overrides = atc.InputOverrides{
+						Inputs: map[string]atc.Version{"input-1": {"ref": "v1"}},
+					}
+
+					fakeJob.CreateBuildWithInputOverridesReturns(createdBuild, nil)
+				})
+
+				It("creates a build with the overrides", func() {
+					Expect(fakeJob.CreateBuildCallCount()).To(Equal(0))
+					Expect(fakeJob.CreateBuildWithInputOverridesCallCount()).To(Equal(1))
+					Expect(fakeJob.CreateBuildWithInputOverridesArgsForCall(0)).To(Equal(overrides))
+				})
+			})
+
 			Context("when get pending builds for job fails", func() {
 				BeforeEach(func() {
 					fakeJob.GetPendingBuildsReturns(nil, disaster)
@@ -338,7 +497,7 @@ var _ = Describe("Scheduler", func() {
 
 					It("tries to start builds for the right job", func() {
 						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
-						_, _, _, _, b := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+						_, _, _, _, b, _ := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
 						Expect(b).To(Equal(nextPendingBuilds))
 					})
 				})
@@ -346,6 +505,78 @@ var _ = Describe("Scheduler", func() {
 		})
 	})
 
+	Describe("RerunImmediately", func() {
+		var (
+			fakeJob          *dbfakes.FakeJob
+			fakeBuildToRerun *dbfakes.FakeBuild
+			rerunBuild       db.Build
+			rerunErr         error
+		)
+
+		BeforeEach(func() {
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+
+			fakeBuildToRerun = new(dbfakes.FakeBuild)
+			fakeBuildToRerun.NameReturns("42")
+		})
+
+		JustBeforeEach(func() {
+			var waiter Waiter
+			rerunBuild, waiter, rerunErr = scheduler.RerunImmediately(
+				lagertest.NewTestLogger("test"),
+				fakeJob,
+				fakeBuildToRerun,
+				db.Resources{},
+				atc.VersionedResourceTypes{},
+			)
+			if waiter != nil {
+				waiter.Wait()
+			}
+		})
+
+		Context("when creating the rerun build fails", func() {
+			BeforeEach(func() {
+				fakeJob.RerunBuildReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(rerunErr).To(Equal(disaster))
+			})
+
+			It("does not try to start pending builds for job", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the rerun build succeeds", func() {
+			var createdBuild *dbfakes.FakeBuild
+
+			BeforeEach(func() {
+				createdBuild = new(dbfakes.FakeBuild)
+				fakeJob.RerunBuildReturns(createdBuild, nil)
+
+				fakeJob.GetPendingBuildsReturns([]db.Build{createdBuild}, nil)
+			})
+
+			It("reruns the given build", func() {
+				Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+				Expect(fakeJob.RerunBuildArgsForCall(0)).To(Equal(fakeBuildToRerun))
+			})
+
+			It("returns the rerun build", func() {
+				Expect(rerunErr).NotTo(HaveOccurred())
+				Expect(rerunBuild).To(Equal(createdBuild))
+			})
+
+			It("tries to start pending builds for the job", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+				_, _, _, _, b, _ := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+				Expect(b).To(Equal([]db.Build{createdBuild}))
+			})
+		})
+	})
+
 	Describe("SaveNextInputMapping", func() {
 		var saveErr error
 		var fakeJob *dbfakes.FakeJob
@@ -13,6 +13,25 @@ import (
 )
 
 type FakeBuildScheduler struct {
+	RerunImmediatelyStub        func(lager.Logger, db.Job, db.Build, db.Resources, atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error)
+	rerunImmediatelyMutex       sync.RWMutex
+	rerunImmediatelyArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.Job
+		arg3 db.Build
+		arg4 db.Resources
+		arg5 atc.VersionedResourceTypes
+	}
+	rerunImmediatelyReturns struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}
+	rerunImmediatelyReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}
 	SaveNextInputMappingStub        func(lager.Logger, db.Job, db.Resources) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -43,13 +62,14 @@ type FakeBuildScheduler struct {
 		result1 map[string]time.Duration
 		result2 error
 	}
-	TriggerImmediatelyStub        func(lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error)
+	TriggerImmediatelyStub        func(lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, atc.InputOverrides) (db.Build, scheduler.Waiter, error)
 	triggerImmediatelyMutex       sync.RWMutex
 	triggerImmediatelyArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 db.Job
 		arg3 db.Resources
 		arg4 atc.VersionedResourceTypes
+		arg5 atc.InputOverrides
 	}
 	triggerImmediatelyReturns struct {
 		result1 db.Build
@@ -65,6 +85,66 @@ type FakeBuildScheduler struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildScheduler) RerunImmediately(arg1 lager.Logger, arg2 db.Job, arg3 db.Build, arg4 db.Resources, arg5 atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error) {
+	fake.rerunImmediatelyMutex.Lock()
+	ret, specificReturn := fake.rerunImmediatelyReturnsOnCall[len(fake.rerunImmediatelyArgsForCall)]
+	fake.rerunImmediatelyArgsForCall = append(fake.rerunImmediatelyArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.Job
+		arg3 db.Build
+		arg4 db.Resources
+		arg5 atc.VersionedResourceTypes
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("RerunImmediately", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.rerunImmediatelyMutex.Unlock()
+	if fake.RerunImmediatelyStub != nil {
+		return fake.RerunImmediatelyStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.rerunImmediatelyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyCallCount() int {
+	fake.rerunImmediatelyMutex.RLock()
+	defer fake.rerunImmediatelyMutex.RUnlock()
+	return len(fake.rerunImmediatelyArgsForCall)
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyArgsForCall(i int) (lager.Logger, db.Job, db.Build, db.Resources, atc.VersionedResourceTypes) {
+	fake.rerunImmediatelyMutex.RLock()
+	defer fake.rerunImmediatelyMutex.RUnlock()
+	argsForCall := fake.rerunImmediatelyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyReturns(result1 db.Build, result2 scheduler.Waiter, result3 error) {
+	fake.RerunImmediatelyStub = nil
+	fake.rerunImmediatelyReturns = struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildScheduler) RerunImmediatelyReturnsOnCall(i int, result1 db.Build, result2 scheduler.Waiter, result3 error) {
+	fake.RerunImmediatelyStub = nil
+	if fake.rerunImmediatelyReturnsOnCall == nil {
+		fake.rerunImmediatelyReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 scheduler.Waiter
+			result3 error
+		})
+	}
+	fake.rerunImmediatelyReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 scheduler.Waiter
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuildScheduler) SaveNextInputMapping(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -179,7 +259,7 @@ func (fake *FakeBuildScheduler) ScheduleReturnsOnCall(i int, result1 map[string]
 	}{result1, result2}
 }
 
-func (fake *FakeBuildScheduler) TriggerImmediately(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources, arg4 atc.VersionedResourceTypes) (db.Build, scheduler.Waiter, error) {
+func (fake *FakeBuildScheduler) TriggerImmediately(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources, arg4 atc.VersionedResourceTypes, arg5 atc.InputOverrides) (db.Build, scheduler.Waiter, error) {
 	fake.triggerImmediatelyMutex.Lock()
 	ret, specificReturn := fake.triggerImmediatelyReturnsOnCall[len(fake.triggerImmediatelyArgsForCall)]
 	fake.triggerImmediatelyArgsForCall = append(fake.triggerImmediatelyArgsForCall, struct {
@@ -187,11 +267,12 @@ func (fake *FakeBuildScheduler) TriggerImmediately(arg1 lager.Logger, arg2 db.Jo
 		arg2 db.Job
 		arg3 db.Resources
 		arg4 atc.VersionedResourceTypes
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("TriggerImmediately", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 atc.InputOverrides
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("TriggerImmediately", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.triggerImmediatelyMutex.Unlock()
 	if fake.TriggerImmediatelyStub != nil {
-		return fake.TriggerImmediatelyStub(arg1, arg2, arg3, arg4)
+		return fake.TriggerImmediatelyStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -206,11 +287,11 @@ func (fake *FakeBuildScheduler) TriggerImmediatelyCallCount() int {
 	return len(fake.triggerImmediatelyArgsForCall)
 }
 
-func (fake *FakeBuildScheduler) TriggerImmediatelyArgsForCall(i int) (lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes) {
+func (fake *FakeBuildScheduler) TriggerImmediatelyArgsForCall(i int) (lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, atc.InputOverrides) {
 	fake.triggerImmediatelyMutex.RLock()
 	defer fake.triggerImmediatelyMutex.RUnlock()
 	argsForCall := fake.triggerImmediatelyArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeBuildScheduler) TriggerImmediatelyReturns(result1 db.Build, result2 scheduler.Waiter, result3 error) {
@@ -241,6 +322,8 @@ func (fake *FakeBuildScheduler) TriggerImmediatelyReturnsOnCall(i int, result1 d
 func (fake *FakeBuildScheduler) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.rerunImmediatelyMutex.RLock()
+	defer fake.rerunImmediatelyMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleMutex.RLock()
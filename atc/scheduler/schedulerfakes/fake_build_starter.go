@@ -11,7 +11,7 @@ import (
 )
 
 type FakeBuildStarter struct {
-	TryStartPendingBuildsForJobStub        func(lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) error
+	TryStartPendingBuildsForJobStub        func(lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build, *db.QueueUsage) error
 	tryStartPendingBuildsForJobMutex       sync.RWMutex
 	tryStartPendingBuildsForJobArgsForCall []struct {
 		arg1 lager.Logger
@@ -19,6 +19,7 @@ type FakeBuildStarter struct {
 		arg3 db.Resources
 		arg4 atc.VersionedResourceTypes
 		arg5 []db.Build
+		arg6 *db.QueueUsage
 	}
 	tryStartPendingBuildsForJobReturns struct {
 		result1 error
@@ -30,7 +31,7 @@ type FakeBuildStarter struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBuildStarter) TryStartPendingBuildsForJob(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources, arg4 atc.VersionedResourceTypes, arg5 []db.Build) error {
+func (fake *FakeBuildStarter) TryStartPendingBuildsForJob(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources, arg4 atc.VersionedResourceTypes, arg5 []db.Build, arg6 *db.QueueUsage) error {
 	var arg5Copy []db.Build
 	if arg5 != nil {
 		arg5Copy = make([]db.Build, len(arg5))
@@ -44,11 +45,12 @@ func (fake *FakeBuildStarter) TryStartPendingBuildsForJob(arg1 lager.Logger, arg
 		arg3 db.Resources
 		arg4 atc.VersionedResourceTypes
 		arg5 []db.Build
-	}{arg1, arg2, arg3, arg4, arg5Copy})
-	fake.recordInvocation("TryStartPendingBuildsForJob", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
+		arg6 *db.QueueUsage
+	}{arg1, arg2, arg3, arg4, arg5Copy, arg6})
+	fake.recordInvocation("TryStartPendingBuildsForJob", []interface{}{arg1, arg2, arg3, arg4, arg5Copy, arg6})
 	fake.tryStartPendingBuildsForJobMutex.Unlock()
 	if fake.TryStartPendingBuildsForJobStub != nil {
-		return fake.TryStartPendingBuildsForJobStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.TryStartPendingBuildsForJobStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1
@@ -63,11 +65,11 @@ func (fake *FakeBuildStarter) TryStartPendingBuildsForJobCallCount() int {
 	return len(fake.tryStartPendingBuildsForJobArgsForCall)
 }
 
-func (fake *FakeBuildStarter) TryStartPendingBuildsForJobArgsForCall(i int) (lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) {
+func (fake *FakeBuildStarter) TryStartPendingBuildsForJobArgsForCall(i int) (lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build, *db.QueueUsage) {
 	fake.tryStartPendingBuildsForJobMutex.RLock()
 	defer fake.tryStartPendingBuildsForJobMutex.RUnlock()
 	argsForCall := fake.tryStartPendingBuildsForJobArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeBuildStarter) TryStartPendingBuildsForJobReturns(result1 error) {
@@ -4,6 +4,16 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// MaxConcurrentBuilds limits how many of the team's builds may be running
+	// at once. Zero means no limit. When setting a team, nil leaves the
+	// current limit as it is.
+	MaxConcurrentBuilds *int `json:"max_concurrent_builds,omitempty"`
+
+	// BuildWeight is the team's share of the build queue relative to other
+	// teams when the cluster is at capacity. Zero means the default of 1. When
+	// setting a team, nil leaves the current weight as it is.
+	BuildWeight *int `json:"build_weight,omitempty"`
 }
 
 type TeamAuth map[string]map[string][]string
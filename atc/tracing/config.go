@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/trace/jaeger"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type Config struct {
+	ServiceName string            `long:"service-name" default:"concourse-web" description:"Service name to attach to the spans."`
+	Attributes  map[string]string `long:"attribute" description:"Attribute to attach to every span, e.g. the name of the cluster. Can be specified multiple times." value-name:"NAME:VALUE"`
+
+	OTLP struct {
+		Address  string            `long:"otlp-address" description:"Address of an OTLP collector to export spans to, e.g. localhost:55680."`
+		Headers  map[string]string `long:"otlp-header" description:"Header to attach to every request to the OTLP collector. Can be specified multiple times." value-name:"NAME:VALUE"`
+		Insecure bool              `long:"otlp-insecure" description:"Connect to the OTLP collector without TLS."`
+	}
+
+	Jaeger struct {
+		Endpoint string `long:"jaeger-endpoint" description:"URL of a Jaeger collector to export spans to, e.g. http://localhost:14268/api/traces."`
+	}
+}
+
+func (config Config) IsConfigured() bool {
+	return config.OTLP.Address != "" || config.Jaeger.Endpoint != ""
+}
+
+func (config Config) Validate() error {
+	if config.OTLP.Address != "" && config.Jaeger.Endpoint != "" {
+		return errors.New("tracing is misconfigured, cannot export spans to both OTLP and Jaeger")
+	}
+
+	return nil
+}
+
+// Prepare configures the exporter and turns tracing on. It does nothing if no
+// exporter is configured.
+func (config Config) Prepare() error {
+	if !config.IsConfigured() {
+		return nil
+	}
+
+	err := config.Validate()
+	if err != nil {
+		return err
+	}
+
+	exporter, err := config.exporter()
+	if err != nil {
+		return err
+	}
+
+	attributes := []kv.KeyValue{kv.String("service.name", config.ServiceName)}
+	attributes = append(attributes, keyValues(config.Attributes)...)
+
+	provider, err := sdktrace.NewProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.AlwaysSample()}),
+		sdktrace.WithResource(resource.New(attributes...)),
+		exporter,
+	)
+	if err != nil {
+		return err
+	}
+
+	ConfigureTraceProvider(provider)
+
+	return nil
+}
+
+func (config Config) exporter() (sdktrace.ProviderOption, error) {
+	if config.Jaeger.Endpoint != "" {
+		// the Jaeger exporter does its own batching
+		exporter, err := jaeger.NewRawExporter(
+			jaeger.WithCollectorEndpoint(config.Jaeger.Endpoint),
+			jaeger.WithProcess(jaeger.Process{
+				ServiceName: config.ServiceName,
+			}),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return sdktrace.WithSyncer(exporter), nil
+	}
+
+	options := []otlp.ExporterOption{
+		otlp.WithAddress(config.OTLP.Address),
+		otlp.WithHeaders(config.OTLP.Headers),
+	}
+
+	if config.OTLP.Insecure {
+		options = append(options, otlp.WithInsecure())
+	}
+
+	exporter, err := otlp.NewExporter(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.WithBatcher(exporter), nil
+}
@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/trace"
+	"google.golang.org/grpc/codes"
+)
+
+// Configured indicates whether tracing has been configured. When it is not,
+// StartSpan is a no-op, so that tracing costs nothing when it is turned off.
+var Configured bool
+
+// Attrs are the attributes attached to a span, e.g. the team and pipeline of
+// a build.
+type Attrs map[string]string
+
+// ConfigureTraceProvider sets the provider used by StartSpan and turns
+// tracing on.
+func ConfigureTraceProvider(tp trace.Provider) {
+	global.SetTraceProvider(tp)
+	Configured = true
+}
+
+// StartSpan starts a span as a child of whichever span is in ctx, returning a
+// context containing the new span. The span must be ended with End.
+func StartSpan(ctx context.Context, component string, attrs Attrs) (context.Context, trace.Span) {
+	if !Configured {
+		return ctx, trace.NoopSpan{}
+	}
+
+	return global.TraceProvider().Tracer("concourse").Start(
+		ctx,
+		component,
+		trace.WithAttributes(keyValues(attrs)...),
+	)
+}
+
+// End ends the span, marking it as failed if err is non-nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(context.Background(), err, trace.WithErrorStatus(codes.Internal))
+	}
+
+	span.End()
+}
+
+// Env returns the trace context of the span in ctx as environment variables,
+// (e.g. TRACEPARENT) so that processes running in containers can attach their
+// own spans to the build.
+func Env(ctx context.Context) []string {
+	if !Configured {
+		return nil
+	}
+
+	carrier := envCarrier{}
+	trace.TraceContext{}.Inject(ctx, carrier)
+
+	env := []string{}
+	for k, v := range carrier {
+		env = append(env, k+"="+v)
+	}
+
+	return env
+}
+
+type envCarrier map[string]string
+
+func (carrier envCarrier) Get(key string) string {
+	return carrier[strings.ToUpper(key)]
+}
+
+func (carrier envCarrier) Set(key string, value string) {
+	carrier[strings.ToUpper(key)] = value
+}
+
+func keyValues(attrs Attrs) []kv.KeyValue {
+	keyValues := []kv.KeyValue{}
+	for k, v := range attrs {
+		keyValues = append(keyValues, kv.String(k, v))
+	}
+
+	return keyValues
+}
@@ -0,0 +1,105 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/tracing/tracetest"
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/kv/value"
+	"go.opentelemetry.io/otel/api/trace"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracer", func() {
+	var provider *tracetest.Provider
+
+	BeforeEach(func() {
+		provider = tracetest.NewProvider()
+	})
+
+	AfterEach(func() {
+		tracing.Configured = false
+	})
+
+	Describe("StartSpan", func() {
+		Context("when tracing is not configured", func() {
+			It("returns a no-op span", func() {
+				ctx, span := tracing.StartSpan(context.Background(), "build", nil)
+				Expect(span).To(Equal(trace.NoopSpan{}))
+				Expect(ctx).To(Equal(context.Background()))
+			})
+		})
+
+		Context("when tracing is configured", func() {
+			BeforeEach(func() {
+				tracing.ConfigureTraceProvider(provider)
+			})
+
+			It("starts a span with the attributes", func() {
+				_, span := tracing.StartSpan(context.Background(), "build", tracing.Attrs{
+					"team": "some-team",
+				})
+				span.End()
+
+				spans := provider.Spans()
+				Expect(spans).To(HaveLen(1))
+				Expect(spans[0].Name()).To(Equal("build"))
+				Expect(spans[0].Attributes()).To(HaveKeyWithValue(kv.Key("team"), value.String("some-team")))
+			})
+
+			It("starts child spans from the context", func() {
+				ctx, parent := tracing.StartSpan(context.Background(), "build", nil)
+				_, child := tracing.StartSpan(ctx, "task", nil)
+
+				Expect(child.SpanContext().TraceID).To(Equal(parent.SpanContext().TraceID))
+				Expect(provider.Spans()[1].ParentSpanID()).To(Equal(parent.SpanContext().SpanID))
+			})
+		})
+	})
+
+	Describe("End", func() {
+		BeforeEach(func() {
+			tracing.ConfigureTraceProvider(provider)
+		})
+
+		It("marks the span as failed when there is an error", func() {
+			_, span := tracing.StartSpan(context.Background(), "task", nil)
+			tracing.End(span, errors.New("nope"))
+
+			spans := provider.Spans()
+			Expect(spans[0].Ended()).To(BeTrue())
+			Expect(spans[0].StatusCode()).To(Equal(codes.Internal))
+		})
+
+		It("leaves the span alone when there is no error", func() {
+			_, span := tracing.StartSpan(context.Background(), "task", nil)
+			tracing.End(span, nil)
+
+			spans := provider.Spans()
+			Expect(spans[0].Ended()).To(BeTrue())
+			Expect(spans[0].StatusCode()).To(Equal(codes.OK))
+		})
+	})
+
+	Describe("Env", func() {
+		It("returns nothing when tracing is not configured", func() {
+			Expect(tracing.Env(context.Background())).To(BeEmpty())
+		})
+
+		It("returns the trace context of the span", func() {
+			tracing.ConfigureTraceProvider(provider)
+
+			ctx, span := tracing.StartSpan(context.Background(), "task", nil)
+
+			sc := span.SpanContext()
+			Expect(tracing.Env(ctx)).To(ConsistOf(
+				"TRACEPARENT=00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-00",
+			))
+		})
+	})
+})
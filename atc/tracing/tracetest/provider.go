@@ -0,0 +1,27 @@
+// Package tracetest provides a trace provider which records spans in memory,
+// for asserting on them in tests.
+package tracetest
+
+import (
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/api/trace/testtrace"
+)
+
+type Provider struct {
+	tracer *testtrace.Tracer
+}
+
+func NewProvider() *Provider {
+	return &Provider{
+		tracer: testtrace.NewTracer(),
+	}
+}
+
+func (provider *Provider) Tracer(string) trace.Tracer {
+	return provider.tracer
+}
+
+// Spans returns the spans which have been started so far.
+func (provider *Provider) Spans() []*testtrace.Span {
+	return provider.tracer.Spans()
+}
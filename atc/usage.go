@@ -0,0 +1,34 @@
+package atc
+
+const (
+	UsageQueryFrom    = "from"
+	UsageQueryTo      = "to"
+	UsageQueryGroupBy = "group_by"
+)
+
+// TeamUsage is the amount of worker resources a team consumed between From
+// and To, given as unix timestamps.
+type TeamUsage struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+
+	Total     Usage   `json:"total"`
+	Breakdown []Usage `json:"breakdown,omitempty"`
+}
+
+type Usage struct {
+	PipelineName string `json:"pipeline_name,omitempty"`
+	JobName      string `json:"job_name,omitempty"`
+
+	Containers        int     `json:"containers"`
+	ContainerSeconds  float64 `json:"container_seconds"`
+	CPUShareSeconds   float64 `json:"cpu_share_seconds"`
+	MemoryByteSeconds float64 `json:"memory_byte_seconds"`
+
+	Volumes       int     `json:"volumes"`
+	VolumeSeconds float64 `json:"volume_seconds"`
+
+	// VolumeBytes is the most disk space the volumes took up at any one time.
+	VolumeBytes       int64   `json:"volume_bytes"`
+	VolumeByteSeconds float64 `json:"volume_byte_seconds"`
+}
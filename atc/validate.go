@@ -3,9 +3,12 @@ package atc
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/concourse/concourse/atc/cron"
 )
 
 func formatErr(groupName string, err error) string {
@@ -49,6 +52,11 @@ func (c Config) Validate() ([]Warning, []string) {
 	}
 	warnings = append(warnings, jobWarnings...)
 
+	varSourcesErr := validateVarSources(c)
+	if varSourcesErr != nil {
+		errorMessages = append(errorMessages, formatErr("var sources", varSourcesErr))
+	}
+
 	return warnings, errorMessages
 }
 
@@ -161,6 +169,46 @@ func validateResourceTypes(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+// varSourceNameRegex matches the names which may be used as the source of a
+// ((source:path)) var reference.
+var varSourceNameRegex = regexp.MustCompile(`\A[-\w\pL]+\z`)
+
+func validateVarSources(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, source := range c.VarSources {
+		var identifier string
+		if source.Name == "" {
+			identifier = fmt.Sprintf("var_sources[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("var_sources.%s", source.Name)
+		}
+
+		if other, exists := names[source.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"var_sources[%d] and var_sources[%d] have the same name ('%s')",
+					other, i, source.Name))
+		} else if source.Name != "" {
+			names[source.Name] = i
+		}
+
+		if source.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if !varSourceNameRegex.MatchString(source.Name) {
+			errorMessages = append(errorMessages, identifier+" has an invalid name; only letters, numbers, '-' and '_' are allowed")
+		}
+
+		if source.Type == "" {
+			errorMessages = append(errorMessages, identifier+" has no type")
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
 func validateResourcesUnused(c Config) []string {
 	usedResources := usedResources(c)
 
@@ -217,11 +265,12 @@ func validateJobs(c Config) ([]Warning, error) {
 			errorMessages = append(errorMessages, identifier+" has no name")
 		}
 
-		if job.BuildLogsToRetain < 0 {
-			errorMessages = append(
-				errorMessages,
-				identifier+fmt.Sprintf(" has negative build_logs_to_retain: %d", job.BuildLogsToRetain),
-			)
+		if job.BuildLogsToRetain != nil {
+			errorMessages = append(errorMessages, validateBuildLogRetention(identifier, *job.BuildLogsToRetain)...)
+		}
+
+		if job.Schedule != nil {
+			errorMessages = append(errorMessages, validateJobSchedule(identifier+".schedule", *job.Schedule)...)
 		}
 
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
@@ -272,6 +321,49 @@ func validateJobs(c Config) ([]Warning, error) {
 	return warnings, compositeErr(errorMessages)
 }
 
+func validateBuildLogRetention(identifier string, retention BuildLogRetention) []string {
+	errorMessages := []string{}
+
+	if retention.Builds < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has negative build_logs_to_retain: %d", retention.Builds))
+	}
+
+	if retention.Days < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has negative build_logs_to_retain.days: %d", retention.Days))
+	}
+
+	if retention.MinimumSucceededBuilds < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has negative build_logs_to_retain.minimum_succeeded_builds: %d", retention.MinimumSucceededBuilds))
+	}
+
+	return errorMessages
+}
+
+func validateJobSchedule(identifier string, schedule JobSchedule) []string {
+	errorMessages := []string{}
+
+	if schedule.Location != "" {
+		_, err := time.LoadLocation(schedule.Location)
+		if err != nil {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown location ('%s')", schedule.Location))
+		}
+	}
+
+	_, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an invalid cron expression: %s", err))
+	}
+
+	jitter, err := schedule.JitterDuration()
+	if err != nil {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" refers to a jitter that could not be parsed ('%s')", schedule.Jitter))
+	} else if jitter < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has a negative jitter ('%s')", schedule.Jitter))
+	}
+
+	return errorMessages
+}
+
 type foundTypes struct {
 	identifier string
 	found      map[string]bool
@@ -327,10 +419,22 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		foundTypes.Find("aggregate")
 	}
 
+	if plan.InParallel != nil {
+		foundTypes.Find("in_parallel")
+	}
+
 	if plan.Try != nil {
 		foundTypes.Find("try")
 	}
 
+	if plan.SetPipeline != "" {
+		foundTypes.Find("set_pipeline")
+	}
+
+	if plan.LoadVar != "" {
+		foundTypes.Find("load_var")
+	}
+
 	if valid, message := foundTypes.IsValid(); !valid {
 		return []Warning{}, []string{message}
 	}
@@ -355,6 +459,18 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
+	case plan.InParallel != nil:
+		for i, plan := range plan.InParallel.Steps {
+			subIdentifier := fmt.Sprintf("%s.in_parallel[%d]", identifier, i)
+			planWarnings, planErrMessages := validatePlan(c, subIdentifier, plan)
+			warnings = append(warnings, planWarnings...)
+			errorMessages = append(errorMessages, planErrMessages...)
+		}
+
+		if plan.InParallel.Limit < 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.in_parallel has an invalid limit (%d)", identifier, plan.InParallel.Limit))
+		}
+
 	case plan.Get != "":
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
@@ -495,6 +611,36 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 			plan, identifier)...,
 		)
 
+	case plan.SetPipeline != "":
+		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any pipeline configuration file")
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config"},
+			plan, identifier)...,
+		)
+
+	case plan.LoadVar != "":
+		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any file")
+		}
+
+		switch plan.Format {
+		case "", "raw", "trim", "json", "yaml", "yml":
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown format '%s'", identifier, plan.Format))
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config"},
+			plan, identifier)...,
+		)
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -543,9 +689,66 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	errorMessages = append(errorMessages, validateAcross(identifier, plan)...)
+	errorMessages = append(errorMessages, validatePlacement(identifier, plan)...)
+
 	return warnings, errorMessages
 }
 
+func validatePlacement(identifier string, plan PlanConfig) []string {
+	errorMessages := []string{}
+
+	if len(plan.Placement) > 0 && plan.Get == "" && plan.Put == "" && plan.Task == "" {
+		errorMessages = append(errorMessages, identifier+" has placement constraints but is not a get, put or task step")
+	}
+
+	for i, constraint := range plan.Placement {
+		err := constraint.Validate()
+		if err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.placement[%d] %s", identifier, i, err))
+		}
+	}
+
+	return errorMessages
+}
+
+var acrossValuesVarRegex = regexp.MustCompile(`\A\(\([-/\.\w\pL]+\)\)\z`)
+
+func validateAcross(identifier string, plan PlanConfig) []string {
+	errorMessages := []string{}
+
+	seen := map[string]bool{}
+	for i, acrossVar := range plan.Across {
+		subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+		if acrossVar.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" has no var specified")
+		} else if seen[acrossVar.Var] {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s repeats var '%s'", subIdentifier, acrossVar.Var))
+		}
+
+		seen[acrossVar.Var] = true
+
+		switch values := acrossVar.Values.(type) {
+		case []interface{}:
+		case string:
+			if !acrossValuesVarRegex.MatchString(values) {
+				errorMessages = append(errorMessages, subIdentifier+" has values which are neither a list nor a ((var))")
+			}
+		case nil:
+			errorMessages = append(errorMessages, subIdentifier+" has no values specified")
+		default:
+			errorMessages = append(errorMessages, subIdentifier+" has values which are neither a list nor a ((var))")
+		}
+
+		if acrossVar.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid max_in_flight (%d)", subIdentifier, acrossVar.MaxInFlight))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	errorMessages := []string{}
 	foundInapplicableFields := []string{}
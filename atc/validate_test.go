@@ -423,6 +423,57 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("invalid var sources", func() {
+		BeforeEach(func() {
+			config.VarSources = VarSourceConfigs{
+				{
+					Name:   "some-vault",
+					Type:   "vault",
+					Config: map[string]interface{}{"url": "https://vault.example.com"},
+				},
+			}
+		})
+
+		It("accepts a valid var source", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+
+		Context("when a var source has no name or type", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{})
+			})
+
+			It("returns an error describing both errors", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid var sources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[1] has no name"))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[1] has no type"))
+			})
+		})
+
+		Context("when a var source's name cannot be referenced", func() {
+			BeforeEach(func() {
+				config.VarSources[0].Name = "some.vault"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources.some.vault has an invalid name"))
+			})
+		})
+
+		Context("when two var sources have the same name", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, config.VarSources...)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("var_sources[0] and var_sources[1] have the same name ('some-vault')"))
+			})
+		})
+	})
+
 	Describe("validating a job", func() {
 		var job JobConfig
 
@@ -448,7 +499,7 @@ var _ = Describe("ValidateConfig", func() {
 
 		Context("when a job has a negative build_logs_to_retain", func() {
 			BeforeEach(func() {
-				job.BuildLogsToRetain = -1
+				job.BuildLogsToRetain = &BuildLogRetention{Builds: -1}
 				config.Jobs = append(config.Jobs, job)
 			})
 
@@ -459,6 +510,52 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job retains build logs for a negative number of days", func() {
+			BeforeEach(func() {
+				job.BuildLogsToRetain = &BuildLogRetention{Days: -1}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has negative build_logs_to_retain.days: -1"))
+			})
+		})
+
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				job.Schedule = &JobSchedule{
+					Cron:     "0 9 * * mon-fri",
+					Location: "America/New_York",
+					Jitter:   "5m",
+				}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid schedule", func() {
+			BeforeEach(func() {
+				job.Schedule = &JobSchedule{
+					Cron:     "0 25 * * *",
+					Location: "Nowhere/Special",
+					Jitter:   "bogus",
+				}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule has an unknown location ('Nowhere/Special')"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule has an invalid cron expression: invalid hour: value 25 out of range 0-23"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule refers to a jitter that could not be parsed ('bogus')"))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -541,6 +638,31 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has duplicate inputs via in_parallel", func() {
+			BeforeEach(func() {
+				job.Plan = append(job.Plan, PlanConfig{
+					Get: "some-resource",
+				})
+				job.Plan = append(job.Plan, PlanConfig{
+					InParallel: &InParallelConfig{
+						Steps: PlanSequence{
+							{
+								Get: "some-resource",
+							},
+						},
+					},
+				})
+
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns a single error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(strings.Count(errorMessages[0], "has get steps with the same name: some-resource")).To(Equal(1))
+			})
+		})
+
 		Describe("plans", func() {
 			Context("when multiple actions are specified in the same plan", func() {
 				Context("when it's not just Get and Put", func() {
@@ -696,6 +818,232 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a set_pipeline plan has no file specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline: "some-pipeline",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline does not specify any pipeline configuration file"))
+				})
+			})
+
+			Context("when a set_pipeline plan has invalid fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline:    "some-pipeline",
+						TaskConfigPath: "some-artifact/pipeline.yml",
+						Resource:       "some-resource",
+						Privileged:     true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.some-pipeline has invalid fields specified (resource, privileged)"))
+				})
+			})
+
+			Context("when a load_var plan has no file specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar: "some-var",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var does not specify any file"))
+				})
+			})
+
+			Context("when a load_var plan has an unknown format", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-artifact/version",
+						Format:         "toml",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has an unknown format 'toml'"))
+				})
+			})
+
+			Context("when a load_var plan has invalid fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "some-var",
+						TaskConfigPath: "some-artifact/version",
+						Trigger:        true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.some-var has invalid fields specified (trigger)"))
+				})
+			})
+
+			Context("when a step is run across valid vars", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Across: []AcrossVarConfig{
+							{Var: "go_version", Values: []interface{}{"1.10", "1.11"}, MaxInFlight: 1},
+							{Var: "platform", Values: "((platforms))"},
+						},
+						FailFast: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a step is run across invalid vars", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Across: []AcrossVarConfig{
+							{Values: []interface{}{"a"}},
+							{Var: "go_version", Values: "1.10", MaxInFlight: -1},
+							{Var: "go_version"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[0] has no var specified"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[1] has values which are neither a list nor a ((var))"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[1] has an invalid max_in_flight (-1)"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[2] repeats var 'go_version'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.across[2] has no values specified"))
+				})
+			})
+
+			Context("when a step has valid placement constraints", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Placement: PlacementConstraints{
+							{Key: "memory", Operator: PlacementOperatorIn, Values: []string{"32gb", "64gb"}},
+							{Key: "spot", Operator: PlacementOperatorNotIn, Values: []string{"true"}},
+							{Key: "gpu", Operator: PlacementOperatorExists},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a step has invalid placement constraints", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "some-task",
+						TaskConfigPath: "some-artifact/task.yml",
+						Placement: PlacementConstraints{
+							{Operator: PlacementOperatorExists},
+							{Key: "memory", Operator: PlacementOperatorIn},
+							{Key: "gpu", Operator: PlacementOperatorExists, Values: []string{"nvidia"}},
+							{Key: "os", Operator: "matches", Values: []string{"linux"}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.placement[0] has no key specified"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.placement[1] has no values specified for operator 'in'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.placement[2] has values specified for operator 'exists'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.placement[3] has an unknown operator 'matches'"))
+				})
+			})
+
+			Context("when a step which does not run in a container has placement constraints", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Do: &PlanSequence{
+							{Get: "some-resource"},
+						},
+						Placement: PlacementConstraints{
+							{Key: "gpu", Operator: PlacementOperatorExists},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0] has placement constraints but is not a get, put or task step"))
+				})
+			})
+
+			Context("when an in_parallel plan has invalid steps", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						InParallel: &InParallelConfig{
+							Steps: PlanSequence{
+								{
+									Get: "some-resource",
+								},
+								{
+									Get: "some-nonexistent-resource",
+								},
+							},
+							Limit: -1,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].in_parallel[1].get.some-nonexistent-resource refers to a resource that does not exist"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].in_parallel has an invalid limit (-1)"))
+				})
+			})
+
 			Context("when a put plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -21,14 +21,18 @@ type Worker struct {
 
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
-	Platform  string   `json:"platform"`
-	Tags      []string `json:"tags"`
-	Team      string   `json:"team"`
-	Name      string   `json:"name"`
-	Version   string   `json:"version"`
-	StartTime int64    `json:"start_time"`
-	Ephemeral bool     `json:"ephemeral"`
-	State     string   `json:"state"`
+	Platform  string            `json:"platform"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Team      string            `json:"team"`
+	Name      string            `json:"name"`
+	Version   string            `json:"version"`
+	StartTime int64             `json:"start_time"`
+	Ephemeral bool              `json:"ephemeral"`
+	State     string            `json:"state"`
+
+	QuarantineReason string             `json:"quarantine_reason,omitempty"`
+	Maintenance      *MaintenanceWindow `json:"maintenance,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -56,3 +60,39 @@ type WorkerResourceType struct {
 type PruneWorkerResponseBody struct {
 	Stderr string `json:"stderr"`
 }
+
+const (
+	MaintenanceBehaviourLand   = "land"
+	MaintenanceBehaviourRetire = "retire"
+)
+
+var ErrMissingMaintenanceStart = errors.New("missing maintenance start")
+var ErrInvalidMaintenanceDuration = errors.New("maintenance duration must be positive")
+var ErrInvalidMaintenanceBehaviour = errors.New("maintenance behaviour must be 'land' or 'retire'")
+
+type MaintenanceWindow struct {
+	Start     int64  `json:"start"`
+	Duration  int64  `json:"duration"`
+	Behaviour string `json:"behaviour"`
+}
+
+func (w MaintenanceWindow) Validate() error {
+	if w.Start == 0 {
+		return ErrMissingMaintenanceStart
+	}
+
+	if w.Duration <= 0 {
+		return ErrInvalidMaintenanceDuration
+	}
+
+	if w.Behaviour != MaintenanceBehaviourLand && w.Behaviour != MaintenanceBehaviourRetire {
+		return ErrInvalidMaintenanceBehaviour
+	}
+
+	return nil
+}
+
+type WorkerMaintenance struct {
+	Window         MaintenanceWindow `json:"window"`
+	BlockingBuilds []Build           `json:"blocking_builds"`
+}
@@ -12,3 +12,29 @@ type ArtifactDestination interface {
 	// expand into the destination directory.
 	StreamIn(string, io.Reader) error
 }
+
+// countingDestination counts the bytes of the tar streams it's handed.
+type countingDestination struct {
+	ArtifactDestination
+
+	bytes int64
+}
+
+func (dest *countingDestination) StreamIn(path string, tarStream io.Reader) error {
+	return dest.ArtifactDestination.StreamIn(path, &countingReader{
+		Reader: tarStream,
+		bytes:  &dest.bytes,
+	})
+}
+
+type countingReader struct {
+	io.Reader
+
+	bytes *int64
+}
+
+func (reader *countingReader) Read(p []byte) (int, error) {
+	n, err := reader.Reader.Read(p)
+	*reader.bytes += int64(n)
+	return n, err
+}
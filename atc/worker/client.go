@@ -15,6 +15,15 @@ import (
 //go:generate counterfeiter . Client
 
 type Client interface {
+	FindOrChooseWorker(
+		context.Context,
+		lager.Logger,
+		ImageFetchingDelegate,
+		db.ContainerOwner,
+		ContainerSpec,
+		creds.VersionedResourceTypes,
+	) (Worker, error)
+
 	FindOrCreateContainer(
 		context.Context,
 		lager.Logger,
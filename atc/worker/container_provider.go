@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const creatingContainerRetryDelay = 1 * time.Second
@@ -30,6 +31,7 @@ func NewContainerProvider(
 	dbVolumeRepository db.VolumeRepository,
 	dbTeamFactory db.TeamFactory,
 	lockFactory lock.LockFactory,
+	quarantiner Quarantiner,
 ) ContainerProvider {
 
 	return &containerProvider{
@@ -40,6 +42,7 @@ func NewContainerProvider(
 		dbVolumeRepository: dbVolumeRepository,
 		dbTeamFactory:      dbTeamFactory,
 		lockFactory:        lockFactory,
+		quarantiner:        quarantiner,
 		httpProxyURL:       dbWorker.HTTPProxyURL(),
 		httpsProxyURL:      dbWorker.HTTPSProxyURL(),
 		noProxy:            dbWorker.NoProxy(),
@@ -77,6 +80,7 @@ type containerProvider struct {
 	dbTeamFactory      db.TeamFactory
 
 	lockFactory lock.LockFactory
+	quarantiner Quarantiner
 
 	worker        db.Worker
 	httpProxyURL  string
@@ -95,6 +99,12 @@ func (p *containerProvider) FindOrCreateContainer(
 	spec ContainerSpec,
 	resourceTypes creds.VersionedResourceTypes,
 ) (Container, error) {
+	ctx, span := tracing.StartSpan(ctx, "find-or-create-container", tracing.Attrs{
+		"worker": p.worker.Name(),
+		"type":   string(metadata.Type),
+	})
+	defer span.End()
+
 	for {
 		var gardenContainer garden.Container
 
@@ -168,6 +178,14 @@ func (p *containerProvider) FindOrCreateContainer(
 			if creatingContainer == nil {
 				logger.Debug("creating-container-in-db")
 
+				if spec.Limits.CPU != nil {
+					metadata.CPULimit = *spec.Limits.CPU
+				}
+
+				if spec.Limits.Memory != nil {
+					metadata.MemoryLimit = *spec.Limits.Memory
+				}
+
 				creatingContainer, err = p.dbTeamFactory.GetByID(spec.TeamID).CreateContainer(
 					p.worker.Name(),
 					owner,
@@ -198,17 +216,28 @@ func (p *containerProvider) FindOrCreateContainer(
 
 			logger.Debug("fetching-image")
 
-			fetchedImage, err := image.FetchForContainer(ctx, logger, creatingContainer)
+			fetchCtx, fetchSpan := tracing.StartSpan(ctx, "fetch-image", tracing.Attrs{
+				"container": creatingContainer.Handle(),
+			})
+
+			fetchStart := p.clock.Now()
+
+			fetchedImage, err := image.FetchForContainer(fetchCtx, logger, creatingContainer)
+			tracing.End(fetchSpan, err)
 			if err != nil {
 				creatingContainer.Failed()
 				logger.Error("failed-to-fetch-image-for-container", err)
 				return nil, err
 			}
 
+			delegate.ImageFetched(logger, p.clock.Since(fetchStart))
+
 			logger.Debug("creating-container-in-garden")
 
 			gardenContainer, err = p.createGardenContainer(
+				ctx,
 				logger,
+				delegate,
 				creatingContainer,
 				spec,
 				fetchedImage,
@@ -221,11 +250,16 @@ func (p *containerProvider) FindOrCreateContainer(
 				metric.FailedContainers.Inc()
 
 				logger.Error("failed-to-create-container-in-garden", err)
+
+				p.quarantiner.ContainerFailed(logger, p.worker, err)
+
 				return nil, err
 			}
 
 			metric.ContainersCreated.Inc()
 
+			p.quarantiner.ContainerCreated(logger, p.worker)
+
 			logger.Debug("created-container-in-garden")
 		}
 
@@ -320,7 +354,9 @@ func (p *containerProvider) constructGardenWorkerContainer(
 }
 
 func (p *containerProvider) createGardenContainer(
+	ctx context.Context,
 	logger lager.Logger,
+	delegate ImageFetchingDelegate,
 	creatingContainer db.CreatingContainer,
 	spec ContainerSpec,
 	fetchedImage FetchedImage,
@@ -422,10 +458,20 @@ func (p *containerProvider) createGardenContainer(
 				return nil, err
 			}
 
-			err = inputSource.Source().StreamTo(inputVolume)
+			_, streamSpan := tracing.StartSpan(ctx, "stream-in", tracing.Attrs{
+				"destination": cleanedInputPath,
+			})
+
+			streamStart := p.clock.Now()
+			destination := &countingDestination{ArtifactDestination: inputVolume}
+
+			err = inputSource.Source().StreamTo(destination)
+			tracing.End(streamSpan, err)
 			if err != nil {
 				return nil, err
 			}
+
+			delegate.InputStreamed(logger, destination.bytes, p.clock.Since(streamStart))
 		}
 
 		ioVolumeMounts = append(ioVolumeMounts, VolumeMount{
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"time"
 
@@ -44,6 +45,8 @@ var _ = Describe("ContainerProvider", func() {
 		fakeDBTeam             *dbfakes.FakeTeam
 		fakeDBVolumeRepository *dbfakes.FakeVolumeRepository
 		fakeLockFactory        *lockfakes.FakeLockFactory
+		fakeDBWorker           *dbfakes.FakeWorker
+		fakeQuarantiner        *workerfakes.FakeQuarantiner
 
 		containerProvider ContainerProvider
 
@@ -95,6 +98,7 @@ var _ = Describe("ContainerProvider", func() {
 		}, nil)
 		fakeImageFactory.GetImageReturns(fakeImage, nil)
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
+		fakeQuarantiner = new(workerfakes.FakeQuarantiner)
 
 		fakeDBTeamFactory := new(dbfakes.FakeTeamFactory)
 		fakeDBTeam = new(dbfakes.FakeTeam)
@@ -104,7 +108,7 @@ var _ = Describe("ContainerProvider", func() {
 		fakeGardenContainer = new(gardenfakes.FakeContainer)
 		fakeGardenClient.CreateReturns(fakeGardenContainer, nil)
 
-		fakeDBWorker := new(dbfakes.FakeWorker)
+		fakeDBWorker = new(dbfakes.FakeWorker)
 		fakeDBWorker.HTTPProxyURLReturns("http://proxy.com")
 		fakeDBWorker.HTTPSProxyURLReturns("https://proxy.com")
 		fakeDBWorker.NoProxyReturns("http://noproxy.com")
@@ -119,6 +123,7 @@ var _ = Describe("ContainerProvider", func() {
 			fakeDBVolumeRepository,
 			fakeDBTeamFactory,
 			fakeLockFactory,
+			fakeQuarantiner,
 		)
 
 		fakeLocalInput = new(workerfakes.FakeInputSource)
@@ -856,10 +861,39 @@ var _ = Describe("ContainerProvider", func() {
 				Expect(ioutil.ReadAll(from)).To(Equal([]byte("some-stream")))
 			})
 
+			Context("when the remote inputs are streamed", func() {
+				BeforeEach(func() {
+					fakeRemoteInputAS.StreamToStub = func(ad ArtifactDestination) error {
+						return ad.StreamIn(".", bytes.NewBufferString("some-stream"))
+					}
+
+					fakeRemoteInputContainerVolume.StreamInStub = func(path string, tarStream io.Reader) error {
+						_, err := ioutil.ReadAll(tarStream)
+						return err
+					}
+				})
+
+				It("tells the delegate how many bytes were streamed", func() {
+					Expect(fakeImageFetchingDelegate.InputStreamedCallCount()).To(Equal(1))
+					_, streamedBytes, _ := fakeImageFetchingDelegate.InputStreamedArgsForCall(0)
+					Expect(streamedBytes).To(Equal(int64(len("some-stream"))))
+				})
+			})
+
+			It("tells the delegate that the image was fetched", func() {
+				Expect(fakeImageFetchingDelegate.ImageFetchedCallCount()).To(Equal(1))
+			})
+
 			It("marks container as created", func() {
 				Expect(fakeCreatingContainer.CreatedCallCount()).To(Equal(1))
 			})
 
+			It("tells the quarantiner that the worker created a container", func() {
+				Expect(fakeQuarantiner.ContainerCreatedCallCount()).To(Equal(1))
+				_, worker := fakeQuarantiner.ContainerCreatedArgsForCall(0)
+				Expect(worker).To(Equal(fakeDBWorker))
+			})
+
 			Context("when the fetched image was privileged", func() {
 				BeforeEach(func() {
 					fakeImage.FetchForContainerReturns(FetchedImage{
@@ -965,6 +999,14 @@ var _ = Describe("ContainerProvider", func() {
 				It("marks the container as failed", func() {
 					Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
 				})
+
+				It("tells the quarantiner that the worker failed to create a container", func() {
+					Expect(fakeQuarantiner.ContainerCreatedCallCount()).To(Equal(0))
+					Expect(fakeQuarantiner.ContainerFailedCallCount()).To(Equal(1))
+					_, worker, err := fakeQuarantiner.ContainerFailedArgsForCall(0)
+					Expect(worker).To(Equal(fakeDBWorker))
+					Expect(err).To(Equal(disasterErr))
+				})
 			})
 		})
 	})
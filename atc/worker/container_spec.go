@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
 )
 
 type WorkerSpec struct {
@@ -14,11 +15,19 @@ type WorkerSpec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+
+	// Constraints on the labels of the worker, checked by the pool.
+	Placement atc.PlacementConstraints
 }
 
 type ContainerSpec struct {
+	// The kind of step the container is for, taken into account when placing
+	// it on a worker.
+	Type db.ContainerType
+
 	Platform  string
 	Tags      []string
+	Placement atc.PlacementConstraints
 	TeamID    int
 	ImageSpec ImageSpec
 	Env       []string
@@ -91,6 +100,7 @@ func (spec ContainerSpec) WorkerSpec() WorkerSpec {
 		Platform:     spec.Platform,
 		Tags:         spec.Tags,
 		TeamID:       spec.TeamID,
+		Placement:    spec.Placement,
 	}
 }
 
@@ -29,6 +29,7 @@ type dbWorkerProvider struct {
 	dbWorkerFactory                   db.WorkerFactory
 	workerVersion                     version.Version
 	baggageclaimResponseHeaderTimeout time.Duration
+	quarantiner                       Quarantiner
 }
 
 func NewDBWorkerProvider(
@@ -44,6 +45,7 @@ func NewDBWorkerProvider(
 	workerFactory db.WorkerFactory,
 	workerVersion version.Version,
 	baggageclaimResponseHeaderTimeout time.Duration,
+	quarantiner Quarantiner,
 ) WorkerProvider {
 	return &dbWorkerProvider{
 		lockFactory:                       lockFactory,
@@ -58,6 +60,7 @@ func NewDBWorkerProvider(
 		dbWorkerFactory:                   workerFactory,
 		workerVersion:                     workerVersion,
 		baggageclaimResponseHeaderTimeout: baggageclaimResponseHeaderTimeout,
+		quarantiner:                       quarantiner,
 	}
 }
 
@@ -165,6 +168,7 @@ func (provider *dbWorkerProvider) NewGardenWorker(logger lager.Logger, tikTok cl
 		provider.dbVolumeRepository,
 		provider.dbWorkerBaseResourceTypeFactory,
 		provider.dbWorkerTaskCacheFactory,
+		provider.quarantiner,
 	)
 
 	containerProvider := NewContainerProvider(
@@ -178,6 +182,7 @@ func (provider *dbWorkerProvider) NewGardenWorker(logger lager.Logger, tikTok cl
 		provider.dbVolumeRepository,
 		provider.dbTeamFactory,
 		provider.lockFactory,
+		provider.quarantiner,
 	)
 
 	return NewGardenWorker(
@@ -172,6 +172,7 @@ var _ = Describe("DBProvider", func() {
 			fakeDBWorkerFactory,
 			wantWorkerVersion,
 			baggageclaimResponseHeaderTimeout,
+			NewQuarantiner(0),
 		)
 		baggageclaimURL = baggageclaimServer.URL()
 	})
@@ -177,6 +177,7 @@ func (i *imageResourceFetcher) Fetch(
 		logger.Session("init-image"),
 		getSess,
 		i.worker.Tags(),
+		nil,
 		i.teamID,
 		i.customTypes,
 		resourceInstance,
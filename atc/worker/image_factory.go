@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -48,6 +49,18 @@ type ImageFetchingDelegate interface {
 	Stdout() io.Writer
 	Stderr() io.Writer
 	ImageVersionDetermined(db.UsedResourceCache) error
+
+	// SelectedWorker is called with the name of the worker the container is
+	// being found or created on.
+	SelectedWorker(lager.Logger, string)
+
+	// ImageFetched is called with how long it took to fetch the image of a
+	// container being created.
+	ImageFetched(lager.Logger, time.Duration)
+
+	// InputStreamed is called with the number of bytes streamed into an input
+	// of a container being created, and how long it took.
+	InputStreamed(lager.Logger, int64, time.Duration)
 }
 
 type ImageMetadata struct {
@@ -60,3 +73,6 @@ type NoopImageFetchingDelegate struct{}
 func (NoopImageFetchingDelegate) Stdout() io.Writer                                 { return ioutil.Discard }
 func (NoopImageFetchingDelegate) Stderr() io.Writer                                 { return ioutil.Discard }
 func (NoopImageFetchingDelegate) ImageVersionDetermined(db.UsedResourceCache) error { return nil }
+func (NoopImageFetchingDelegate) SelectedWorker(lager.Logger, string)               {}
+func (NoopImageFetchingDelegate) ImageFetched(lager.Logger, time.Duration)          {}
+func (NoopImageFetchingDelegate) InputStreamed(lager.Logger, int64, time.Duration)  {}
@@ -1,14 +1,85 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"strings"
 	"time"
+
+	"github.com/concourse/concourse/atc/db"
 )
 
+// ErrNoWorkerAvailable is returned when every compatible worker is too busy
+// to take on the container right now. Choosing again later may succeed.
+var ErrNoWorkerAvailable = errors.New("no worker available")
+
 type ContainerPlacementStrategy interface {
 	Choose([]Worker, ContainerSpec) (Worker, error)
 }
 
+// NewContainerPlacementStrategy builds a strategy from a comma-separated list
+// of strategy names. Each strategy breaks the ties left by the one before it,
+// and any remaining tie is broken at random.
+func NewContainerPlacementStrategy(strategies string, maxActiveTasksPerWorker int) (ContainerPlacementStrategy, error) {
+	nodes := []ContainerPlacementStrategyNode{}
+
+	for _, name := range strings.Split(strategies, ",") {
+		switch strings.TrimSpace(name) {
+		case "volume-locality":
+			nodes = append(nodes, VolumeLocalityPlacementStrategyNode{})
+		case "fewest-build-containers":
+			nodes = append(nodes, FewestBuildContainersPlacementStrategyNode{})
+		case "limit-active-tasks":
+			nodes = append(nodes, LimitActiveTasksPlacementStrategyNode{
+				MaxActiveTasks: maxActiveTasksPerWorker,
+			})
+		case "random":
+			// ties are always broken at random
+		default:
+			return nil, fmt.Errorf("unknown container placement strategy '%s'", name)
+		}
+	}
+
+	return NewChainPlacementStrategy(nodes...), nil
+}
+
+// ContainerPlacementStrategyNode narrows down the workers a container may be
+// placed on to the ones it prefers.
+type ContainerPlacementStrategyNode interface {
+	Candidates([]Worker, ContainerSpec) ([]Worker, error)
+}
+
+type ChainPlacementStrategy struct {
+	nodes []ContainerPlacementStrategyNode
+	rand  *rand.Rand
+}
+
+func NewChainPlacementStrategy(nodes ...ContainerPlacementStrategyNode) ContainerPlacementStrategy {
+	return &ChainPlacementStrategy{
+		nodes: nodes,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *ChainPlacementStrategy) Choose(workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates := workers
+
+	for _, node := range strategy.nodes {
+		var err error
+		candidates, err = node.Candidates(candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoWorkerAvailable
+	}
+
+	return candidates[strategy.rand.Intn(len(candidates))], nil
+}
+
 type VolumeLocalityPlacementStrategy struct {
 	rand *rand.Rand
 }
@@ -20,6 +91,19 @@ func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) Choose(workers []Worker, spec ContainerSpec) (Worker, error) {
+	highestLocalityWorkers, err := VolumeLocalityPlacementStrategyNode{}.Candidates(workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+}
+
+// VolumeLocalityPlacementStrategyNode prefers the workers which already have
+// the most of the container's inputs.
+type VolumeLocalityPlacementStrategyNode struct{}
+
+func (VolumeLocalityPlacementStrategyNode) Candidates(workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -43,9 +127,73 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(workers []Worker, spec C
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
+	return workersByCount[highestCount], nil
+}
 
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+// FewestBuildContainersPlacementStrategyNode prefers the workers running the
+// fewest containers for builds.
+type FewestBuildContainersPlacementStrategyNode struct{}
+
+func (FewestBuildContainersPlacementStrategyNode) Candidates(workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates := []Worker{}
+	fewest := -1
+
+	for _, w := range workers {
+		count := w.BuildContainers()
+
+		if fewest == -1 || count < fewest {
+			fewest = count
+			candidates = []Worker{}
+		}
+
+		if count == fewest {
+			candidates = append(candidates, w)
+		}
+	}
+
+	return candidates, nil
+}
+
+// LimitActiveTasksPlacementStrategyNode places tasks on the workers running
+// the fewest tasks, and leaves out workers which are already running
+// MaxActiveTasks of them. Containers for other steps are placed anywhere.
+//
+// If every worker is at the limit, ErrNoWorkerAvailable is returned so that
+// the task waits for one to free up rather than overloading a worker.
+type LimitActiveTasksPlacementStrategyNode struct {
+	MaxActiveTasks int
+}
+
+func (node LimitActiveTasksPlacementStrategyNode) Candidates(workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	if spec.Type != db.ContainerTypeTask {
+		return workers, nil
+	}
+
+	candidates := []Worker{}
+	fewest := -1
+
+	for _, w := range workers {
+		activeTasks := w.ActiveTasks()
+
+		if node.MaxActiveTasks > 0 && activeTasks >= node.MaxActiveTasks {
+			continue
+		}
+
+		if fewest == -1 || activeTasks < fewest {
+			fewest = activeTasks
+			candidates = []Worker{}
+		}
+
+		if activeTasks == fewest {
+			candidates = append(candidates, w)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoWorkerAvailable
+	}
+
+	return candidates, nil
 }
 
 type RandomPlacementStrategy struct {
@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"github.com/concourse/concourse/atc/db"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
@@ -208,3 +209,116 @@ var _ = Describe("RandomPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("NewContainerPlacementStrategy", func() {
+	It("accepts a chain of known strategies", func() {
+		_, err := NewContainerPlacementStrategy("volume-locality,fewest-build-containers, limit-active-tasks,random", 2)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects unknown strategies", func() {
+		_, err := NewContainerPlacementStrategy("volume-locality,bogus", 0)
+		Expect(err).To(MatchError("unknown container placement strategy 'bogus'"))
+	})
+})
+
+var _ = Describe("ChainPlacementStrategy", func() {
+	var (
+		busyWorker  *workerfakes.FakeWorker
+		quietWorker *workerfakes.FakeWorker
+		idleWorker  *workerfakes.FakeWorker
+	)
+
+	BeforeEach(func() {
+		busyWorker = new(workerfakes.FakeWorker)
+		busyWorker.BuildContainersReturns(10)
+		busyWorker.ActiveTasksReturns(2)
+
+		quietWorker = new(workerfakes.FakeWorker)
+		quietWorker.BuildContainersReturns(3)
+		quietWorker.ActiveTasksReturns(1)
+
+		idleWorker = new(workerfakes.FakeWorker)
+		idleWorker.BuildContainersReturns(3)
+		idleWorker.ActiveTasksReturns(0)
+
+		workers = []Worker{busyWorker, quietWorker, idleWorker}
+
+		spec = ContainerSpec{
+			Type:   db.ContainerTypeTask,
+			TeamID: 4567,
+		}
+	})
+
+	JustBeforeEach(func() {
+		chosenWorker, chooseErr = strategy.Choose(workers, spec)
+	})
+
+	Context("with fewest-build-containers", func() {
+		BeforeEach(func() {
+			strategy = NewChainPlacementStrategy(FewestBuildContainersPlacementStrategyNode{})
+		})
+
+		It("chooses one of the workers with the fewest build containers", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(SatisfyAny(Equal(quietWorker), Equal(idleWorker)))
+		})
+	})
+
+	Context("with limit-active-tasks", func() {
+		BeforeEach(func() {
+			strategy = NewChainPlacementStrategy(LimitActiveTasksPlacementStrategyNode{MaxActiveTasks: 2})
+		})
+
+		It("chooses the worker running the fewest tasks", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(idleWorker))
+		})
+
+		Context("when every worker is at the limit", func() {
+			BeforeEach(func() {
+				workers = []Worker{busyWorker}
+			})
+
+			It("returns ErrNoWorkerAvailable", func() {
+				Expect(chooseErr).To(Equal(ErrNoWorkerAvailable))
+			})
+
+			Context("when the container is not for a task", func() {
+				BeforeEach(func() {
+					spec.Type = db.ContainerTypeGet
+				})
+
+				It("chooses the worker anyway", func() {
+					Expect(chooseErr).ToNot(HaveOccurred())
+					Expect(chosenWorker).To(Equal(busyWorker))
+				})
+			})
+		})
+	})
+
+	Context("with fewest-build-containers followed by limit-active-tasks", func() {
+		BeforeEach(func() {
+			strategy = NewChainPlacementStrategy(
+				FewestBuildContainersPlacementStrategyNode{},
+				LimitActiveTasksPlacementStrategyNode{MaxActiveTasks: 2},
+			)
+		})
+
+		It("breaks the first strategy's ties with the second", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(idleWorker))
+		})
+	})
+
+	Context("with no workers left to choose from", func() {
+		BeforeEach(func() {
+			strategy = NewChainPlacementStrategy()
+			workers = []Worker{}
+		})
+
+		It("returns ErrNoWorkerAvailable", func() {
+			Expect(chooseErr).To(Equal(ErrNoWorkerAvailable))
+		})
+	})
+})
@@ -60,18 +60,52 @@ func (err NoCompatibleWorkersError) Error() string {
 	)
 }
 
+// NoWorkersSatisfyingConstraintsError is returned when some workers are
+// compatible with the container, but none of them match its placement
+// constraints.
+type NoWorkersSatisfyingConstraintsError struct {
+	Constraints atc.PlacementConstraints
+	Workers     []Worker
+}
+
+func (err NoWorkersSatisfyingConstraintsError) Error() string {
+	compatibleWorkers := ""
+	for _, worker := range err.Workers {
+		compatibleWorkers += "\n  - " + worker.Description()
+	}
+
+	return fmt.Sprintf(
+		"no workers satisfying constraints: %s\n\ncompatible workers: %s",
+		err.Constraints,
+		compatibleWorkers,
+	)
+}
+
+// NoWorkerAvailableRetryInterval is how long to wait before choosing a worker
+// again when all of them are too busy.
+const NoWorkerAvailableRetryInterval = 5 * time.Second
+
 type pool struct {
+	clock    clock.Clock
 	provider WorkerProvider
 
 	rand     *rand.Rand
 	strategy ContainerPlacementStrategy
+
+	maxActiveTasks int
 }
 
-func NewPool(provider WorkerProvider, strategy ContainerPlacementStrategy) Client {
+// NewPool returns a Client which places containers on the provider's workers
+// using the given strategy. A worker is never given more than maxActiveTasks
+// tasks to run at once, unless it is 0.
+func NewPool(clock clock.Clock, provider WorkerProvider, strategy ContainerPlacementStrategy, maxActiveTasks int) Client {
 	return &pool{
+		clock:    clock,
 		provider: provider,
 		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
 		strategy: strategy,
+
+		maxActiveTasks: maxActiveTasks,
 	}
 }
 
@@ -91,10 +125,13 @@ func (pool *pool) AllSatisfying(logger lager.Logger, spec WorkerSpec, resourceTy
 
 	compatibleTeamWorkers := []Worker{}
 	compatibleGeneralWorkers := []Worker{}
+	constrainedWorkers := []Worker{}
 	for _, worker := range workers {
 		satisfyingWorker, err := worker.Satisfying(logger, spec, resourceTypes)
 		if err == nil {
-			if worker.IsOwnedByTeam() {
+			if !spec.Placement.Match(worker.Labels()) {
+				constrainedWorkers = append(constrainedWorkers, satisfyingWorker)
+			} else if worker.IsOwnedByTeam() {
 				compatibleTeamWorkers = append(compatibleTeamWorkers, satisfyingWorker)
 			} else {
 				compatibleGeneralWorkers = append(compatibleGeneralWorkers, satisfyingWorker)
@@ -110,6 +147,13 @@ func (pool *pool) AllSatisfying(logger lager.Logger, spec WorkerSpec, resourceTy
 		return compatibleGeneralWorkers, nil
 	}
 
+	if len(constrainedWorkers) != 0 {
+		return nil, NoWorkersSatisfyingConstraintsError{
+			Constraints: spec.Placement,
+			Workers:     constrainedWorkers,
+		}
+	}
+
 	return nil, NoCompatibleWorkersError{
 		Spec:    spec,
 		Workers: workers,
@@ -125,15 +169,32 @@ func (pool *pool) Satisfying(logger lager.Logger, spec WorkerSpec, resourceTypes
 	return randomWorker, nil
 }
 
-func (pool *pool) FindOrCreateContainer(
+// FindOrChooseWorker returns the worker the owner's container is on, or
+// chooses one to create it on. If every worker is too busy to take the
+// container, it waits for one to free up.
+//
+// For a task, the worker's count of active tasks is increased, and must be
+// decreased by the caller once the task is done.
+func (pool *pool) FindOrChooseWorker(
 	ctx context.Context,
 	logger lager.Logger,
 	delegate ImageFetchingDelegate,
 	owner db.ContainerOwner,
-	metadata db.ContainerMetadata,
 	spec ContainerSpec,
 	resourceTypes creds.VersionedResourceTypes,
-) (Container, error) {
+) (Worker, error) {
+	return pool.findOrChooseWorker(ctx, logger, delegate, owner, spec, resourceTypes, spec.Type == db.ContainerTypeTask)
+}
+
+func (pool *pool) findOrChooseWorker(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	owner db.ContainerOwner,
+	spec ContainerSpec,
+	resourceTypes creds.VersionedResourceTypes,
+	countActiveTask bool,
+) (Worker, error) {
 	worker, found, err := pool.provider.FindWorkerForContainerByOwner(
 		logger.Session("find-worker"),
 		spec.TeamID,
@@ -143,16 +204,102 @@ func (pool *pool) FindOrCreateContainer(
 		return nil, err
 	}
 
-	if !found {
+	if found {
+		if countActiveTask {
+			// the task is already on the worker, e.g. when a build is resumed,
+			// so it counts regardless of the limit
+			_, err := worker.IncreaseActiveTasks(0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return worker, nil
+	}
+
+	waiting := false
+	for {
 		compatibleWorkers, err := pool.AllSatisfying(logger, spec.WorkerSpec(), resourceTypes)
 		if err != nil {
 			return nil, err
 		}
 
-		worker, err = pool.strategy.Choose(compatibleWorkers, spec)
-		if err != nil {
-			return nil, err
+		if countActiveTask {
+			// leave out full workers before the strategy narrows the workers
+			// down, so that a task does not wait on the worker it prefers while
+			// others have room for it
+			compatibleWorkers = pool.workersWithRoomForTask(compatibleWorkers)
 		}
+
+		if len(compatibleWorkers) > 0 {
+			worker, err = pool.strategy.Choose(compatibleWorkers, spec)
+			if err != nil && err != ErrNoWorkerAvailable {
+				return nil, err
+			}
+
+			if err == nil {
+				if !countActiveTask {
+					return worker, nil
+				}
+
+				increased, err := worker.IncreaseActiveTasks(pool.maxActiveTasks)
+				if err != nil {
+					return nil, err
+				}
+
+				if increased {
+					return worker, nil
+				}
+
+				// the worker was given its last task by another ATC since it
+				// was listed; choose again from the ones left
+				continue
+			}
+		}
+
+		if !waiting {
+			logger.Info("waiting-for-available-worker")
+			fmt.Fprintln(delegate.Stdout(), "all workers are busy at the moment, waiting for one to become available...")
+			waiting = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-pool.clock.After(NoWorkerAvailableRetryInterval):
+		}
+	}
+}
+
+// workersWithRoomForTask returns the workers which are running fewer than
+// maxActiveTasks tasks.
+func (pool *pool) workersWithRoomForTask(workers []Worker) []Worker {
+	if pool.maxActiveTasks == 0 {
+		return workers
+	}
+
+	available := []Worker{}
+	for _, w := range workers {
+		if w.ActiveTasks() < pool.maxActiveTasks {
+			available = append(available, w)
+		}
+	}
+
+	return available
+}
+
+func (pool *pool) FindOrCreateContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	owner db.ContainerOwner,
+	metadata db.ContainerMetadata,
+	spec ContainerSpec,
+	resourceTypes creds.VersionedResourceTypes,
+) (Container, error) {
+	worker, err := pool.findOrChooseWorker(ctx, logger, delegate, owner, spec, resourceTypes, false)
+	if err != nil {
+		return nil, err
 	}
 
 	return worker.FindOrCreateContainer(
@@ -3,7 +3,9 @@ package worker_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/concourse/atc"
@@ -15,6 +17,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Pool", func() {
@@ -22,6 +25,7 @@ var _ = Describe("Pool", func() {
 		logger       *lagertest.TestLogger
 		fakeProvider *workerfakes.FakeWorkerProvider
 		fakeStrategy *workerfakes.FakeContainerPlacementStrategy
+		fakeClock    *fakeclock.FakeClock
 		pool         Client
 	)
 
@@ -30,7 +34,9 @@ var _ = Describe("Pool", func() {
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
 
-		pool = NewPool(fakeProvider, fakeStrategy)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		pool = NewPool(fakeClock, fakeProvider, fakeStrategy, 3)
 	})
 
 	Describe("Satisfying", func() {
@@ -253,6 +259,40 @@ var _ = Describe("Pool", func() {
 					}))
 				})
 			})
+
+			Context("when the spec has placement constraints", func() {
+				BeforeEach(func() {
+					spec.Placement = atc.PlacementConstraints{
+						{Key: "memory", Operator: atc.PlacementOperatorIn, Values: []string{"32gb", "64gb"}},
+					}
+
+					workerA.LabelsReturns(map[string]string{"memory": "32gb"})
+					workerB.LabelsReturns(map[string]string{"memory": "8gb"})
+					workerC.LabelsReturns(map[string]string{"memory": "64gb"})
+				})
+
+				It("returns only the satisfying workers whose labels match", func() {
+					Expect(satisfyingErr).NotTo(HaveOccurred())
+					Expect(satisfyingWorkers).To(ConsistOf(workerA))
+				})
+
+				Context("when no satisfying worker's labels match", func() {
+					BeforeEach(func() {
+						workerA.LabelsReturns(map[string]string{})
+					})
+
+					It("returns a NoWorkersSatisfyingConstraintsError", func() {
+						Expect(satisfyingErr).To(Equal(NoWorkersSatisfyingConstraintsError{
+							Constraints: spec.Placement,
+							Workers:     []Worker{workerA, workerB},
+						}))
+					})
+
+					It("describes the constraints", func() {
+						Expect(satisfyingErr.Error()).To(ContainSubstring("no workers satisfying constraints: label 'memory' in (32gb, 64gb)"))
+					})
+				})
+			})
 		})
 
 		Context("when team workers and general workers satisfy the spec", func() {
@@ -557,6 +597,52 @@ var _ = Describe("Pool", func() {
 					})
 				})
 
+				Context("when every worker is too busy", func() {
+					var stdout *gbytes.Buffer
+
+					BeforeEach(func() {
+						stdout = gbytes.NewBuffer()
+						fakeImageFetchingDelegate.StdoutReturns(stdout)
+
+						fakeStrategy.ChooseReturnsOnCall(0, nil, ErrNoWorkerAvailable)
+						fakeStrategy.ChooseReturnsOnCall(1, compatibleWorker, nil)
+
+						go func() {
+							defer GinkgoRecover()
+
+							Eventually(fakeClock.WatcherCount).Should(Equal(1))
+							fakeClock.Increment(NoWorkerAvailableRetryInterval)
+						}()
+					})
+
+					It("waits for a worker to become available", func() {
+						Expect(createErr).ToNot(HaveOccurred())
+						Expect(fakeStrategy.ChooseCallCount()).To(Equal(2))
+						Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+						Expect(createdContainer).To(Equal(fakeContainer))
+					})
+
+					It("tells the user it is waiting", func() {
+						Expect(stdout).To(gbytes.Say("all workers are busy at the moment"))
+					})
+				})
+
+				Context("when every worker stays too busy until the context is canceled", func() {
+					BeforeEach(func() {
+						fakeImageFetchingDelegate.StdoutReturns(gbytes.NewBuffer())
+						fakeStrategy.ChooseReturns(nil, ErrNoWorkerAvailable)
+
+						var cancel context.CancelFunc
+						ctx, cancel = context.WithCancel(ctx)
+						cancel()
+					})
+
+					It("returns the context's error", func() {
+						Expect(createErr).To(Equal(context.Canceled))
+						Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when strategy errors", func() {
 					var (
 						strategyError error
@@ -574,4 +660,163 @@ var _ = Describe("Pool", func() {
 			})
 		})
 	})
+
+	Describe("FindOrChooseWorker", func() {
+		var (
+			spec             ContainerSpec
+			compatibleWorker *workerfakes.FakeWorker
+
+			chosenWorker Worker
+			chooseErr    error
+		)
+
+		BeforeEach(func() {
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+				TeamID:    4567,
+				Type:      db.ContainerTypeTask,
+			}
+
+			compatibleWorker = new(workerfakes.FakeWorker)
+			compatibleWorker.SatisfyingReturns(compatibleWorker, nil)
+			compatibleWorker.IncreaseActiveTasksReturns(true, nil)
+
+			fakeProvider.RunningWorkersReturns([]Worker{compatibleWorker}, nil)
+			fakeStrategy.ChooseReturns(compatibleWorker, nil)
+		})
+
+		JustBeforeEach(func() {
+			fakeDelegate := new(workerfakes.FakeImageFetchingDelegate)
+			fakeDelegate.StdoutReturns(gbytes.NewBuffer())
+
+			chosenWorker, chooseErr = pool.FindOrChooseWorker(
+				context.Background(),
+				logger,
+				fakeDelegate,
+				new(dbfakes.FakeContainerOwner),
+				spec,
+				creds.VersionedResourceTypes{},
+			)
+		})
+
+		It("counts the task against the chosen worker's limit", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(compatibleWorker))
+
+			Expect(compatibleWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+			Expect(compatibleWorker.IncreaseActiveTasksArgsForCall(0)).To(Equal(3))
+		})
+
+		Context("when the chosen worker reaches its limit before the task is counted", func() {
+			BeforeEach(func() {
+				compatibleWorker.IncreaseActiveTasksReturnsOnCall(0, false, nil)
+				compatibleWorker.IncreaseActiveTasksReturnsOnCall(1, true, nil)
+			})
+
+			It("chooses again without waiting", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker))
+
+				Expect(fakeStrategy.ChooseCallCount()).To(Equal(2))
+				Expect(compatibleWorker.IncreaseActiveTasksCallCount()).To(Equal(2))
+				Expect(fakeClock.WatcherCount()).To(BeZero())
+			})
+		})
+
+		Context("when some of the workers are at their limit", func() {
+			var fullWorker *workerfakes.FakeWorker
+
+			BeforeEach(func() {
+				fullWorker = new(workerfakes.FakeWorker)
+				fullWorker.SatisfyingReturns(fullWorker, nil)
+				fullWorker.ActiveTasksReturns(3)
+
+				compatibleWorker.ActiveTasksReturns(2)
+
+				fakeProvider.RunningWorkersReturns([]Worker{fullWorker, compatibleWorker}, nil)
+			})
+
+			It("leaves them out before the strategy chooses", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker))
+
+				Expect(fakeStrategy.ChooseCallCount()).To(Equal(1))
+				workers, _ := fakeStrategy.ChooseArgsForCall(0)
+				Expect(workers).To(Equal([]Worker{compatibleWorker}))
+			})
+
+			Context("when the container is not for a task", func() {
+				BeforeEach(func() {
+					spec.Type = db.ContainerTypeGet
+				})
+
+				It("leaves them in", func() {
+					workers, _ := fakeStrategy.ChooseArgsForCall(0)
+					Expect(workers).To(ConsistOf(fullWorker, compatibleWorker))
+				})
+			})
+		})
+
+		Context("when every worker is at its limit", func() {
+			BeforeEach(func() {
+				compatibleWorker.ActiveTasksReturnsOnCall(0, 3)
+				compatibleWorker.ActiveTasksReturnsOnCall(1, 2)
+
+				go func() {
+					defer GinkgoRecover()
+
+					Eventually(fakeClock.WatcherCount).Should(Equal(1))
+					fakeClock.Increment(NoWorkerAvailableRetryInterval)
+				}()
+			})
+
+			It("waits for one to free up without asking the strategy", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker))
+
+				Expect(fakeStrategy.ChooseCallCount()).To(Equal(1))
+				Expect(compatibleWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when counting the task fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				compatibleWorker.IncreaseActiveTasksReturns(false, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(chooseErr).To(Equal(disaster))
+			})
+		})
+
+		Context("when a worker is found with the container", func() {
+			var fakeWorker *workerfakes.FakeWorker
+
+			BeforeEach(func() {
+				fakeWorker = new(workerfakes.FakeWorker)
+				fakeProvider.FindWorkerForContainerByOwnerReturns(fakeWorker, true, nil)
+			})
+
+			It("counts the task regardless of the limit", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(fakeWorker))
+
+				Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+				Expect(fakeWorker.IncreaseActiveTasksArgsForCall(0)).To(Equal(0))
+			})
+		})
+
+		Context("when the container is not for a task", func() {
+			BeforeEach(func() {
+				spec.Type = db.ContainerTypeGet
+			})
+
+			It("does not count it", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(compatibleWorker.IncreaseActiveTasksCallCount()).To(BeZero())
+			})
+		})
+	})
 })
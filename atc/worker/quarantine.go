@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+//go:generate counterfeiter . Quarantiner
+
+// A Quarantiner takes workers out of rotation once creating containers or
+// volumes on them has failed too many times in a row, e.g. because of a
+// broken disk or DNS, so that they stop failing builds until an operator has
+// a look.
+//
+// Only creating a container resets the count, as a container's volumes are
+// created before it, even when the container itself can't be.
+type Quarantiner interface {
+	ContainerCreated(lager.Logger, db.Worker)
+	ContainerFailed(lager.Logger, db.Worker, error)
+	VolumeFailed(lager.Logger, db.Worker, error)
+}
+
+type quarantiner struct {
+	threshold int
+}
+
+// NewQuarantiner returns a Quarantiner which quarantines a worker after
+// threshold consecutive failures. A threshold of zero never quarantines.
+func NewQuarantiner(threshold int) Quarantiner {
+	return &quarantiner{
+		threshold: threshold,
+	}
+}
+
+func (q *quarantiner) ContainerCreated(logger lager.Logger, worker db.Worker) {
+	if q.threshold <= 0 {
+		return
+	}
+
+	err := worker.ResetErrors()
+	if err != nil {
+		logger.Error("failed-to-reset-worker-errors", err)
+	}
+}
+
+func (q *quarantiner) ContainerFailed(logger lager.Logger, worker db.Worker, cause error) {
+	q.failed(logger, worker, cause)
+}
+
+func (q *quarantiner) VolumeFailed(logger lager.Logger, worker db.Worker, cause error) {
+	q.failed(logger, worker, cause)
+}
+
+func (q *quarantiner) failed(logger lager.Logger, worker db.Worker, cause error) {
+	if q.threshold <= 0 {
+		return
+	}
+
+	errors, err := worker.RecordError()
+	if err != nil {
+		logger.Error("failed-to-record-worker-error", err)
+		return
+	}
+
+	if errors < q.threshold {
+		return
+	}
+
+	reason := fmt.Sprintf("failed to create %d containers or volumes in a row, most recently: %s", errors, cause)
+
+	quarantined, err := worker.Quarantine(reason)
+	if err != nil {
+		logger.Error("failed-to-quarantine-worker", err)
+		return
+	}
+
+	// the worker may have been quarantined by another failure already, or
+	// landed in the meantime
+	if !quarantined {
+		return
+	}
+
+	logger.Info("quarantined-worker", lager.Data{"worker": worker.Name(), "errors": errors})
+
+	metric.WorkerQuarantined{
+		WorkerName: worker.Name(),
+		Errors:     errors,
+	}.Emit(logger)
+}
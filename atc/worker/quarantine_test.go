@@ -0,0 +1,149 @@
+package worker_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quarantiner", func() {
+	var (
+		logger      *lagertest.TestLogger
+		fakeWorker  *dbfakes.FakeWorker
+		threshold   int
+		quarantiner Quarantiner
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeWorker = new(dbfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		threshold = 3
+	})
+
+	JustBeforeEach(func() {
+		quarantiner = NewQuarantiner(threshold)
+	})
+
+	Describe("ContainerCreated", func() {
+		It("resets the worker's errors", func() {
+			quarantiner.ContainerCreated(logger, fakeWorker)
+			Expect(fakeWorker.ResetErrorsCallCount()).To(Equal(1))
+		})
+
+		Context("when the threshold is zero", func() {
+			BeforeEach(func() {
+				threshold = 0
+			})
+
+			It("does nothing", func() {
+				quarantiner.ContainerCreated(logger, fakeWorker)
+				Expect(fakeWorker.ResetErrorsCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("ContainerFailed", func() {
+		disaster := errors.New("disaster")
+
+		Context("when the worker has not reached the threshold", func() {
+			BeforeEach(func() {
+				fakeWorker.RecordErrorReturns(2, nil)
+			})
+
+			It("records the error without quarantining the worker", func() {
+				quarantiner.ContainerFailed(logger, fakeWorker, disaster)
+				Expect(fakeWorker.RecordErrorCallCount()).To(Equal(1))
+				Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the worker reaches the threshold", func() {
+			BeforeEach(func() {
+				fakeWorker.RecordErrorReturns(3, nil)
+			})
+
+			It("quarantines the worker with the most recent error as the reason", func() {
+				quarantiner.ContainerFailed(logger, fakeWorker, disaster)
+				Expect(fakeWorker.QuarantineCallCount()).To(Equal(1))
+				Expect(fakeWorker.QuarantineArgsForCall(0)).To(Equal("failed to create 3 containers or volumes in a row, most recently: disaster"))
+			})
+
+			Context("when the worker is quarantined", func() {
+				BeforeEach(func() {
+					fakeWorker.QuarantineReturns(true, nil)
+				})
+
+				It("logs it", func() {
+					quarantiner.ContainerFailed(logger, fakeWorker, disaster)
+					Expect(logger.LogMessages()).To(ContainElement("test.quarantined-worker"))
+				})
+			})
+
+			Context("when the worker was not running", func() {
+				BeforeEach(func() {
+					fakeWorker.QuarantineReturns(false, nil)
+				})
+
+				It("does not log it as quarantined", func() {
+					quarantiner.ContainerFailed(logger, fakeWorker, disaster)
+					Expect(logger.LogMessages()).ToNot(ContainElement("test.quarantined-worker"))
+				})
+			})
+		})
+
+		Context("when recording the error fails", func() {
+			BeforeEach(func() {
+				fakeWorker.RecordErrorReturns(0, errors.New("nope"))
+			})
+
+			It("does not quarantine the worker", func() {
+				quarantiner.ContainerFailed(logger, fakeWorker, disaster)
+				Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the threshold is zero", func() {
+			BeforeEach(func() {
+				threshold = 0
+			})
+
+			It("does nothing", func() {
+				quarantiner.ContainerFailed(logger, fakeWorker, disaster)
+				Expect(fakeWorker.RecordErrorCallCount()).To(BeZero())
+				Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("VolumeFailed", func() {
+		disaster := errors.New("disk full")
+
+		BeforeEach(func() {
+			fakeWorker.RecordErrorReturns(3, nil)
+		})
+
+		It("counts towards quarantining the worker", func() {
+			quarantiner.VolumeFailed(logger, fakeWorker, disaster)
+			Expect(fakeWorker.RecordErrorCallCount()).To(Equal(1))
+			Expect(fakeWorker.QuarantineCallCount()).To(Equal(1))
+			Expect(fakeWorker.QuarantineArgsForCall(0)).To(Equal("failed to create 3 containers or volumes in a row, most recently: disk full"))
+		})
+
+		Context("when the threshold is zero", func() {
+			BeforeEach(func() {
+				threshold = 0
+			})
+
+			It("does nothing", func() {
+				quarantiner.VolumeFailed(logger, fakeWorker, disaster)
+				Expect(fakeWorker.RecordErrorCallCount()).To(BeZero())
+			})
+		})
+	})
+})
@@ -84,6 +84,7 @@ type volumeClient struct {
 	dbWorkerTaskCacheFactory        db.WorkerTaskCacheFactory
 	clock                           clock.Clock
 	dbWorker                        db.Worker
+	quarantiner                     Quarantiner
 }
 
 func NewVolumeClient(
@@ -95,6 +96,7 @@ func NewVolumeClient(
 	dbVolumeRepository db.VolumeRepository,
 	dbWorkerBaseResourceTypeFactory db.WorkerBaseResourceTypeFactory,
 	dbWorkerTaskCacheFactory db.WorkerTaskCacheFactory,
+	quarantiner Quarantiner,
 ) VolumeClient {
 	return &volumeClient{
 		baggageclaimClient:              baggageclaimClient,
@@ -104,6 +106,7 @@ func NewVolumeClient(
 		dbWorkerTaskCacheFactory:        dbWorkerTaskCacheFactory,
 		clock:                           clock,
 		dbWorker:                        dbWorker,
+		quarantiner:                     quarantiner,
 	}
 }
 
@@ -421,6 +424,8 @@ func (c *volumeClient) findOrCreateVolume(
 
 			metric.FailedVolumes.Inc()
 
+			c.quarantiner.VolumeFailed(logger, c.dbWorker, err)
+
 			return nil, err
 		}
 
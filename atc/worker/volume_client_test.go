@@ -33,6 +33,7 @@ var _ = Describe("VolumeClient", func() {
 		fakeWorkerTaskCacheFactory        *dbfakes.FakeWorkerTaskCacheFactory
 		fakeClock                         *fakeclock.FakeClock
 		dbWorker                          *dbfakes.FakeWorker
+		fakeQuarantiner                   *workerfakes.FakeQuarantiner
 
 		volumeClient worker.VolumeClient
 	)
@@ -50,6 +51,7 @@ var _ = Describe("VolumeClient", func() {
 		fakeWorkerBaseResourceTypeFactory = new(dbfakes.FakeWorkerBaseResourceTypeFactory)
 		fakeWorkerTaskCacheFactory = new(dbfakes.FakeWorkerTaskCacheFactory)
 		fakeLock = new(lockfakes.FakeLock)
+		fakeQuarantiner = new(workerfakes.FakeQuarantiner)
 
 		volumeClient = worker.NewVolumeClient(
 			fakeBaggageclaimClient,
@@ -60,6 +62,7 @@ var _ = Describe("VolumeClient", func() {
 			fakeDBVolumeRepository,
 			fakeWorkerBaseResourceTypeFactory,
 			fakeWorkerTaskCacheFactory,
+			fakeQuarantiner,
 		)
 	})
 
@@ -182,6 +185,13 @@ var _ = Describe("VolumeClient", func() {
 						It("marks the creating volume as failed", func() {
 							Expect(fakeCreatingVolume.FailedCallCount()).To(Equal(1))
 						})
+
+						It("counts the failure against the worker", func() {
+							Expect(fakeQuarantiner.VolumeFailedCallCount()).To(Equal(1))
+							_, failedWorker, cause := fakeQuarantiner.VolumeFailedArgsForCall(0)
+							Expect(failedWorker).To(Equal(dbWorker))
+							Expect(cause).To(MatchError("failed to create volume, oh no"))
+						})
 					})
 				})
 
@@ -664,6 +674,7 @@ var _ = Describe("VolumeClient", func() {
 				fakeDBVolumeRepository,
 				fakeWorkerBaseResourceTypeFactory,
 				fakeWorkerTaskCacheFactory,
+				fakeQuarantiner,
 			).LookupVolume(testLogger, handle)
 		})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,11 +32,17 @@ type Worker interface {
 	Client
 
 	ActiveContainers() int
+	BuildContainers() int
+
+	ActiveTasks() int
+	IncreaseActiveTasks(limit int) (bool, error)
+	DecreaseActiveTasks() error
 
 	Description() string
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
 	Tags() atc.Tags
+	Labels() map[string]string
 	Uptime() time.Duration
 	IsOwnedByTeam() bool
 	Ephemeral() bool
@@ -57,6 +64,8 @@ type gardenWorker struct {
 
 	clock clock.Clock
 
+	dbWorker db.Worker
+
 	activeContainers int
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
@@ -84,6 +93,7 @@ func NewGardenWorker(
 		containerProvider:  containerProvider,
 
 		clock:            clock,
+		dbWorker:         dbWorker,
 		activeContainers: dbWorker.ActiveContainers(),
 		resourceTypes:    dbWorker.ResourceTypes(),
 		platform:         dbWorker.Platform(),
@@ -157,6 +167,17 @@ func (worker *gardenWorker) LookupVolume(logger lager.Logger, handle string) (Vo
 	return worker.volumeClient.LookupVolume(logger, handle)
 }
 
+func (worker *gardenWorker) FindOrChooseWorker(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	owner db.ContainerOwner,
+	spec ContainerSpec,
+	resourceTypes creds.VersionedResourceTypes,
+) (Worker, error) {
+	return worker, nil
+}
+
 func (worker *gardenWorker) FindOrCreateContainer(
 	ctx context.Context,
 	logger lager.Logger,
@@ -166,6 +187,7 @@ func (worker *gardenWorker) FindOrCreateContainer(
 	spec ContainerSpec,
 	resourceTypes creds.VersionedResourceTypes,
 ) (Container, error) {
+	delegate.SelectedWorker(logger, worker.Name())
 
 	return worker.containerProvider.FindOrCreateContainer(
 		ctx,
@@ -186,6 +208,22 @@ func (worker *gardenWorker) ActiveContainers() int {
 	return worker.activeContainers
 }
 
+func (worker *gardenWorker) BuildContainers() int {
+	return worker.dbWorker.BuildContainers()
+}
+
+func (worker *gardenWorker) ActiveTasks() int {
+	return worker.dbWorker.ActiveTasks()
+}
+
+func (worker *gardenWorker) IncreaseActiveTasks(limit int) (bool, error) {
+	return worker.dbWorker.IncreaseActiveTasks(limit)
+}
+
+func (worker *gardenWorker) DecreaseActiveTasks() error {
+	return worker.dbWorker.DecreaseActiveTasks()
+}
+
 func (worker *gardenWorker) Satisfying(logger lager.Logger, spec WorkerSpec, resourceTypes creds.VersionedResourceTypes) (Worker, error) {
 	if spec.TeamID != worker.teamID && worker.teamID != 0 {
 		return nil, ErrTeamMismatch
@@ -252,6 +290,19 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	labels := worker.Labels()
+
+	keys := []string{}
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		messages = append(messages, fmt.Sprintf("label '%s=%s'", key, labels[key]))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -267,6 +318,10 @@ func (worker *gardenWorker) Tags() atc.Tags {
 	return worker.tags
 }
 
+func (worker *gardenWorker) Labels() map[string]string {
+	return worker.dbWorker.Labels()
+}
+
 func (worker *gardenWorker) IsOwnedByTeam() bool {
 	return worker.teamID != 0
 }
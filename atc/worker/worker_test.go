@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"context"
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
@@ -11,6 +12,7 @@ import (
 	"github.com/concourse/baggageclaim/baggageclaimfakes"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	wfakes "github.com/concourse/concourse/atc/worker/workerfakes"
@@ -190,6 +192,46 @@ var _ = Describe("Worker", func() {
 
 	})
 
+	Describe("FindOrCreateContainer", func() {
+		var (
+			fakeDelegate      *wfakes.FakeImageFetchingDelegate
+			existingContainer *wfakes.FakeContainer
+
+			foundContainer Container
+			findErr        error
+		)
+
+		BeforeEach(func() {
+			fakeDelegate = new(wfakes.FakeImageFetchingDelegate)
+			existingContainer = new(wfakes.FakeContainer)
+			fakeContainerProvider.FindOrCreateContainerReturns(existingContainer, nil)
+		})
+
+		JustBeforeEach(func() {
+			foundContainer, findErr = gardenWorker.FindOrCreateContainer(
+				context.TODO(),
+				logger,
+				fakeDelegate,
+				db.NewBuildStepContainerOwner(42, "some-plan-id"),
+				db.ContainerMetadata{},
+				ContainerSpec{},
+				nil,
+			)
+		})
+
+		It("finds or creates the container through the container provider", func() {
+			Expect(findErr).ToNot(HaveOccurred())
+			Expect(foundContainer).To(Equal(existingContainer))
+			Expect(fakeContainerProvider.FindOrCreateContainerCallCount()).To(Equal(1))
+		})
+
+		It("tells the delegate which worker was selected", func() {
+			Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
+			_, selectedWorker := fakeDelegate.SelectedWorkerArgsForCall(0)
+			Expect(selectedWorker).To(Equal("some-worker"))
+		})
+	})
+
 	Describe("Satisfying", func() {
 		var (
 			spec WorkerSpec
@@ -4,12 +4,20 @@ package workerfakes
 import (
 	io "io"
 	sync "sync"
+	time "time"
 
+	lager "code.cloudfoundry.org/lager"
 	db "github.com/concourse/concourse/atc/db"
 	worker "github.com/concourse/concourse/atc/worker"
 )
 
 type FakeImageFetchingDelegate struct {
+	ImageFetchedStub        func(lager.Logger, time.Duration)
+	imageFetchedMutex       sync.RWMutex
+	imageFetchedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 time.Duration
+	}
 	ImageVersionDeterminedStub        func(db.UsedResourceCache) error
 	imageVersionDeterminedMutex       sync.RWMutex
 	imageVersionDeterminedArgsForCall []struct {
@@ -21,6 +29,19 @@ type FakeImageFetchingDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	InputStreamedStub        func(lager.Logger, int64, time.Duration)
+	inputStreamedMutex       sync.RWMutex
+	inputStreamedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int64
+		arg3 time.Duration
+	}
+	SelectedWorkerStub        func(lager.Logger, string)
+	selectedWorkerMutex       sync.RWMutex
+	selectedWorkerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	StderrStub        func() io.Writer
 	stderrMutex       sync.RWMutex
 	stderrArgsForCall []struct {
@@ -45,6 +66,32 @@ type FakeImageFetchingDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImageFetchingDelegate) ImageFetched(arg1 lager.Logger, arg2 time.Duration) {
+	fake.imageFetchedMutex.Lock()
+	fake.imageFetchedArgsForCall = append(fake.imageFetchedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("ImageFetched", []interface{}{arg1, arg2})
+	fake.imageFetchedMutex.Unlock()
+	if fake.ImageFetchedStub != nil {
+		fake.ImageFetchedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeImageFetchingDelegate) ImageFetchedCallCount() int {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return len(fake.imageFetchedArgsForCall)
+}
+
+func (fake *FakeImageFetchingDelegate) ImageFetchedArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	argsForCall := fake.imageFetchedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeImageFetchingDelegate) ImageVersionDetermined(arg1 db.UsedResourceCache) error {
 	fake.imageVersionDeterminedMutex.Lock()
 	ret, specificReturn := fake.imageVersionDeterminedReturnsOnCall[len(fake.imageVersionDeterminedArgsForCall)]
@@ -95,6 +142,59 @@ func (fake *FakeImageFetchingDelegate) ImageVersionDeterminedReturnsOnCall(i int
 	}{result1}
 }
 
+func (fake *FakeImageFetchingDelegate) InputStreamed(arg1 lager.Logger, arg2 int64, arg3 time.Duration) {
+	fake.inputStreamedMutex.Lock()
+	fake.inputStreamedArgsForCall = append(fake.inputStreamedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int64
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("InputStreamed", []interface{}{arg1, arg2, arg3})
+	fake.inputStreamedMutex.Unlock()
+	if fake.InputStreamedStub != nil {
+		fake.InputStreamedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeImageFetchingDelegate) InputStreamedCallCount() int {
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	return len(fake.inputStreamedArgsForCall)
+}
+
+func (fake *FakeImageFetchingDelegate) InputStreamedArgsForCall(i int) (lager.Logger, int64, time.Duration) {
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	argsForCall := fake.inputStreamedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImageFetchingDelegate) SelectedWorker(arg1 lager.Logger, arg2 string) {
+	fake.selectedWorkerMutex.Lock()
+	fake.selectedWorkerArgsForCall = append(fake.selectedWorkerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SelectedWorker", []interface{}{arg1, arg2})
+	fake.selectedWorkerMutex.Unlock()
+	if fake.SelectedWorkerStub != nil {
+		fake.SelectedWorkerStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeImageFetchingDelegate) SelectedWorkerCallCount() int {
+	fake.selectedWorkerMutex.RLock()
+	defer fake.selectedWorkerMutex.RUnlock()
+	return len(fake.selectedWorkerArgsForCall)
+}
+
+func (fake *FakeImageFetchingDelegate) SelectedWorkerArgsForCall(i int) (lager.Logger, string) {
+	fake.selectedWorkerMutex.RLock()
+	defer fake.selectedWorkerMutex.RUnlock()
+	argsForCall := fake.selectedWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeImageFetchingDelegate) Stderr() io.Writer {
 	fake.stderrMutex.Lock()
 	ret, specificReturn := fake.stderrReturnsOnCall[len(fake.stderrArgsForCall)]
@@ -182,8 +282,14 @@ func (fake *FakeImageFetchingDelegate) StdoutReturnsOnCall(i int, result1 io.Wri
 func (fake *FakeImageFetchingDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	fake.selectedWorkerMutex.RLock()
+	defer fake.selectedWorkerMutex.RUnlock()
 	fake.stderrMutex.RLock()
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
@@ -62,3 +62,51 @@ var _ = Describe("Worker", func() {
 		})
 	})
 })
+
+var _ = Describe("MaintenanceWindow", func() {
+	Describe("Validate", func() {
+		var window atc.MaintenanceWindow
+
+		BeforeEach(func() {
+			window = atc.MaintenanceWindow{
+				Start:     1542200000,
+				Duration:  3600,
+				Behaviour: atc.MaintenanceBehaviourLand,
+			}
+		})
+
+		It("returns no errors", func() {
+			Expect(window.Validate()).To(Succeed())
+		})
+
+		Context("when the start is missing", func() {
+			BeforeEach(func() {
+				window.Start = 0
+			})
+
+			It("returns an error", func() {
+				Expect(window.Validate()).To(Equal(atc.ErrMissingMaintenanceStart))
+			})
+		})
+
+		Context("when the duration is not positive", func() {
+			BeforeEach(func() {
+				window.Duration = 0
+			})
+
+			It("returns an error", func() {
+				Expect(window.Validate()).To(Equal(atc.ErrInvalidMaintenanceDuration))
+			})
+		})
+
+		Context("when the behaviour is unknown", func() {
+			BeforeEach(func() {
+				window.Behaviour = "reboot"
+			})
+
+			It("returns an error", func() {
+				Expect(window.Validate()).To(Equal(atc.ErrInvalidMaintenanceBehaviour))
+			})
+		})
+	})
+})
@@ -46,6 +46,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListAllJobs,
 			atc.ListAllResources,
 			atc.ListBuilds,
+			atc.ListPendingBuilds,
 			atc.MainJobBadge:
 
 		// pipeline is public or authorized
@@ -69,10 +70,15 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.PruneWorker,
 			atc.LandWorker,
 			atc.RetireWorker,
+			atc.UnquarantineWorker,
+			atc.GetWorkerMaintenance,
+			atc.ScheduleWorkerMaintenance,
+			atc.CancelWorkerMaintenance,
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
-			atc.ReportWorkerVolumes:
+			atc.ReportWorkerVolumes,
+			atc.ReportWorkerVolumeSizes:
 			newHandler = wrappa.checkWorkerTeamAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// pipeline is public or authorized
@@ -82,6 +88,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.JobBadge,
 			atc.ListJobs,
 			atc.GetJob,
+			atc.GetJobSchedulingStatus,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
 			atc.GetResource,
@@ -112,6 +119,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		case atc.GetLogLevel,
 			atc.SetLogLevel,
+			atc.DrainBuilds,
 			atc.GetInfoCreds:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
@@ -119,10 +127,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.CheckResource,
 			atc.CheckResourceType,
 			atc.CreateJobBuild,
+			atc.RerunJobBuild,
 			atc.CreatePipelineBuild,
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
+			atc.PinResourceVersion,
+			atc.UnpinResource,
 			atc.GetConfig,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
@@ -133,11 +144,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenamePipeline,
 			atc.UnpauseJob,
 			atc.UnpausePipeline,
+			atc.ArchivePipeline,
 			atc.UnpauseResource,
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.SaveConfig,
-			atc.ClearTaskCache:
+			atc.ClearTaskCache,
+			atc.GetTeamUsage:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
@@ -146,6 +146,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.CheckResourceWebHook: unauthenticated(inputHandlers[atc.CheckResourceWebHook]),
 				atc.ListAllPipelines:     unauthenticated(inputHandlers[atc.ListAllPipelines]),
 				atc.ListBuilds:           unauthenticated(inputHandlers[atc.ListBuilds]),
+				atc.ListPendingBuilds:    unauthenticated(inputHandlers[atc.ListPendingBuilds]),
 				atc.ListPipelines:        unauthenticated(inputHandlers[atc.ListPipelines]),
 				atc.ListAllJobs:          unauthenticated(inputHandlers[atc.ListAllJobs]),
 				atc.ListAllResources:     unauthenticated(inputHandlers[atc.ListAllResources]),
@@ -167,13 +168,18 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ReadOutputFromBuildPlan: checkWritePermissionForBuild(inputHandlers[atc.ReadOutputFromBuildPlan]),
 
 				// resource belongs to authorized team
-				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
-				atc.LandWorker:               checkTeamAccessForWorker(inputHandlers[atc.LandWorker]),
-				atc.ReportWorkerContainers:   checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerContainers]),
-				atc.ReportWorkerVolumes:      checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumes]),
-				atc.RetireWorker:             checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
-				atc.ListDestroyingContainers: checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingContainers]),
-				atc.ListDestroyingVolumes:    checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingVolumes]),
+				atc.PruneWorker:               checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
+				atc.LandWorker:                checkTeamAccessForWorker(inputHandlers[atc.LandWorker]),
+				atc.ReportWorkerContainers:    checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerContainers]),
+				atc.ReportWorkerVolumes:       checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumes]),
+				atc.ReportWorkerVolumeSizes:   checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumeSizes]),
+				atc.RetireWorker:              checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
+				atc.UnquarantineWorker:        checkTeamAccessForWorker(inputHandlers[atc.UnquarantineWorker]),
+				atc.GetWorkerMaintenance:      checkTeamAccessForWorker(inputHandlers[atc.GetWorkerMaintenance]),
+				atc.ScheduleWorkerMaintenance: checkTeamAccessForWorker(inputHandlers[atc.ScheduleWorkerMaintenance]),
+				atc.CancelWorkerMaintenance:   checkTeamAccessForWorker(inputHandlers[atc.CancelWorkerMaintenance]),
+				atc.ListDestroyingContainers:  checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingContainers]),
+				atc.ListDestroyingVolumes:     checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingVolumes]),
 
 				// belongs to public pipeline or authorized
 				atc.GetPipeline:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipeline]),
@@ -182,6 +188,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.JobBadge:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.JobBadge]),
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
+				atc.GetJobSchedulingStatus:        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJobSchedulingStatus]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
 				atc.ListPipelineBuilds:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBuilds]),
 				atc.GetResource:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResource]),
@@ -211,15 +218,19 @@ var _ = Describe("APIAuthWrappa", func() {
 				// authenticated and is admin
 				atc.GetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
 				atc.SetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
+				atc.DrainBuilds:  authenticatedAndAdmin(inputHandlers[atc.DrainBuilds]),
 				atc.GetInfoCreds: authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
 
 				// authorized (requested team matches resource team)
 				atc.CheckResource:          authorized(inputHandlers[atc.CheckResource]),
 				atc.CheckResourceType:      authorized(inputHandlers[atc.CheckResourceType]),
 				atc.CreateJobBuild:         authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:          authorized(inputHandlers[atc.RerunJobBuild]),
 				atc.DeletePipeline:         authorized(inputHandlers[atc.DeletePipeline]),
 				atc.DisableResourceVersion: authorized(inputHandlers[atc.DisableResourceVersion]),
 				atc.EnableResourceVersion:  authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:     authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:          authorized(inputHandlers[atc.UnpinResource]),
 				atc.GetConfig:              authorized(inputHandlers[atc.GetConfig]),
 				atc.GetVersionsDB:          authorized(inputHandlers[atc.GetVersionsDB]),
 				atc.ListJobInputs:          authorized(inputHandlers[atc.ListJobInputs]),
@@ -231,11 +242,13 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.SaveConfig:             authorized(inputHandlers[atc.SaveConfig]),
 				atc.UnpauseJob:             authorized(inputHandlers[atc.UnpauseJob]),
 				atc.UnpausePipeline:        authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ArchivePipeline:        authorized(inputHandlers[atc.ArchivePipeline]),
 				atc.UnpauseResource:        authorized(inputHandlers[atc.UnpauseResource]),
 				atc.ExposePipeline:         authorized(inputHandlers[atc.ExposePipeline]),
 				atc.HidePipeline:           authorized(inputHandlers[atc.HidePipeline]),
 				atc.CreatePipelineBuild:    authorized(inputHandlers[atc.CreatePipelineBuild]),
 				atc.ClearTaskCache:         authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.GetTeamUsage:           authorized(inputHandlers[atc.GetTeamUsage]),
 			}
 		})
 
@@ -23,6 +23,7 @@ type BuildsCommand struct {
 	Job      flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
 	Team     bool                     `short:"t"  long:"team" description:"Only show builds for the currently targeted team"`
+	Pending  bool                     `long:"pending" description:"Show pending builds in the order they will be started, with their position in the build queue"`
 	Json     bool                     `long:"json" description:"Print command result as JSON"`
 }
 
@@ -37,6 +38,10 @@ func (command *BuildsCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.Pending {
+		return command.showPendingBuilds(target.Client())
+	}
+
 	page := concourse.Page{Limit: command.Count}
 
 	team := target.Team()
@@ -162,6 +167,47 @@ func (command *BuildsCommand) Execute([]string) error {
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
 
+func (command *BuildsCommand) showPendingBuilds(client concourse.Client) error {
+	builds, err := client.PendingBuilds()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(builds)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "position", Color: color.New(color.Bold)},
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "priority", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, b := range builds {
+		var positionCell ui.TableCell
+		if b.QueuePosition != 0 {
+			positionCell.Contents = strconv.Itoa(b.QueuePosition)
+		} else {
+			// still waiting for e.g. its inputs before it can join the queue
+			positionCell.Contents = "n/a"
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			positionCell,
+			{Contents: strconv.Itoa(b.ID)},
+			{Contents: fmt.Sprintf("%s/%s", b.PipelineName, b.JobName)},
+			{Contents: b.Name},
+			{Contents: strconv.Itoa(b.Priority)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
 func populateTimeCells(startTime time.Time, endTime time.Time) (ui.TableCell, ui.TableCell, ui.TableCell) {
 	var startTimeCell ui.TableCell
 	var endTimeCell ui.TableCell
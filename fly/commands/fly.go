@@ -33,6 +33,7 @@ type FlyCommand struct {
 	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
 
 	Jobs       JobsCommand       `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
+	JobStatus  JobStatusCommand  `command:"job-status" alias:"jst" description:"Explain why a job's builds are not being scheduled"`
 	PauseJob   PauseJobCommand   `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob UnpauseJobCommand `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 
@@ -42,6 +43,7 @@ type FlyCommand struct {
 	SetPipeline       SetPipelineCommand       `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
 	PausePipeline     PausePipelineCommand     `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	UnpausePipeline   UnpausePipelineCommand   `command:"unpause-pipeline"    alias:"up"   description:"Un-pause a pipeline"`
+	ArchivePipeline   ArchivePipelineCommand   `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
 	ExposePipeline    ExposePipelineCommand    `command:"expose-pipeline"     alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline      HidePipelineCommand      `command:"hide-pipeline"       alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline    RenamePipelineCommand    `command:"rename-pipeline"     alias:"rp"   description:"Rename a pipeline"`
@@ -52,19 +54,28 @@ type FlyCommand struct {
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 	PauseResource     PauseResourceCommand     `command:"pause-resource"      alias:"pr"   description:"Pause a resource"`
 	UnpauseResource   UnpauseResourceCommand   `command:"unpause-resource"    alias:"ur"   description:"Unpause a resource"`
+	PinResource       PinResourceCommand       `command:"pin-resource"        alias:"pir"  description:"Pin a version of a resource"`
+	UnpinResource     UnpinResourceCommand     `command:"unpin-resource"      alias:"upir" description:"Unpin a resource"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
+	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build with the same inputs"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
-	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
-	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
-	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	Usage UsageCommand `command:"usage" alias:"u" description:"Show the worker resources used by the team"`
+
+	Workers            WorkersCommand            `command:"workers" alias:"ws" description:"List the registered workers"`
+	LandWorker         LandWorkerCommand         `command:"land-worker" alias:"lw" description:"Land a worker"`
+	PruneWorker        PruneWorkerCommand        `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	UnquarantineWorker UnquarantineWorkerCommand `command:"unquarantine-worker" alias:"uqw" description:"Put a quarantined worker back to work"`
+
+	WorkerMaintenance         WorkerMaintenanceCommand         `command:"worker-maintenance" alias:"wm" description:"Show a worker's scheduled maintenance and the builds holding it up"`
+	ScheduleWorkerMaintenance ScheduleWorkerMaintenanceCommand `command:"schedule-worker-maintenance" alias:"swm" description:"Schedule landing or retiring a worker for maintenance"`
 }
 
 var Fly FlyCommand
@@ -44,7 +44,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	config, rawConfig, _, _, err := target.Team().PipelineConfig(pipelineName)
+	config, rawConfig, _, _, err := target.Team().PipelineConfig(atc.PipelineRef{Name: pipelineName})
 	if err != nil {
 		if _, ok := err.(concourse.PipelineConfigError); ok {
 			dumpRawConfig(rawConfig, asJSON)
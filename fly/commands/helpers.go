@@ -80,3 +80,41 @@ func SliceItoa(slice []int) string {
 	}
 	return strSlice
 }
+
+// findResourceVersion finds the saved version of a resource which has all of
+// the given fields, so that versions can be identified without spelling out
+// every field.
+func findResourceVersion(team concourse.Team, pipelineName string, resourceName string, fields atc.Version) (atc.VersionedResource, bool, error) {
+	page := &concourse.Page{Limit: 100}
+
+	for page != nil {
+		versionedResources, pagination, found, err := team.ResourceVersions(pipelineName, resourceName, *page)
+		if err != nil {
+			return atc.VersionedResource{}, false, err
+		}
+
+		if !found {
+			return atc.VersionedResource{}, false, fmt.Errorf("pipeline '%s' or resource '%s' not found\n", pipelineName, resourceName)
+		}
+
+		for _, versionedResource := range versionedResources {
+			if versionMatches(versionedResource.Version, fields) {
+				return versionedResource, true, nil
+			}
+		}
+
+		page = pagination.Next
+	}
+
+	return atc.VersionedResource{}, false, nil
+}
+
+func versionMatches(version atc.Version, fields atc.Version) bool {
+	for key, value := range fields {
+		if version[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
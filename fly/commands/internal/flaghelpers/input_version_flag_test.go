@@ -0,0 +1,38 @@
+package flaghelpers_test
+
+import (
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InputVersionFlag", func() {
+	It("parses the input name and version field", func() {
+		flag := &InputVersionFlag{}
+
+		err := flag.UnmarshalFlag("some-input=ref:a:b")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flag.Name).To(Equal("some-input"))
+		Expect(flag.Key).To(Equal("ref"))
+		Expect(flag.Value).To(Equal("a:b"))
+	})
+
+	Context("when there is no version", func() {
+		It("displays an error message", func() {
+			flag := &InputVersionFlag{}
+
+			err := flag.UnmarshalFlag("some-input")
+			Expect(err).To(MatchError("invalid input version 'some-input' (must be name=key:value)"))
+		})
+	})
+
+	Context("when the version has no key", func() {
+		It("displays an error message", func() {
+			flag := &InputVersionFlag{}
+
+			err := flag.UnmarshalFlag("some-input=abcdef")
+			Expect(err).To(MatchError("invalid input version 'some-input=abcdef' (must be name=key:value)"))
+		})
+	})
+})
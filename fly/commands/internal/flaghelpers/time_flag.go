@@ -0,0 +1,28 @@
+package flaghelpers
+
+import (
+	"errors"
+	"time"
+)
+
+// TimeFlag is a point in time given either as an RFC3339 timestamp or as a
+// duration from now.
+type TimeFlag struct {
+	time.Time
+}
+
+func (flag *TimeFlag) UnmarshalFlag(value string) error {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		flag.Time = t
+		return nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err == nil {
+		flag.Time = time.Now().Add(d)
+		return nil
+	}
+
+	return errors.New("time should be an RFC3339 timestamp (e.g. 2006-01-02T15:04:05Z) or a duration from now (e.g. 2h)")
+}
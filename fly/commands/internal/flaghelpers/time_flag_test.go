@@ -0,0 +1,35 @@
+package flaghelpers_test
+
+import (
+	"time"
+
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TimeFlag", func() {
+	var timeFlag *TimeFlag
+
+	BeforeEach(func() {
+		timeFlag = &TimeFlag{}
+	})
+
+	It("parses an RFC3339 timestamp", func() {
+		err := timeFlag.UnmarshalFlag("2018-11-14T02:00:00Z")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(timeFlag.Time).To(Equal(time.Date(2018, 11, 14, 2, 0, 0, 0, time.UTC)))
+	})
+
+	It("parses a duration from now", func() {
+		err := timeFlag.UnmarshalFlag("2h")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(timeFlag.Time).To(BeTemporally("~", time.Now().Add(2*time.Hour), time.Minute))
+	})
+
+	It("rejects anything else", func() {
+		err := timeFlag.UnmarshalFlag("tomorrow")
+		Expect(err).To(MatchError(ContainSubstring("time should be an RFC3339 timestamp")))
+	})
+})
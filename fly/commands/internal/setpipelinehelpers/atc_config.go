@@ -15,12 +15,11 @@ import (
 	temp "github.com/concourse/concourse/fly/template"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
-	"github.com/onsi/gomega/gexec"
 	"github.com/vito/go-interact/interact"
 )
 
 type ATCConfig struct {
-	PipelineName     string
+	PipelineRef      atc.PipelineRef
 	Team             concourse.Team
 	Target           string
 	SkipInteraction  bool
@@ -99,7 +98,7 @@ func (atcConfig ATCConfig) Set(configPath atc.PathFlag, templateVariables []flag
 	if err != nil {
 		return err
 	}
-	existingConfig, _, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
+	existingConfig, _, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	errorMessages := []string{}
 	if err != nil {
 		if configError, ok := err.(concourse.PipelineConfigError); ok {
@@ -115,7 +114,7 @@ func (atcConfig ATCConfig) Set(configPath atc.PathFlag, templateVariables []flag
 		return err
 	}
 
-	diffExists := diff(existingConfig, new)
+	diffExists := existingConfig.Diff(os.Stdout, new)
 
 	if len(errorMessages) > 0 {
 		atcConfig.showPipelineConfigErrors(errorMessages)
@@ -132,7 +131,7 @@ func (atcConfig ATCConfig) Set(configPath atc.PathFlag, templateVariables []flag
 	}
 
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
-		atcConfig.PipelineName,
+		atcConfig.PipelineRef,
 		existingConfigVersion,
 		newConfig,
 		atcConfig.CheckCredentials,
@@ -293,11 +292,13 @@ func (atcConfig ATCConfig) showHelpfulMessage(created bool, updated bool) {
 			fmt.Println("Could not parse targetURL")
 		}
 
-		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineName)
+		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineRef.Name)
 		if err != nil {
 			fmt.Println("Could not parse pipelineURL")
 		}
 
+		pipelineURL.RawQuery = atcConfig.PipelineRef.QueryParams().Encode()
+
 		fmt.Println("pipeline created!")
 		fmt.Printf("you can view your pipeline here: %s\n", targetURL.ResolveReference(pipelineURL))
 		fmt.Println("")
@@ -308,50 +309,3 @@ func (atcConfig ATCConfig) showHelpfulMessage(created bool, updated bool) {
 		panic("Something really went wrong!")
 	}
 }
-
-func diff(existingConfig atc.Config, newConfig atc.Config) bool {
-	var diffExists bool
-
-	indent := gexec.NewPrefixedWriter("  ", os.Stdout)
-
-	groupDiffs := groupDiffIndices(GroupIndex(existingConfig.Groups), GroupIndex(newConfig.Groups))
-	if len(groupDiffs) > 0 {
-		diffExists = true
-		fmt.Println("groups:")
-
-		for _, diff := range groupDiffs {
-			diff.Render(indent, "group")
-		}
-	}
-
-	resourceDiffs := diffIndices(ResourceIndex(existingConfig.Resources), ResourceIndex(newConfig.Resources))
-	if len(resourceDiffs) > 0 {
-		diffExists = true
-		fmt.Println("resources:")
-
-		for _, diff := range resourceDiffs {
-			diff.Render(indent, "resource")
-		}
-	}
-
-	resourceTypeDiffs := diffIndices(ResourceTypeIndex(existingConfig.ResourceTypes), ResourceTypeIndex(newConfig.ResourceTypes))
-	if len(resourceTypeDiffs) > 0 {
-		diffExists = true
-		fmt.Println("resource types:")
-
-		for _, diff := range resourceTypeDiffs {
-			diff.Render(indent, "resource type")
-		}
-	}
-
-	jobDiffs := diffIndices(JobIndex(existingConfig.Jobs), JobIndex(newConfig.Jobs))
-	if len(jobDiffs) > 0 {
-		diffExists = true
-		fmt.Println("jobs:")
-
-		for _, diff := range jobDiffs {
-			diff.Render(indent, "job")
-		}
-	}
-	return diffExists
-}
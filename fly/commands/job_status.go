@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type JobStatusCommand struct {
+	Job  flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to explain the scheduling of"`
+	Json bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *JobStatusCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	status, found, err := target.Team().JobSchedulingStatus(command.Job.PipelineName, command.Job.JobName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found\n", command.Job.PipelineName, command.Job.JobName)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(status)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if status.PendingBuildName != "" {
+		fmt.Printf("next pending build: #%s\n", status.PendingBuildName)
+	} else {
+		fmt.Println("no pending build")
+	}
+
+	if len(status.Blockers) == 0 {
+		fmt.Println("nothing is blocking the job from being scheduled")
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "reason", Color: color.New(color.Bold)},
+			{Contents: "details", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, blocker := range status.Blockers {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: string(blocker.Reason), Color: color.New(color.FgYellow)},
+			{Contents: blockerDetails(blocker)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func blockerDetails(blocker atc.SchedulingBlocker) string {
+	switch blocker.Reason {
+	case atc.SchedulingBlockerPipelinePaused:
+		return "the pipeline is paused"
+	case atc.SchedulingBlockerJobPaused:
+		return "the job is paused"
+	case atc.SchedulingBlockerMaxInFlightReached:
+		return fmt.Sprintf("build %s #%s is running", blocker.JobName, blocker.BuildName)
+	case atc.SchedulingBlockerSerialGroupBlocked:
+		return fmt.Sprintf("waiting for build %s #%s", blocker.JobName, blocker.BuildName)
+	case atc.SchedulingBlockerInputUnsatisfied:
+		switch blocker.InputReason {
+		case "passed_constraints":
+			return fmt.Sprintf("input '%s': no version of '%s' satisfies passed: [%s]", blocker.Input, blocker.Resource, strings.Join(blocker.PassedJobs, ", "))
+		case "pinned_version_unavailable":
+			return fmt.Sprintf("input '%s': the pinned version of '%s' is not available", blocker.Input, blocker.Resource)
+		default:
+			return fmt.Sprintf("input '%s': '%s' has no versions", blocker.Input, blocker.Resource)
+		}
+	case atc.SchedulingBlockerTeamQuotaReached:
+		return fmt.Sprintf("pending: team quota reached (%d of %d builds running)", blocker.ActiveBuilds, blocker.MaxConcurrentBuilds)
+	case atc.SchedulingBlockerCheckErrored:
+		if blocker.CheckError != "" {
+			return fmt.Sprintf("input '%s': checking '%s' failed: %s", blocker.Input, blocker.Resource, blocker.CheckError)
+		}
+		return fmt.Sprintf("input '%s': checking '%s' failed", blocker.Input, blocker.Resource)
+	}
+
+	return ""
+}
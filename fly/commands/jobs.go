@@ -2,6 +2,7 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -44,7 +45,7 @@ func (command *JobsCommand) Execute([]string) error {
 		return nil
 	}
 
-	headers = []string{"name", "paused", "status", "next"}
+	headers = []string{"name", "paused", "status", "next", "scheduled"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -102,6 +103,14 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		var scheduledColumn ui.TableCell
+		if p.NextScheduled != 0 {
+			scheduledColumn.Contents = time.Unix(p.NextScheduled, 0).Local().Format(timeDateLayout)
+		} else {
+			scheduledColumn.Contents = "n/a"
+		}
+		row = append(row, scheduledColumn)
+
 		table.Data = append(table.Data, row)
 	}
 
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type PinResourceCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource to pin"`
+	Version  atc.Version              `short:"v" long:"version"  required:"true" value-name:"KEY:VALUE"         description:"Version of the resource to pin, e.g. ref:abcd. Can be specified multiple times for versions with several fields."`
+	Comment  string                   `short:"c" long:"comment"                  value-name:"COMMENT"           description:"Reason for pinning the version, shown alongside the resource"`
+}
+
+func (command *PinResourceCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	versionedResource, found, err := findResourceVersion(team, command.Resource.PipelineName, command.Resource.ResourceName, command.Version)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("could not find version matching %v\n", command.Version)
+	}
+
+	pinned, err := team.PinResourceVersion(command.Resource.PipelineName, command.Resource.ResourceName, versionedResource.ID, command.Comment)
+	if err != nil {
+		return err
+	}
+
+	if !pinned {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	fmt.Printf("pinned '%s' with version %v\n", command.Resource.ResourceName, versionedResource.Version)
+	return nil
+}
@@ -11,8 +11,9 @@ import (
 )
 
 type PipelinesCommand struct {
-	All  bool `short:"a"  long:"all" description:"Show all pipelines"`
-	Json bool `long:"json" description:"Print command result as JSON"`
+	All             bool `short:"a"  long:"all" description:"Show all pipelines"`
+	IncludeArchived bool `long:"include-archived" description:"Show archived pipelines"`
+	Json            bool `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *PipelinesCommand) Execute([]string) error {
@@ -40,6 +41,12 @@ func (command *PipelinesCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.IncludeArchived {
+		headers = append(headers, "archived")
+	} else {
+		pipelines = unarchivedPipelines(pipelines)
+	}
+
 	if command.Json {
 		err = displayhelpers.JsonPrint(pipelines)
 		if err != nil {
@@ -48,12 +55,17 @@ func (command *PipelinesCommand) Execute([]string) error {
 		return nil
 	}
 
+	pipelines, instanced := groupPipelineInstances(pipelines)
+	if instanced {
+		headers = append(headers[:1], append([]string{"instance vars"}, headers[1:]...)...)
+	}
+
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
 	}
 
-	for _, p := range pipelines {
+	for i, p := range pipelines {
 		var pausedColumn ui.TableCell
 		if p.Paused {
 			pausedColumn.Contents = "yes"
@@ -71,15 +83,86 @@ func (command *PipelinesCommand) Execute([]string) error {
 		}
 
 		row := ui.TableRow{}
-		row = append(row, ui.TableCell{Contents: p.Name})
+
+		// only show the name for the first of a pipeline's instances
+		if i > 0 && pipelines[i-1].Name == p.Name && pipelines[i-1].TeamName == p.TeamName {
+			row = append(row, ui.TableCell{Contents: ""})
+		} else {
+			row = append(row, ui.TableCell{Contents: p.Name})
+		}
+
+		if instanced {
+			if len(p.InstanceVars) == 0 {
+				row = append(row, ui.TableCell{Contents: "n/a"})
+			} else {
+				row = append(row, ui.TableCell{Contents: p.InstanceVars.String()})
+			}
+		}
+
 		if command.All {
 			row = append(row, ui.TableCell{Contents: p.TeamName})
 		}
 		row = append(row, pausedColumn)
 		row = append(row, publicColumn)
 
+		if command.IncludeArchived {
+			var archivedColumn ui.TableCell
+			if p.Archived {
+				archivedColumn.Contents = "yes"
+				archivedColumn.Color = color.New(color.FgCyan)
+			} else {
+				archivedColumn.Contents = "no"
+			}
+
+			row = append(row, archivedColumn)
+		}
+
 		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+// groupPipelineInstances moves the instances of each pipeline next to the
+// first pipeline of the same name, and reports whether any pipeline is
+// instanced.
+func groupPipelineInstances(pipelines []atc.Pipeline) ([]atc.Pipeline, bool) {
+	type groupKey struct {
+		team string
+		name string
+	}
+
+	instanced := false
+	order := []groupKey{}
+	groups := map[groupKey][]atc.Pipeline{}
+	for _, p := range pipelines {
+		if len(p.InstanceVars) != 0 {
+			instanced = true
+		}
+
+		key := groupKey{p.TeamName, p.Name}
+		if _, found := groups[key]; !found {
+			order = append(order, key)
+		}
+
+		groups[key] = append(groups[key], p)
+	}
+
+	grouped := make([]atc.Pipeline, 0, len(pipelines))
+	for _, key := range order {
+		grouped = append(grouped, groups[key]...)
+	}
+
+	return grouped, instanced
+}
+
+func unarchivedPipelines(pipelines []atc.Pipeline) []atc.Pipeline {
+	unarchived := []atc.Pipeline{}
+	for _, p := range pipelines {
+		if !p.Archived {
+			unarchived = append(unarchived, p)
+		}
+	}
+
+	return unarchived
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ScheduleWorkerMaintenanceCommand struct {
+	Worker    string                `short:"w" long:"worker" required:"true" description:"Worker to schedule maintenance for"`
+	Start     *flaghelpers.TimeFlag `short:"s" long:"start" description:"When the maintenance starts, as an RFC3339 timestamp or a duration from now"`
+	Duration  time.Duration         `short:"d" long:"duration" default:"1h" description:"How long the maintenance takes"`
+	Behaviour string                `short:"b" long:"behaviour" default:"land" choice:"land" choice:"retire" description:"Whether to land or retire the worker for the maintenance"`
+	Cancel    bool                  `long:"cancel" description:"Cancel the worker's scheduled maintenance instead"`
+}
+
+func (command *ScheduleWorkerMaintenanceCommand) Execute(args []string) error {
+	if !command.Cancel && command.Start == nil {
+		return errors.New("--start is required unless --cancel is given")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.Cancel {
+		err = target.Client().CancelWorkerMaintenance(command.Worker)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("cancelled maintenance of '%s'\n", command.Worker)
+
+		return nil
+	}
+
+	err = target.Client().ScheduleWorkerMaintenance(command.Worker, atc.MaintenanceWindow{
+		Start:     command.Start.Unix(),
+		Duration:  int64(command.Duration.Seconds()),
+		Behaviour: command.Behaviour,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"scheduled maintenance of '%s' at %s for %s (%s)\n",
+		command.Worker,
+		command.Start.Local().Format(timeDateLayout),
+		command.Duration,
+		command.Behaviour,
+	)
+
+	return nil
+}
@@ -21,6 +21,8 @@ type SetPipelineCommand struct {
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	InstanceVar []flaghelpers.VariablePairFlag `short:"i"  long:"instance-var"  value-name:"[NAME=STRING]"  description:"Set an instance var, configuring one of several instances of the pipeline. Instance vars are also available as variables in the pipeline"`
 }
 
 func (command *SetPipelineCommand) Validate() error {
@@ -34,7 +36,20 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
-	pipelineName := string(command.Pipeline)
+	pipelineRef := atc.PipelineRef{Name: string(command.Pipeline)}
+
+	if len(command.InstanceVar) != 0 {
+		pipelineRef.InstanceVars = atc.InstanceVars{}
+		for _, pair := range command.InstanceVar {
+			pipelineRef.InstanceVars[pair.Name] = pair.Value
+		}
+	}
+
+	// instance vars take the lowest precedence so that they may be overridden
+	// by -v
+	templateVariables := make([]flaghelpers.VariablePairFlag, 0, len(command.InstanceVar)+len(command.Var))
+	templateVariables = append(templateVariables, command.InstanceVar...)
+	templateVariables = append(templateVariables, command.Var...)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -50,11 +65,11 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 
 	atcConfig := setpipelinehelpers.ATCConfig{
 		Team:             target.Team(),
-		PipelineName:     pipelineName,
+		PipelineRef:      pipelineRef,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
 	}
 
-	return atcConfig.Set(configPath, command.Var, command.YAMLVar, templateVariablesFiles)
+	return atcConfig.Set(configPath, templateVariables, command.YAMLVar, templateVariablesFiles)
 }
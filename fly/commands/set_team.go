@@ -27,6 +27,9 @@ type SetTeamCommand struct {
 	TeamName        string               `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
 	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+
+	MaxConcurrentBuilds *int `long:"max-concurrent-builds" description:"Maximum number of the team's builds which may run at once, 0 for no limit (admins only)"`
+	BuildWeight         *int `long:"build-weight" description:"The team's share of the cluster relative to other teams when it is at capacity (admins only)"`
 }
 
 func (command *SetTeamCommand) Execute([]string) error {
@@ -54,6 +57,14 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	fmt.Println("Team Name:", command.TeamName)
 
+	if command.MaxConcurrentBuilds != nil {
+		fmt.Println("Max Concurrent Builds:", *command.MaxConcurrentBuilds)
+	}
+
+	if command.BuildWeight != nil {
+		fmt.Println("Build Weight:", *command.BuildWeight)
+	}
+
 	for _, role := range roles {
 		authUsers := authRoles[role]["users"]
 		authGroups := authRoles[role]["groups"]
@@ -94,7 +105,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: atc.TeamAuth(authRoles)}
+	team := atc.Team{
+		Auth:                atc.TeamAuth(authRoles),
+		MaxConcurrentBuilds: command.MaxConcurrentBuilds,
+		BuildWeight:         command.BuildWeight,
+	}
 
 	_, created, updated, err := target.Client().Team(command.TeamName).CreateOrUpdate(team)
 	if err != nil {
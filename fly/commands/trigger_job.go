@@ -6,15 +6,20 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type TriggerJobCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the build output"`
+	Job          flaghelpers.JobFlag            `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
+	Watch        bool                           `short:"w" long:"watch" description:"Start watching the build output"`
+	Inputs       []flaghelpers.InputVersionFlag `short:"i" long:"input" value-name:"NAME=KEY:VALUE" description:"Version to use for an input, e.g. my-repo=ref:abcd. Inputs which are not given resolve as usual. Can be specified multiple times."`
+	IgnorePassed bool                           `long:"ignore-passed" description:"Use the given input versions even if they have not passed the jobs listed in the inputs' 'passed' constraints"`
+	Priority     *int                           `long:"priority" description:"Priority of the build in the build queue, instead of the job's priority"`
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
@@ -30,9 +35,29 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().CreateJobBuild(pipelineName, jobName)
-	if err != nil {
-		return err
+	team := target.Team()
+
+	var build atc.Build
+	if len(command.Inputs) > 0 || command.Priority != nil {
+		overrides := atc.InputOverrides{}
+		if len(command.Inputs) > 0 {
+			overrides, err = command.inputOverrides(team)
+			if err != nil {
+				return err
+			}
+		}
+
+		overrides.Priority = command.Priority
+
+		build, err = team.CreateJobBuildWithInputOverrides(pipelineName, jobName, overrides)
+		if err != nil {
+			return err
+		}
+	} else {
+		build, err = team.CreateJobBuild(pipelineName, jobName)
+		if err != nil {
+			return err
+		}
 	}
 	fmt.Printf("started %s/%s #%s\n", pipelineName, jobName, build.Name)
 
@@ -64,3 +89,57 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// inputOverrides finds the full versions matching the fields given for each
+// input.
+func (command *TriggerJobCommand) inputOverrides(team concourse.Team) (atc.InputOverrides, error) {
+	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+
+	fields := map[string]atc.Version{}
+	for _, input := range command.Inputs {
+		if fields[input.Name] == nil {
+			fields[input.Name] = atc.Version{}
+		}
+
+		fields[input.Name][input.Key] = input.Value
+	}
+
+	job, found, err := team.Job(pipelineName, jobName)
+	if err != nil {
+		return atc.InputOverrides{}, err
+	}
+
+	if !found {
+		return atc.InputOverrides{}, fmt.Errorf("job '%s' not found in pipeline '%s'", jobName, pipelineName)
+	}
+
+	resources := map[string]string{}
+	for _, input := range job.Inputs {
+		resources[input.Name] = input.Resource
+	}
+
+	overrides := atc.InputOverrides{
+		Inputs:       map[string]atc.Version{},
+		IgnorePassed: command.IgnorePassed,
+	}
+
+	for name, version := range fields {
+		resourceName, found := resources[name]
+		if !found {
+			return atc.InputOverrides{}, fmt.Errorf("job '%s' has no input named '%s'", jobName, name)
+		}
+
+		versionedResource, found, err := findResourceVersion(team, pipelineName, resourceName, version)
+		if err != nil {
+			return atc.InputOverrides{}, err
+		}
+
+		if !found {
+			return atc.InputOverrides{}, fmt.Errorf("could not find version matching %v for input '%s'", version, name)
+		}
+
+		overrides.Inputs[name] = versionedResource.Version
+	}
+
+	return overrides, nil
+}
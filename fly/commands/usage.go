@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type UsageCommand struct {
+	Since   time.Duration `long:"since" default:"24h" description:"How far back to report usage for"`
+	Until   time.Duration `long:"until" default:"0s" description:"How long ago the reported period ends"`
+	GroupBy string        `long:"group-by" choice:"pipeline" choice:"job" description:"Break down the usage by pipeline or job"`
+	Json    bool          `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *UsageCommand) Execute([]string) error {
+	if command.Since <= command.Until {
+		return errors.New("--since must be further back than --until")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	usage, err := target.Team().Usage(now.Add(-command.Since), now.Add(-command.Until), command.GroupBy)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(usage)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{}
+	if command.GroupBy != "" {
+		headers = append(headers, ui.TableCell{Contents: "pipeline", Color: color.New(color.Bold)})
+	}
+
+	if command.GroupBy == "job" {
+		headers = append(headers, ui.TableCell{Contents: "job", Color: color.New(color.Bold)})
+	}
+
+	headers = append(headers,
+		ui.TableCell{Contents: "containers", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "container hours", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "cpu share hours", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "memory gib hours", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "volumes", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "volume hours", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "peak volume gib", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "volume gib hours", Color: color.New(color.Bold)},
+	)
+
+	table := ui.Table{Headers: headers}
+
+	for _, u := range usage.Breakdown {
+		row := ui.TableRow{}
+		if command.GroupBy != "" {
+			row = append(row, namedOrNone(u.PipelineName))
+		}
+
+		if command.GroupBy == "job" {
+			row = append(row, namedOrNone(u.JobName))
+		}
+
+		table.Data = append(table.Data, append(row, usageCells(u)...))
+	}
+
+	if command.GroupBy != "" {
+		total := ui.TableRow{{Contents: "total", Color: color.New(color.Bold)}}
+		if command.GroupBy == "job" {
+			total = append(total, ui.TableCell{Contents: ""})
+		}
+
+		table.Data = append(table.Data, append(total, usageCells(usage.Total)...))
+	} else {
+		table.Data = append(table.Data, usageCells(usage.Total))
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func namedOrNone(name string) ui.TableCell {
+	if name == "" {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	return ui.TableCell{Contents: name}
+}
+
+func usageCells(usage atc.Usage) ui.TableRow {
+	return ui.TableRow{
+		{Contents: strconv.Itoa(usage.Containers)},
+		{Contents: formatHours(usage.ContainerSeconds)},
+		{Contents: formatHours(usage.CPUShareSeconds)},
+		{Contents: formatHours(usage.MemoryByteSeconds / (1 << 30))},
+		{Contents: strconv.Itoa(usage.Volumes)},
+		{Contents: formatHours(usage.VolumeSeconds)},
+		{Contents: strconv.FormatFloat(float64(usage.VolumeBytes)/(1<<30), 'f', 2, 64)},
+		{Contents: formatHours(usage.VolumeByteSeconds / (1 << 30))},
+	}
+}
+
+func formatHours(seconds float64) string {
+	return strconv.FormatFloat(seconds/3600, 'f', 2, 64)
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WorkerMaintenanceCommand struct {
+	Worker string `short:"w" long:"worker" required:"true" description:"Worker to show the maintenance of"`
+	Json   bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WorkerMaintenanceCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	maintenance, found, err := target.Client().WorkerMaintenance(command.Worker)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("worker '%s' has no scheduled maintenance", command.Worker)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(maintenance)
+	}
+
+	start := time.Unix(maintenance.Window.Start, 0).Local()
+	duration := time.Duration(maintenance.Window.Duration) * time.Second
+
+	fmt.Printf("start:     %s\n", start.Format(timeDateLayout))
+	fmt.Printf("end:       %s\n", start.Add(duration).Format(timeDateLayout))
+	fmt.Printf("behaviour: %s\n", maintenance.Window.Behaviour)
+
+	if len(maintenance.BlockingBuilds) == 0 {
+		fmt.Println("")
+		fmt.Println("no builds are holding up the maintenance")
+		return nil
+	}
+
+	fmt.Println("")
+	fmt.Println("the following builds are holding up the maintenance:")
+	fmt.Println("")
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, b := range maintenance.BlockingBuilds {
+		var pipelineJobCell ui.TableCell
+		if b.PipelineName == "" {
+			pipelineJobCell.Contents = "one-off"
+		} else {
+			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", b.PipelineName, b.JobName)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(b.ID)},
+			pipelineJobCell,
+			{Contents: b.Name},
+			{Contents: b.Status},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
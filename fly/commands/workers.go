@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -47,10 +48,18 @@ func (command *WorkersCommand) Execute([]string) error {
 
 	var runningWorkers []worker
 	var stalledWorkers []worker
+	var quarantinedWorkers []worker
 	var outdatedWorkers []worker
+	var maintainedWorkers []worker
 	for _, w := range workers {
+		if w.Maintenance != nil {
+			maintainedWorkers = append(maintainedWorkers, worker{w, false})
+		}
+
 		if w.State == "stalled" {
 			stalledWorkers = append(stalledWorkers, worker{w, false})
+		} else if w.State == "quarantined" {
+			quarantinedWorkers = append(quarantinedWorkers, worker{w, false})
 		} else {
 			workerVersionCompatible, err := target.IsWorkerVersionCompatible(w.Version)
 			if err != nil {
@@ -67,7 +76,7 @@ func (command *WorkersCommand) Execute([]string) error {
 
 	dst, isTTY := ui.ForTTY(os.Stdout)
 	if !isTTY {
-		return command.tableFor(append(append(runningWorkers, outdatedWorkers...), stalledWorkers...)).Render(os.Stdout, Fly.PrintTableHeaders)
+		return command.tableFor(append(append(append(runningWorkers, outdatedWorkers...), quarantinedWorkers...), stalledWorkers...)).Render(os.Stdout, Fly.PrintTableHeaders)
 	}
 
 	err = command.tableFor(runningWorkers).Render(os.Stdout, Fly.PrintTableHeaders)
@@ -92,6 +101,77 @@ func (command *WorkersCommand) Execute([]string) error {
 		}
 	}
 
+	if len(quarantinedWorkers) > 0 {
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "the following workers have been quarantined:")
+		fmt.Fprintln(dst, "")
+
+		table := ui.Table{
+			Headers: ui.TableRow{
+				{Contents: "name", Color: color.New(color.Bold)},
+				{Contents: "reason", Color: color.New(color.Bold)},
+			},
+		}
+
+		for _, w := range quarantinedWorkers {
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: w.Name},
+				stringOrDefault(w.QuarantineReason),
+			})
+		}
+
+		err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "once the problem has been fixed, these workers can be put back to work by running:")
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "    "+ui.Embolden("fly -t %s unquarantine-worker -w (name)", Fly.Target))
+		fmt.Fprintln(dst, "")
+	}
+
+	if len(maintainedWorkers) > 0 {
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "the following workers have scheduled maintenance:")
+		fmt.Fprintln(dst, "")
+
+		table := ui.Table{
+			Headers: ui.TableRow{
+				{Contents: "name", Color: color.New(color.Bold)},
+				{Contents: "start", Color: color.New(color.Bold)},
+				{Contents: "end", Color: color.New(color.Bold)},
+				{Contents: "behaviour", Color: color.New(color.Bold)},
+			},
+		}
+
+		for _, w := range maintainedWorkers {
+			start := time.Unix(w.Maintenance.Start, 0).Local()
+			end := start.Add(time.Duration(w.Maintenance.Duration) * time.Second)
+
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: w.Name},
+				{Contents: start.Format(timeDateLayout)},
+				{Contents: end.Format(timeDateLayout)},
+				{Contents: w.Maintenance.Behaviour},
+			})
+		}
+
+		err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "the builds holding up a worker's maintenance can be shown by running:")
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "    "+ui.Embolden("fly -t %s worker-maintenance -w (name)", Fly.Target))
+		fmt.Fprintln(dst, "")
+	}
+
 	if len(stalledWorkers) > 0 {
 		fmt.Fprintln(dst, "")
 		fmt.Fprintln(dst, "")
@@ -129,6 +209,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			ui.TableCell{Contents: "garden address", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "baggageclaim url", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "resource types", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "labels", Color: color.New(color.Bold)},
 		)
 	}
 
@@ -154,6 +235,15 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			row = append(row, stringOrDefault(w.GardenAddr))
 			row = append(row, stringOrDefault(w.BaggageclaimURL))
 			row = append(row, stringOrDefault(strings.Join(resourceTypes, ", ")))
+
+			var labels []string
+			for key, value := range w.Labels {
+				labels = append(labels, key+"="+value)
+			}
+
+			sort.Strings(labels)
+
+			row = append(row, stringOrDefault(strings.Join(labels, ", ")))
 		}
 
 		table.Data = append(table.Data, row)
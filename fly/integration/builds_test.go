@@ -500,6 +500,74 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when passing the pending argument", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--pending")
+
+				expectedURL = "/api/v1/builds/pending"
+				queryParams = ""
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:            4,
+						PipelineName:  "some-pipeline",
+						JobName:       "some-job",
+						Name:          "64",
+						Status:        "pending",
+						Priority:      10,
+						QueuePosition: 1,
+					},
+					{
+						ID:           5,
+						PipelineName: "some-other-pipeline",
+						JobName:      "some-other-job",
+						Name:         "12",
+						Status:       "pending",
+					},
+				}
+			})
+
+			It("returns the pending builds in queue order", func() {
+				Eventually(session.Out).Should(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "position", Color: color.New(color.Bold)},
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "pipeline/job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "priority", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "1"},
+							{Contents: "4"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "64"},
+							{Contents: "10"},
+						},
+						{
+							{Contents: "n/a"},
+							{Contents: "5"},
+							{Contents: "some-other-pipeline/some-other-job"},
+							{Contents: "12"},
+							{Contents: "0"},
+						},
+					},
+				}))
+				Eventually(session).Should(gexec.Exit(0))
+			})
+
+			Context("when the api returns an error", func() {
+				BeforeEach(func() {
+					returnedStatusCode = http.StatusInternalServerError
+				})
+
+				It("writes an error message to stderr", func() {
+					Eventually(session.Err).Should(gbytes.Say("Unexpected Response"))
+					Eventually(session).Should(gexec.Exit(1))
+				})
+			})
+		})
+
 		Context("when passing the pipeline argument", func() {
 			BeforeEach(func() {
 				cmdArgs = append(cmdArgs, "-p")
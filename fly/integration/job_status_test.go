@@ -0,0 +1,161 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("job-status", func() {
+		var (
+			path   string
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			var err error
+			path, err = atc.Routes.CreatePathForRoute(atc.GetJobSchedulingStatus, rata.Params{
+				"pipeline_name": "awesome-pipeline",
+				"job_name":      "awesome-job",
+				"team_name":     "main",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "job-status", "-j", "awesome-pipeline/awesome-job")
+		})
+
+		Context("when the job is blocked", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobSchedulingStatus{
+							JobName:          "awesome-job",
+							PendingBuildID:   42,
+							PendingBuildName: "7",
+							Blockers: []atc.SchedulingBlocker{
+								{Reason: atc.SchedulingBlockerPipelinePaused},
+								{
+									Reason:    atc.SchedulingBlockerSerialGroupBlocked,
+									BuildID:   41,
+									BuildName: "3",
+									JobName:   "other-job",
+								},
+								{
+									Reason:      atc.SchedulingBlockerInputUnsatisfied,
+									Input:       "some-input",
+									Resource:    "some-resource",
+									InputReason: "passed_constraints",
+									PassedJobs:  []string{"job-1", "job-2"},
+								},
+								{
+									Reason:              atc.SchedulingBlockerTeamQuotaReached,
+									ActiveBuilds:        4,
+									MaxConcurrentBuilds: 4,
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("explains what is blocking it", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say(`next pending build: #7`))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "pipeline_paused", Color: color.New(color.FgYellow)}, {Contents: "the pipeline is paused"}},
+						{{Contents: "serial_group_blocked", Color: color.New(color.FgYellow)}, {Contents: "waiting for build other-job #3"}},
+						{{Contents: "input_unsatisfied", Color: color.New(color.FgYellow)}, {Contents: "input 'some-input': no version of 'some-resource' satisfies passed: [job-1, job-2]"}},
+						{{Contents: "team_quota_reached", Color: color.New(color.FgYellow)}, {Contents: "pending: team quota reached (4 of 4 builds running)"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the status as json", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"job_name": "awesome-job",
+						"pending_build_id": 42,
+						"pending_build_name": "7",
+						"blockers": [
+							{"reason": "pipeline_paused"},
+							{"reason": "serial_group_blocked", "build_id": 41, "build_name": "3", "job_name": "other-job"},
+							{
+								"reason": "input_unsatisfied",
+								"input": "some-input",
+								"resource": "some-resource",
+								"input_reason": "passed_constraints",
+								"passed_jobs": ["job-1", "job-2"]
+							},
+							{"reason": "team_quota_reached", "active_builds": 4, "max_concurrent_builds": 4}
+						]
+					}`))
+				})
+			})
+		})
+
+		Context("when nothing is blocking the job", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobSchedulingStatus{
+							JobName:  "awesome-job",
+							Blockers: []atc.SchedulingBlocker{},
+						}),
+					),
+				)
+			})
+
+			It("says so", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say(`no pending build`))
+				Expect(sess.Out).To(gbytes.Say(`nothing is blocking the job from being scheduled`))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails with an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say(`awesome-pipeline/awesome-job not found`))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"fmt"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/ui"
@@ -54,7 +55,12 @@ var _ = Describe("Fly CLI", func() {
 					NextBuild:     nextBuild,
 				}
 			}
+			var scheduledJob atc.Job
+
 			BeforeEach(func() {
+				scheduledJob = createJob(3, false, "", "")
+				scheduledJob.NextScheduled = time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC).Unix()
+
 				pipelineName := "pipeline"
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", pipelineName)
 				atcServer.AppendHandlers(
@@ -63,7 +69,7 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.RespondWithJSONEncoded(200, []atc.Job{
 							createJob(1, false, "succeeded", "started"),
 							createJob(2, true, "failed", ""),
-							createJob(3, false, "", ""),
+							scheduledJob,
 						}),
 					),
 				)
@@ -128,6 +134,7 @@ var _ = Describe("Fly CLI", func() {
                 "team_name": "",
                 "next_build": null,
                 "finished_build": null,
+                "next_scheduled": 1514800800,
                 "inputs": null,
                 "outputs": null,
                 "groups": null
@@ -143,9 +150,9 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}},
-						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}},
-						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}, {Contents: "n/a"}},
+						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: time.Unix(1514800800, 0).Local().Format("2006-01-02@15:04:05-0700")}},
 					},
 				}))
 			})
@@ -0,0 +1,201 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("Pin Resource", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		pipelineName := "pipeline"
+		resourceName := "resource-name-potato"
+		fullResourceName := fmt.Sprintf("%s/%s", pipelineName, resourceName)
+		versionsPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/resources/%s/versions", pipelineName, resourceName)
+
+		Context("when the resource and version flags are provided", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", fullResourceName, "-v", "ref:fake-ref-2", "-c", "v3 is broken")
+			})
+
+			Context("when the version exists", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", versionsPath, "limit=100"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+								{ID: 3, Version: atc.Version{"ref": "fake-ref-3"}},
+							}, http.Header{
+								"Link": []string{fmt.Sprintf(`<%s%s?since=3&limit=100>; rel="next"`, atcServer.URL(), versionsPath)},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", versionsPath, "since=3&limit=100"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+								{ID: 2, Version: atc.Version{"ref": "fake-ref-2", "commit": "abcdef"}},
+							}),
+						),
+					)
+				})
+
+				Context("when pinning the version succeeds", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("PUT", versionsPath+"/2/pin"),
+								ghttp.VerifyJSON(`{"comment":"v3 is broken"}`),
+								ghttp.RespondWith(http.StatusOK, nil),
+							),
+						)
+					})
+
+					It("pins the matching version", func() {
+						Expect(func() {
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							Eventually(sess).Should(gbytes.Say(fmt.Sprintf("pinned '%s'", resourceName)))
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+						}).To(Change(func() int {
+							return len(atcServer.ReceivedRequests())
+						}).By(4))
+					})
+				})
+
+				Context("when the resource is pinned in the pipeline's config", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("PUT", versionsPath+"/2/pin"),
+								ghttp.RespondWith(http.StatusConflict, nil),
+							),
+						)
+					})
+
+					It("exits 1 and outputs an error", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess.Err).Should(gbytes.Say(`error`))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the version does not exist", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", versionsPath, "limit=100"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+								{ID: 3, Version: atc.Version{"ref": "fake-ref-3"}},
+							}),
+						),
+					)
+				})
+
+				It("exits 1 and outputs an error", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`could not find version matching`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the version flag is not provided", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", fullResourceName)
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`error`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("Unpin Resource", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		pipelineName := "pipeline"
+		resourceName := "resource-name-potato"
+		fullResourceName := fmt.Sprintf("%s/%s", pipelineName, resourceName)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "unpin-resource", "-r", fullResourceName)
+		})
+
+		Context("when the resource is unpinned using the API", func() {
+			BeforeEach(func() {
+				apiPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/resources/%s/unpin", pipelineName, resourceName)
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", apiPath),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("successfully unpins the resource", func() {
+				Expect(func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+					Eventually(sess).Should(gbytes.Say(fmt.Sprintf("unpinned '%s'\n", resourceName)))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				apiPath := fmt.Sprintf("/api/v1/teams/main/pipelines/%s/resources/%s/unpin", pipelineName, resourceName)
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", apiPath),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("exits 1 and outputs an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(`not found`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -92,6 +92,98 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when some pipelines are archived", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", Paused: false},
+								{Name: "archived-pipeline", Paused: true, Archived: true},
+							}),
+						),
+					)
+				})
+
+				It("does not show them", func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}},
+						},
+					}))
+				})
+
+				Context("when --include-archived is given", func() {
+					It("shows them with an archived column", func() {
+						flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines", "--include-archived")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(sess).Should(gexec.Exit(0))
+
+						Expect(sess.Out).To(PrintTable(ui.Table{
+							Headers: ui.TableRow{
+								{Contents: "name", Color: color.New(color.Bold)},
+								{Contents: "paused", Color: color.New(color.Bold)},
+								{Contents: "public", Color: color.New(color.Bold)},
+								{Contents: "archived", Color: color.New(color.Bold)},
+							},
+							Data: []ui.TableRow{
+								{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+								{{Contents: "archived-pipeline"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							},
+						}))
+					})
+				})
+			})
+
+			Context("when some pipelines are instanced", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.2"}},
+								{Name: "pipeline-2", Paused: true},
+								{Name: "release", InstanceVars: atc.InstanceVars{"branch": "1.3"}},
+							}),
+						),
+					)
+				})
+
+				It("groups the instances under the pipeline's name", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "instance vars", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "release"}, {Contents: "branch:1.2"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: ""}, {Contents: "branch:1.3"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-2"}, {Contents: "n/a"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}},
+						},
+					}))
+				})
+			})
+
 			Context("when --all is specified", func() {
 				BeforeEach(func() {
 					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines", "--all")
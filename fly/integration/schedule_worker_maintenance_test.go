@@ -0,0 +1,104 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("schedule-worker-maintenance", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when a start is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "schedule-worker-maintenance", "-w", "some-worker", "--start", "2018-11-14T02:00:00Z", "--duration", "2h", "--behaviour", "retire")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.VerifyJSONRepresenting(atc.MaintenanceWindow{
+							Start:     time.Date(2018, 11, 14, 2, 0, 0, 0, time.UTC).Unix(),
+							Duration:  7200,
+							Behaviour: "retire",
+						}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("schedules the maintenance", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("scheduled maintenance of 'some-worker'"))
+			})
+		})
+
+		Context("when the ATC rejects the window", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "schedule-worker-maintenance", "-w", "some-worker", "--start", "1h", "--duration", "0s")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWith(http.StatusBadRequest, "maintenance duration must be positive"),
+					),
+				)
+			})
+
+			It("prints the reason", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("maintenance duration must be positive"))
+			})
+		})
+
+		Context("when --cancel is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "schedule-worker-maintenance", "-w", "some-worker", "--cancel")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cancels the maintenance", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("cancelled maintenance of 'some-worker'"))
+			})
+		})
+
+		Context("when neither a start nor --cancel is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "schedule-worker-maintenance", "-w", "some-worker")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--start is required unless --cancel is given"))
+			})
+		})
+	})
+})
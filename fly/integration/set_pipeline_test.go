@@ -769,6 +769,55 @@ this is super secure
 				})
 			})
 
+			Context("when setting an instanced pipeline", func() {
+				var receivedInstanceVars []string
+
+				BeforeEach(func() {
+					receivedInstanceVars = nil
+
+					path, err := atc.Routes.CreatePathForRoute(atc.GetConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("GET", path,
+						ghttp.CombineHandlers(
+							func(w http.ResponseWriter, r *http.Request) {
+								receivedInstanceVars = append(receivedInstanceVars, r.URL.Query().Get(atc.InstanceVarsQueryParam))
+							},
+							ghttp.RespondWith(http.StatusNotFound, ""),
+						),
+					)
+
+					path, err = atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							func(w http.ResponseWriter, r *http.Request) {
+								receivedInstanceVars = append(receivedInstanceVars, r.URL.Query().Get(atc.InstanceVarsQueryParam))
+							},
+							ghttp.RespondWith(http.StatusCreated, "{}"),
+						),
+					)
+				})
+
+				It("identifies the pipeline instance by its instance vars", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-n", "-p", "awesome-pipeline", "-c", configFile.Name(), "-i", "branch=1.2")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say("pipeline created!"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					Expect(receivedInstanceVars).To(HaveLen(2))
+					for _, vars := range receivedInstanceVars {
+						Expect(vars).To(MatchJSON(`{"branch":"1.2"}`))
+					}
+				})
+			})
+
 			Context("when configuring with groups re-ordered", func() {
 				BeforeEach(func() {
 					changedConfig.Groups = atc.GroupConfigs{
@@ -727,6 +727,55 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending a build quota", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--max-concurrent-builds", "10",
+					"--build-weight", "2",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:brock-obama"],
+									"groups": []
+								}
+							},
+							"max_concurrent_builds": 10,
+							"build_weight": 2
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the build quota", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("Team Name: venture"))
+				Eventually(sess.Out).Should(gbytes.Say("Max Concurrent Builds: 10"))
+				Eventually(sess.Out).Should(gbytes.Say("Build Weight: 2"))
+
+				Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"--local-user", "brock-obama"}
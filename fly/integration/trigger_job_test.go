@@ -31,6 +31,90 @@ var _ = Describe("Fly CLI", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			Context("when input versions are given", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/awesome-pipeline/jobs/awesome-job"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Job{
+								Name: "awesome-job",
+								Inputs: []atc.JobInput{
+									{Name: "some-input", Resource: "some-resource"},
+								},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/awesome-pipeline/resources/some-resource/versions", "limit=100"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.VersionedResource{
+								{ID: 2, Version: atc.Version{"ref": "v2", "time": "later"}},
+								{ID: 1, Version: atc.Version{"ref": "v1", "time": "earlier"}},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path),
+							ghttp.VerifyJSON(`{"inputs":{"some-input":{"ref":"v1","time":"earlier"}},"ignore_passed":true}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+						),
+					)
+				})
+
+				It("starts the build with the matching versions", func() {
+					Expect(func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "-i", "some-input=ref:v1", "--ignore-passed")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(4))
+				})
+
+				It("fails for inputs the job does not have", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "-i", "bogus=ref:v1")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`job 'awesome-job' has no input named 'bogus'`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+
+			Context("when a priority is given", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path),
+							ghttp.VerifyJSON(`{"priority":10}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42", Priority: 10}),
+						),
+					)
+				})
+
+				It("starts the build with that priority", func() {
+					Expect(func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "--priority", "10")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(2))
+				})
+			})
+
 			Context("when the pipeline and job exists", func() {
 				BeforeEach(func() {
 					atcServer.AppendHandlers(
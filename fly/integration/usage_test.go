@@ -0,0 +1,240 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("usage", func() {
+		var (
+			flyCmd *exec.Cmd
+			usage  atc.TeamUsage
+		)
+
+		BeforeEach(func() {
+			usage = atc.TeamUsage{
+				From: 1000,
+				To:   2000,
+				Total: atc.Usage{
+					Containers:        3,
+					ContainerSeconds:  5400,
+					CPUShareSeconds:   1843200,
+					MemoryByteSeconds: 3 * 3600 * (1 << 30),
+					Volumes:           2,
+					VolumeSeconds:     1800,
+					VolumeBytes:       3 * (1 << 30),
+					VolumeByteSeconds: 1800 * (1 << 30),
+				},
+				Breakdown: []atc.Usage{
+					{
+						PipelineName:      "some-pipeline",
+						JobName:           "some-job",
+						Containers:        2,
+						ContainerSeconds:  3600,
+						CPUShareSeconds:   1843200,
+						MemoryByteSeconds: 3 * 3600 * (1 << 30),
+						Volumes:           2,
+						VolumeSeconds:     1800,
+						VolumeBytes:       3 * (1 << 30),
+						VolumeByteSeconds: 1800 * (1 << 30),
+					},
+					{
+						Containers:       1,
+						ContainerSeconds: 1800,
+					},
+				},
+			}
+		})
+
+		Context("without a grouping", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "usage")
+
+				usage.Breakdown = nil
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/usage"),
+						func(w http.ResponseWriter, r *http.Request) {
+							from, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+							Expect(err).NotTo(HaveOccurred())
+
+							to, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(to - from).To(Equal(int64(24 * 60 * 60)))
+							Expect(r.URL.Query().Get("group_by")).To(BeEmpty())
+						},
+						ghttp.RespondWithJSONEncoded(200, usage),
+					),
+				)
+			})
+
+			It("shows the team's total usage of the last day", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "containers", Color: color.New(color.Bold)},
+						{Contents: "container hours", Color: color.New(color.Bold)},
+						{Contents: "cpu share hours", Color: color.New(color.Bold)},
+						{Contents: "memory gib hours", Color: color.New(color.Bold)},
+						{Contents: "volumes", Color: color.New(color.Bold)},
+						{Contents: "volume hours", Color: color.New(color.Bold)},
+						{Contents: "peak volume gib", Color: color.New(color.Bold)},
+						{Contents: "volume gib hours", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "1.50"},
+							{Contents: "512.00"},
+							{Contents: "3.00"},
+							{Contents: "2"},
+							{Contents: "0.50"},
+							{Contents: "3.00"},
+							{Contents: "0.50"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when grouping by job", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "usage", "--group-by", "job", "--since", "48h", "--until", "24h")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/usage"),
+						func(w http.ResponseWriter, r *http.Request) {
+							from, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+							Expect(err).NotTo(HaveOccurred())
+
+							to, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(to - from).To(Equal(int64(24 * 60 * 60)))
+							Expect(r.URL.Query().Get("group_by")).To(Equal("job"))
+						},
+						ghttp.RespondWithJSONEncoded(200, usage),
+					),
+				)
+			})
+
+			It("breaks the usage down by job", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "pipeline", Color: color.New(color.Bold)},
+						{Contents: "job", Color: color.New(color.Bold)},
+						{Contents: "containers", Color: color.New(color.Bold)},
+						{Contents: "container hours", Color: color.New(color.Bold)},
+						{Contents: "cpu share hours", Color: color.New(color.Bold)},
+						{Contents: "memory gib hours", Color: color.New(color.Bold)},
+						{Contents: "volumes", Color: color.New(color.Bold)},
+						{Contents: "volume hours", Color: color.New(color.Bold)},
+						{Contents: "peak volume gib", Color: color.New(color.Bold)},
+						{Contents: "volume gib hours", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "some-pipeline"},
+							{Contents: "some-job"},
+							{Contents: "2"},
+							{Contents: "1.00"},
+							{Contents: "512.00"},
+							{Contents: "3.00"},
+							{Contents: "2"},
+							{Contents: "0.50"},
+							{Contents: "3.00"},
+							{Contents: "0.50"},
+						},
+						{
+							{Contents: "none", Color: color.New(color.Faint)},
+							{Contents: "none", Color: color.New(color.Faint)},
+							{Contents: "1"},
+							{Contents: "0.50"},
+							{Contents: "0.00"},
+							{Contents: "0.00"},
+							{Contents: "0"},
+							{Contents: "0.00"},
+							{Contents: "0.00"},
+							{Contents: "0.00"},
+						},
+						{
+							{Contents: "total", Color: color.New(color.Bold)},
+							{Contents: ""},
+							{Contents: "3"},
+							{Contents: "1.50"},
+							{Contents: "512.00"},
+							{Contents: "3.00"},
+							{Contents: "2"},
+							{Contents: "0.50"},
+							{Contents: "3.00"},
+							{Contents: "0.50"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "usage", "--json")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/usage"),
+						ghttp.RespondWithJSONEncoded(200, usage),
+					),
+				)
+			})
+
+			It("prints the usage as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var printed atc.TeamUsage
+				err = json.Unmarshal(sess.Out.Contents(), &printed)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(printed).To(Equal(usage))
+			})
+		})
+
+		Context("when the period is empty", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "usage", "--since", "1h", "--until", "2h")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--since must be further back than --until"))
+			})
+		})
+	})
+})
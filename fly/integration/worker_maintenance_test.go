@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("worker-maintenance", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "worker-maintenance", "-w", "some-worker")
+		})
+
+		Context("when the worker has scheduled maintenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.WorkerMaintenance{
+							Window: atc.MaintenanceWindow{
+								Start:     1542200000,
+								Duration:  3600,
+								Behaviour: "land",
+							},
+							BlockingBuilds: []atc.Build{
+								{
+									ID:           42,
+									Name:         "3",
+									PipelineName: "some-pipeline",
+									JobName:      "some-job",
+									Status:       "started",
+								},
+								{
+									ID:     43,
+									Name:   "43",
+									Status: "started",
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("shows the builds holding up the maintenance", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("behaviour: land"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "pipeline/job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "42"}, {Contents: "some-pipeline/some-job"}, {Contents: "3"}, {Contents: "started"}},
+						{{Contents: "43"}, {Contents: "one-off"}, {Contents: "43"}, {Contents: "started"}},
+					},
+				}))
+			})
+		})
+
+		Context("when the worker has no scheduled maintenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("worker 'some-worker' has no scheduled maintenance"))
+			})
+		})
+	})
+})
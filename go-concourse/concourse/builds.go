@@ -53,6 +53,50 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	return build, err
 }
 
+func (team *team) CreateJobBuildWithInputOverrides(pipelineName string, jobName string, overrides atc.InputOverrides) (atc.Build, error) {
+	params := rata.Params{
+		"job_name":      jobName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	jsonBytes, err := json.Marshal(overrides)
+	if err != nil {
+		return atc.Build{}, err
+	}
+
+	var build atc.Build
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateJobBuild,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
+func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error) {
+	params := rata.Params{
+		"job_name":      jobName,
+		"build_name":    buildName,
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RerunJobBuild,
+		Params:      params,
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
 func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
 	if pipelineName == "" {
 		return atc.Build{}, false, NameRequiredError("pipeline")
@@ -131,6 +175,17 @@ func (client *client) Builds(page Page) ([]atc.Build, Pagination, error) {
 	}
 }
 
+func (client *client) PendingBuilds() ([]atc.Build, error) {
+	var builds []atc.Build
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListPendingBuilds,
+	}, &internal.Response{
+		Result: &builds,
+	})
+
+	return builds, err
+}
+
 func (client *client) AbortBuild(buildID string) error {
 	params := rata.Params{
 		"build_id": buildID,
@@ -96,6 +96,68 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("CreateJobBuildWithInputOverrides", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:      123,
+				Name:    "mybuild",
+				Status:  "succeeded",
+				JobName: "myjob",
+				APIURL:  "api/v1/builds/123",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"inputs":{"some-input":{"ref":"v1"}},"ignore_passed":true}`),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("sends the overrides and creates the build", func() {
+			build, err := team.CreateJobBuildWithInputOverrides("mypipeline", "myjob", atc.InputOverrides{
+				Inputs:       map[string]atc.Version{"some-input": {"ref": "v1"}},
+				IgnorePassed: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
+	Describe("RerunJobBuild", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:          124,
+				Name:        "42.1",
+				Status:      "pending",
+				JobName:     "myjob",
+				APIURL:      "api/v1/builds/124",
+				RerunOf:     123,
+				RerunNumber: 1,
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/42"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+			)
+		})
+
+		It("reruns the build", func() {
+			build, err := team.RerunJobBuild("mypipeline", "myjob", "42")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
@@ -401,6 +463,44 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("PendingBuilds", func() {
+		var expectedBuilds []atc.Build
+
+		BeforeEach(func() {
+			expectedBuilds = []atc.Build{
+				{
+					ID:            123,
+					Name:          "mybuild1",
+					Status:        "pending",
+					JobName:       "release",
+					PipelineName:  "mypipeline",
+					Priority:      10,
+					QueuePosition: 1,
+				},
+				{
+					ID:           124,
+					Name:         "mybuild2",
+					Status:       "pending",
+					JobName:      "pr",
+					PipelineName: "mypipeline",
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/pending"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuilds),
+				),
+			)
+		})
+
+		It("returns the pending builds", func() {
+			builds, err := client.PendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(Equal(expectedBuilds))
+		})
+	})
+
 	Describe("AbortBuild", func() {
 		BeforeEach(func() {
 			expectedURL := "/api/v1/builds/123/abort"
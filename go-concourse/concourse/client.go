@@ -15,6 +15,7 @@ type Client interface {
 	URL() string
 	HTTPClient() *http.Client
 	Builds(Page) ([]atc.Build, Pagination, error)
+	PendingBuilds() ([]atc.Build, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
@@ -26,6 +27,10 @@ type Client interface {
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	UnquarantineWorker(workerName string) error
+	WorkerMaintenance(workerName string) (atc.WorkerMaintenance, bool, error)
+	ScheduleWorkerMaintenance(workerName string, window atc.MaintenanceWindow) error
+	CancelWorkerMaintenance(workerName string) error
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
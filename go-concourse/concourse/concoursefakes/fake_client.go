@@ -96,6 +96,17 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CancelWorkerMaintenanceStub        func(string) error
+	cancelWorkerMaintenanceMutex       sync.RWMutex
+	cancelWorkerMaintenanceArgsForCall []struct {
+		arg1 string
+	}
+	cancelWorkerMaintenanceReturns struct {
+		result1 error
+	}
+	cancelWorkerMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -181,6 +192,18 @@ type FakeClient struct {
 		result1 []atc.Worker
 		result2 error
 	}
+	PendingBuildsStub        func() ([]atc.Build, error)
+	pendingBuildsMutex       sync.RWMutex
+	pendingBuildsArgsForCall []struct {
+	}
+	pendingBuildsReturns struct {
+		result1 []atc.Build
+		result2 error
+	}
+	pendingBuildsReturnsOnCall map[int]struct {
+		result1 []atc.Build
+		result2 error
+	}
 	PruneWorkerStub        func(string) error
 	pruneWorkerMutex       sync.RWMutex
 	pruneWorkerArgsForCall []struct {
@@ -222,6 +245,18 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	ScheduleWorkerMaintenanceStub        func(string, atc.MaintenanceWindow) error
+	scheduleWorkerMaintenanceMutex       sync.RWMutex
+	scheduleWorkerMaintenanceArgsForCall []struct {
+		arg1 string
+		arg2 atc.MaintenanceWindow
+	}
+	scheduleWorkerMaintenanceReturns struct {
+		result1 error
+	}
+	scheduleWorkerMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendInputToBuildPlanStub        func(int, atc.PlanID, io.Reader) (bool, error)
 	sendInputToBuildPlanMutex       sync.RWMutex
 	sendInputToBuildPlanArgsForCall []struct {
@@ -258,6 +293,32 @@ type FakeClient struct {
 	uRLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnquarantineWorkerStub        func(string) error
+	unquarantineWorkerMutex       sync.RWMutex
+	unquarantineWorkerArgsForCall []struct {
+		arg1 string
+	}
+	unquarantineWorkerReturns struct {
+		result1 error
+	}
+	unquarantineWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WorkerMaintenanceStub        func(string) (atc.WorkerMaintenance, bool, error)
+	workerMaintenanceMutex       sync.RWMutex
+	workerMaintenanceArgsForCall []struct {
+		arg1 string
+	}
+	workerMaintenanceReturns struct {
+		result1 atc.WorkerMaintenance
+		result2 bool
+		result3 error
+	}
+	workerMaintenanceReturnsOnCall map[int]struct {
+		result1 atc.WorkerMaintenance
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -589,6 +650,56 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CancelWorkerMaintenance(arg1 string) error {
+	fake.cancelWorkerMaintenanceMutex.Lock()
+	ret, specificReturn := fake.cancelWorkerMaintenanceReturnsOnCall[len(fake.cancelWorkerMaintenanceArgsForCall)]
+	fake.cancelWorkerMaintenanceArgsForCall = append(fake.cancelWorkerMaintenanceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CancelWorkerMaintenance", []interface{}{arg1})
+	fake.cancelWorkerMaintenanceMutex.Unlock()
+	if fake.CancelWorkerMaintenanceStub != nil {
+		return fake.CancelWorkerMaintenanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cancelWorkerMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CancelWorkerMaintenanceCallCount() int {
+	fake.cancelWorkerMaintenanceMutex.RLock()
+	defer fake.cancelWorkerMaintenanceMutex.RUnlock()
+	return len(fake.cancelWorkerMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) CancelWorkerMaintenanceArgsForCall(i int) string {
+	fake.cancelWorkerMaintenanceMutex.RLock()
+	defer fake.cancelWorkerMaintenanceMutex.RUnlock()
+	argsForCall := fake.cancelWorkerMaintenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CancelWorkerMaintenanceReturns(result1 error) {
+	fake.CancelWorkerMaintenanceStub = nil
+	fake.cancelWorkerMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CancelWorkerMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.CancelWorkerMaintenanceStub = nil
+	if fake.cancelWorkerMaintenanceReturnsOnCall == nil {
+		fake.cancelWorkerMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelWorkerMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -918,6 +1029,51 @@ func (fake *FakeClient) ListWorkersReturnsOnCall(i int, result1 []atc.Worker, re
 	}{result1, result2}
 }
 
+func (fake *FakeClient) PendingBuilds() ([]atc.Build, error) {
+	fake.pendingBuildsMutex.Lock()
+	ret, specificReturn := fake.pendingBuildsReturnsOnCall[len(fake.pendingBuildsArgsForCall)]
+	fake.pendingBuildsArgsForCall = append(fake.pendingBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PendingBuilds", []interface{}{})
+	fake.pendingBuildsMutex.Unlock()
+	if fake.PendingBuildsStub != nil {
+		return fake.PendingBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pendingBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PendingBuildsCallCount() int {
+	fake.pendingBuildsMutex.RLock()
+	defer fake.pendingBuildsMutex.RUnlock()
+	return len(fake.pendingBuildsArgsForCall)
+}
+
+func (fake *FakeClient) PendingBuildsReturns(result1 []atc.Build, result2 error) {
+	fake.PendingBuildsStub = nil
+	fake.pendingBuildsReturns = struct {
+		result1 []atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PendingBuildsReturnsOnCall(i int, result1 []atc.Build, result2 error) {
+	fake.PendingBuildsStub = nil
+	if fake.pendingBuildsReturnsOnCall == nil {
+		fake.pendingBuildsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Build
+			result2 error
+		})
+	}
+	fake.pendingBuildsReturnsOnCall[i] = struct {
+		result1 []atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) PruneWorker(arg1 string) error {
 	fake.pruneWorkerMutex.Lock()
 	ret, specificReturn := fake.pruneWorkerReturnsOnCall[len(fake.pruneWorkerArgsForCall)]
@@ -1079,6 +1235,57 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ScheduleWorkerMaintenance(arg1 string, arg2 atc.MaintenanceWindow) error {
+	fake.scheduleWorkerMaintenanceMutex.Lock()
+	ret, specificReturn := fake.scheduleWorkerMaintenanceReturnsOnCall[len(fake.scheduleWorkerMaintenanceArgsForCall)]
+	fake.scheduleWorkerMaintenanceArgsForCall = append(fake.scheduleWorkerMaintenanceArgsForCall, struct {
+		arg1 string
+		arg2 atc.MaintenanceWindow
+	}{arg1, arg2})
+	fake.recordInvocation("ScheduleWorkerMaintenance", []interface{}{arg1, arg2})
+	fake.scheduleWorkerMaintenanceMutex.Unlock()
+	if fake.ScheduleWorkerMaintenanceStub != nil {
+		return fake.ScheduleWorkerMaintenanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleWorkerMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceCallCount() int {
+	fake.scheduleWorkerMaintenanceMutex.RLock()
+	defer fake.scheduleWorkerMaintenanceMutex.RUnlock()
+	return len(fake.scheduleWorkerMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceArgsForCall(i int) (string, atc.MaintenanceWindow) {
+	fake.scheduleWorkerMaintenanceMutex.RLock()
+	defer fake.scheduleWorkerMaintenanceMutex.RUnlock()
+	argsForCall := fake.scheduleWorkerMaintenanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceReturns(result1 error) {
+	fake.ScheduleWorkerMaintenanceStub = nil
+	fake.scheduleWorkerMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.ScheduleWorkerMaintenanceStub = nil
+	if fake.scheduleWorkerMaintenanceReturnsOnCall == nil {
+		fake.scheduleWorkerMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scheduleWorkerMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) SendInputToBuildPlan(arg1 int, arg2 atc.PlanID, arg3 io.Reader) (bool, error) {
 	fake.sendInputToBuildPlanMutex.Lock()
 	ret, specificReturn := fake.sendInputToBuildPlanReturnsOnCall[len(fake.sendInputToBuildPlanArgsForCall)]
@@ -1226,6 +1433,112 @@ func (fake *FakeClient) URLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeClient) UnquarantineWorker(arg1 string) error {
+	fake.unquarantineWorkerMutex.Lock()
+	ret, specificReturn := fake.unquarantineWorkerReturnsOnCall[len(fake.unquarantineWorkerArgsForCall)]
+	fake.unquarantineWorkerArgsForCall = append(fake.unquarantineWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UnquarantineWorker", []interface{}{arg1})
+	fake.unquarantineWorkerMutex.Unlock()
+	if fake.UnquarantineWorkerStub != nil {
+		return fake.UnquarantineWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unquarantineWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UnquarantineWorkerCallCount() int {
+	fake.unquarantineWorkerMutex.RLock()
+	defer fake.unquarantineWorkerMutex.RUnlock()
+	return len(fake.unquarantineWorkerArgsForCall)
+}
+
+func (fake *FakeClient) UnquarantineWorkerArgsForCall(i int) string {
+	fake.unquarantineWorkerMutex.RLock()
+	defer fake.unquarantineWorkerMutex.RUnlock()
+	argsForCall := fake.unquarantineWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UnquarantineWorkerReturns(result1 error) {
+	fake.UnquarantineWorkerStub = nil
+	fake.unquarantineWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnquarantineWorkerReturnsOnCall(i int, result1 error) {
+	fake.UnquarantineWorkerStub = nil
+	if fake.unquarantineWorkerReturnsOnCall == nil {
+		fake.unquarantineWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unquarantineWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) WorkerMaintenance(arg1 string) (atc.WorkerMaintenance, bool, error) {
+	fake.workerMaintenanceMutex.Lock()
+	ret, specificReturn := fake.workerMaintenanceReturnsOnCall[len(fake.workerMaintenanceArgsForCall)]
+	fake.workerMaintenanceArgsForCall = append(fake.workerMaintenanceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("WorkerMaintenance", []interface{}{arg1})
+	fake.workerMaintenanceMutex.Unlock()
+	if fake.WorkerMaintenanceStub != nil {
+		return fake.WorkerMaintenanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.workerMaintenanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) WorkerMaintenanceCallCount() int {
+	fake.workerMaintenanceMutex.RLock()
+	defer fake.workerMaintenanceMutex.RUnlock()
+	return len(fake.workerMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) WorkerMaintenanceArgsForCall(i int) string {
+	fake.workerMaintenanceMutex.RLock()
+	defer fake.workerMaintenanceMutex.RUnlock()
+	argsForCall := fake.workerMaintenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) WorkerMaintenanceReturns(result1 atc.WorkerMaintenance, result2 bool, result3 error) {
+	fake.WorkerMaintenanceStub = nil
+	fake.workerMaintenanceReturns = struct {
+		result1 atc.WorkerMaintenance
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) WorkerMaintenanceReturnsOnCall(i int, result1 atc.WorkerMaintenance, result2 bool, result3 error) {
+	fake.WorkerMaintenanceStub = nil
+	if fake.workerMaintenanceReturnsOnCall == nil {
+		fake.workerMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerMaintenance
+			result2 bool
+			result3 error
+		})
+	}
+	fake.workerMaintenanceReturnsOnCall[i] = struct {
+		result1 atc.WorkerMaintenance
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1241,6 +1554,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.cancelWorkerMaintenanceMutex.RLock()
+	defer fake.cancelWorkerMaintenanceMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
@@ -1255,18 +1570,26 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listTeamsMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
+	fake.pendingBuildsMutex.RLock()
+	defer fake.pendingBuildsMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
 	fake.readOutputFromBuildPlanMutex.RLock()
 	defer fake.readOutputFromBuildPlanMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.scheduleWorkerMaintenanceMutex.RLock()
+	defer fake.scheduleWorkerMaintenanceMutex.RUnlock()
 	fake.sendInputToBuildPlanMutex.RLock()
 	defer fake.sendInputToBuildPlanMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
+	fake.unquarantineWorkerMutex.RLock()
+	defer fake.unquarantineWorkerMutex.RUnlock()
+	fake.workerMaintenanceMutex.RLock()
+	defer fake.workerMaintenanceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
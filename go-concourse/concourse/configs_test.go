@@ -88,7 +88,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the given config and version for that pipeline", func() {
-				pipelineConfig, rawConfig, version, found, err := team.PipelineConfig("mypipeline")
+				pipelineConfig, rawConfig, version, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pipelineConfig).To(Equal(expectedConfig))
 				Expect(rawConfig).To(Equal(expectedRawConfig))
@@ -110,7 +110,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns an error", func() {
-				_, actualRawConfig, actualConfigVersion, found, err := team.PipelineConfig("mypipeline")
+				_, actualRawConfig, actualConfigVersion, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("config-error"))
 				Expect(actualRawConfig).To(Equal(atc.RawConfig("raw-config")))
@@ -130,7 +130,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, _, found, err := team.PipelineConfig("mypipeline")
+				_, _, _, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -147,7 +147,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the error", func() {
-				_, _, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -163,7 +163,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns an error", func() {
-				_, _, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -222,7 +222,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns true for created and false for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeTrue())
 				Expect(updated).To(BeFalse())
@@ -238,13 +238,36 @@ var _ = Describe("ATC Handler Configs", func() {
 				}))
 			})
 
+			Context("when the pipeline is instanced", func() {
+				var receivedQuery string
+
+				BeforeEach(func() {
+					atcServer.RouteToHandler("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config",
+						func(w http.ResponseWriter, r *http.Request) {
+							receivedQuery = r.URL.Query().Get(atc.InstanceVarsQueryParam)
+							w.WriteHeader(returnHeader)
+							w.Write(returnBody)
+						},
+					)
+				})
+
+				It("passes the instance vars as a query param", func() {
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{
+						Name:         expectedPipelineName,
+						InstanceVars: atc.InstanceVars{"branch": "1.2"},
+					}, expectedVersion, expectedConfig, checkCredentials)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(receivedQuery).To(MatchJSON(`{"branch":"1.2"}`))
+				})
+			})
+
 			Context("when response contains bad JSON", func() {
 				BeforeEach(func() {
 					returnBody = []byte(`bad-json`)
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -261,7 +284,7 @@ var _ = Describe("ATC Handler Configs", func() {
 					})
 
 					It("returns an error", func() {
-						_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+						_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 						Expect(err).To(HaveOccurred())
 						Expect(err.Error()).To(ContainSubstring("Expected to find variables: BAR"))
 					})
@@ -280,7 +303,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false for created and true for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeFalse())
 				Expect(updated).To(BeTrue())
@@ -302,7 +325,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -319,7 +342,7 @@ var _ = Describe("ATC Handler Configs", func() {
 					})
 
 					It("returns an error", func() {
-						_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+						_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 						Expect(err).To(HaveOccurred())
 						Expect(err.Error()).To(ContainSubstring("Expected to find variables: BAR"))
 					})
@@ -335,7 +358,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns config validation error", func() {
-				_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("invalid configuration:\n"))
 				Expect(err.Error()).To(ContainSubstring("fake-error1\nfake-error2"))
@@ -347,7 +370,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -58,6 +58,34 @@ func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
 	}
 }
 
+func (team *team) JobSchedulingStatus(pipelineName, jobName string) (atc.JobSchedulingStatus, bool, error) {
+	if pipelineName == "" {
+		return atc.JobSchedulingStatus{}, false, NameRequiredError("pipeline")
+	}
+
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	var status atc.JobSchedulingStatus
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobSchedulingStatus,
+		Params:      params,
+	}, &internal.Response{
+		Result: &status,
+	})
+	switch err.(type) {
+	case nil:
+		return status, true, nil
+	case internal.ResourceNotFoundError:
+		return status, false, nil
+	default:
+		return status, false, err
+	}
+}
+
 func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineName,
@@ -142,6 +142,63 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("JobSchedulingStatus", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/scheduling-status"
+
+		Context("when the job exists", func() {
+			var expectedStatus atc.JobSchedulingStatus
+
+			BeforeEach(func() {
+				expectedStatus = atc.JobSchedulingStatus{
+					JobName:          "myjob",
+					PendingBuildID:   42,
+					PendingBuildName: "7",
+					Blockers: []atc.SchedulingBlocker{
+						{Reason: atc.SchedulingBlockerPipelinePaused},
+						{
+							Reason:      atc.SchedulingBlockerInputUnsatisfied,
+							Input:       "myinput",
+							Resource:    "myresource",
+							InputReason: "passed_constraints",
+							PassedJobs:  []string{"rc"},
+						},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStatus),
+					),
+				)
+			})
+
+			It("returns the scheduling status of the job", func() {
+				status, found, err := team.JobSchedulingStatus("mypipeline", "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(status).To(Equal(expectedStatus))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.JobSchedulingStatus("mypipeline", "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("JobBuilds", func() {
 		var (
 			expectedBuilds []atc.Build
@@ -121,6 +121,10 @@ func (team *team) UnpausePipeline(pipelineName string) (bool, error) {
 	return team.managePipeline(pipelineName, atc.UnpausePipeline)
 }
 
+func (team *team) ArchivePipeline(pipelineName string) (bool, error) {
+	return team.managePipeline(pipelineName, atc.ArchivePipeline)
+}
+
 func (team *team) ExposePipeline(pipelineName string) (bool, error) {
 	return team.managePipeline(pipelineName, atc.ExposePipeline)
 }
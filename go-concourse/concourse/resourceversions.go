@@ -1,6 +1,8 @@
 package concourse
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -50,6 +52,58 @@ func (team *team) EnableResourceVersion(pipelineName string, resourceName string
 	return team.sendResourceVersion(pipelineName, resourceName, resourceVersionID, atc.EnableResourceVersion)
 }
 
+func (team *team) PinResourceVersion(pipelineName string, resourceName string, resourceVersionID int, comment string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":       pipelineName,
+		"resource_name":       resourceName,
+		"resource_version_id": strconv.Itoa(resourceVersionID),
+		"team_name":           team.name,
+	}
+
+	jsonBytes, err := json.Marshal(atc.PinRequest{Comment: comment})
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.PinResourceVersion,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) UnpinResource(pipelineName string, resourceName string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.UnpinResource,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) sendResourceVersion(pipelineName string, resourceName string, resourceVersionID int, resourceVersionReq string) (bool, error) {
 	params := rata.Params{
 		"pipeline_name":       pipelineName,
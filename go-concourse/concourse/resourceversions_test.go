@@ -363,4 +363,126 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 			})
 		})
 	})
+
+	Describe("PinResourceVersion", func() {
+		var (
+			expectedStatus    int
+			pipelineName      = "banana"
+			resourceName      = "myresource"
+			resourceVersionID = 42
+			expectedURL       = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/versions/%s/pin", pipelineName, resourceName, strconv.Itoa(resourceVersionID))
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSON(`{"comment":"some comment"}`),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the resource exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("calls the pin resource and returns no error", func() {
+				Expect(func() {
+					pinned, err := team.PinResourceVersion(pipelineName, resourceName, resourceVersionID, "some comment")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pinned).To(BeTrue())
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(1))
+			})
+		})
+
+		Context("when the pin resource call fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("calls the pin resource and returns an error", func() {
+				Expect(func() {
+					pinned, err := team.PinResourceVersion(pipelineName, resourceName, resourceVersionID, "some comment")
+					Expect(err).To(HaveOccurred())
+					Expect(pinned).To(BeFalse())
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(1))
+			})
+		})
+
+		Context("when the resource version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("calls the pin resource and returns false", func() {
+				Expect(func() {
+					pinned, err := team.PinResourceVersion(pipelineName, resourceName, resourceVersionID, "some comment")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pinned).To(BeFalse())
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(1))
+			})
+		})
+	})
+
+	Describe("UnpinResource", func() {
+		var (
+			expectedStatus int
+			pipelineName   = "banana"
+			resourceName   = "myresource"
+			expectedURL    = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/unpin", pipelineName, resourceName)
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the resource exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("calls the unpin resource and returns no error", func() {
+				unpinned, err := team.UnpinResource(pipelineName, resourceName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unpinned).To(BeTrue())
+			})
+		})
+
+		Context("when the unpin resource call fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				unpinned, err := team.UnpinResource(pipelineName, resourceName)
+				Expect(err).To(HaveOccurred())
+				Expect(unpinned).To(BeFalse())
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				unpinned, err := team.UnpinResource(pipelineName, resourceName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unpinned).To(BeFalse())
+			})
+		})
+	})
 })
@@ -1,6 +1,8 @@
 package concourse
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 )
@@ -19,23 +21,27 @@ type Team interface {
 	DeletePipeline(pipelineName string) (bool, error)
 	PausePipeline(pipelineName string) (bool, error)
 	UnpausePipeline(pipelineName string) (bool, error)
+	ArchivePipeline(pipelineName string) (bool, error)
 	ExposePipeline(pipelineName string) (bool, error)
 	HidePipeline(pipelineName string) (bool, error)
 	RenamePipeline(pipelineName, name string) (bool, error)
 	PauseResource(pipelineName string, resourceName string) (bool, error)
 	UnpauseResource(pipelineName string, resourceName string) (bool, error)
 	ListPipelines() ([]atc.Pipeline, error)
-	PipelineConfig(pipelineName string) (atc.Config, atc.RawConfig, string, bool, error)
-	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, atc.RawConfig, string, bool, error)
+	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
 
 	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
 
 	BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error)
 
 	Job(pipelineName, jobName string) (atc.Job, bool, error)
+	JobSchedulingStatus(pipelineName, jobName string) (atc.JobSchedulingStatus, bool, error)
 	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
 	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
+	CreateJobBuildWithInputOverrides(pipelineName string, jobName string, overrides atc.InputOverrides) (atc.Build, error)
+	RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
 
 	PauseJob(pipelineName string, jobName string) (bool, error)
@@ -50,6 +56,8 @@ type Team interface {
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
 	EnableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
+	PinResourceVersion(pipelineName string, resourceName string, resourceVersionID int, comment string) (bool, error)
+	UnpinResource(pipelineName string, resourceName string) (bool, error)
 
 	BuildsWithVersionAsInput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 	BuildsWithVersionAsOutput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
@@ -59,6 +67,8 @@ type Team interface {
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
 	OrderingPipelines(pipelineNames []string) error
+
+	Usage(from time.Time, to time.Time, groupBy string) (atc.TeamUsage, error)
 }
 
 type team struct {
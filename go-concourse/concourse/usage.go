@@ -0,0 +1,45 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// Usage returns the team's resource usage between from and to, broken down
+// by groupBy ("pipeline" or "job") unless it is empty. Zero times leave the
+// range up to the server.
+func (team *team) Usage(from time.Time, to time.Time, groupBy string) (atc.TeamUsage, error) {
+	var usage atc.TeamUsage
+
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	urlValues := url.Values{}
+	if !from.IsZero() {
+		urlValues.Set(atc.UsageQueryFrom, strconv.FormatInt(from.Unix(), 10))
+	}
+
+	if !to.IsZero() {
+		urlValues.Set(atc.UsageQueryTo, strconv.FormatInt(to.Unix(), 10))
+	}
+
+	if groupBy != "" {
+		urlValues.Set(atc.UsageQueryGroupBy, groupBy)
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamUsage,
+		Params:      params,
+		Query:       urlValues,
+	}, &internal.Response{
+		Result: &usage,
+	})
+
+	return usage, err
+}
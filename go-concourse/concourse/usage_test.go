@@ -0,0 +1,42 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Usage", func() {
+	Describe("Usage", func() {
+		var expectedUsage atc.TeamUsage
+
+		BeforeEach(func() {
+			expectedUsage = atc.TeamUsage{
+				From:  1000,
+				To:    2000,
+				Total: atc.Usage{Containers: 1, ContainerSeconds: 60},
+				Breakdown: []atc.Usage{
+					{PipelineName: "some-pipeline", Containers: 1, ContainerSeconds: 60},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/usage", "from=1000&group_by=pipeline&to=2000"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedUsage),
+				),
+			)
+		})
+
+		It("returns the usage in the time range", func() {
+			usage, err := team.Usage(time.Unix(1000, 0), time.Unix(2000, 0), "pipeline")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(expectedUsage))
+		})
+	})
+})
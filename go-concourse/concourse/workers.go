@@ -3,6 +3,7 @@ package concourse
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/tedsuo/rata"
 )
 
+var ErrWorkerNotQuarantined = errors.New("worker is not quarantined")
+
 type PruneWorkerError struct {
 	atc.PruneWorkerResponseBody
 }
@@ -92,3 +95,79 @@ func (client *client) LandWorker(workerName string) error {
 
 	return err
 }
+
+func (client *client) UnquarantineWorker(workerName string) error {
+	params := rata.Params{"worker_name": workerName}
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.UnquarantineWorker,
+		Params:      params,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusConflict {
+			return ErrWorkerNotQuarantined
+		}
+	}
+
+	return err
+}
+
+func (client *client) WorkerMaintenance(workerName string) (atc.WorkerMaintenance, bool, error) {
+	params := rata.Params{"worker_name": workerName}
+
+	var maintenance atc.WorkerMaintenance
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetWorkerMaintenance,
+		Params:      params,
+	}, &internal.Response{
+		Result: &maintenance,
+	})
+
+	switch err.(type) {
+	case nil:
+		return maintenance, true, nil
+	case internal.ResourceNotFoundError:
+		return maintenance, false, nil
+	default:
+		return maintenance, false, err
+	}
+}
+
+func (client *client) ScheduleWorkerMaintenance(workerName string, window atc.MaintenanceWindow) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(window)
+	if err != nil {
+		return fmt.Errorf("Unable to marshal maintenance window: %s", err)
+	}
+
+	params := rata.Params{"worker_name": workerName}
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.ScheduleWorkerMaintenance,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+			return errors.New(unexpectedResponseError.Body)
+		}
+	}
+
+	return err
+}
+
+func (client *client) CancelWorkerMaintenance(workerName string) error {
+	params := rata.Params{"worker_name": workerName}
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.CancelWorkerMaintenance,
+		Params:      params,
+	}, nil)
+
+	return err
+}
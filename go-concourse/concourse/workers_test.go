@@ -160,4 +160,159 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("UnquarantineWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/unquarantine"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("unquarantines the worker", func() {
+				err := client.UnquarantineWorker("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the worker is not quarantined", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/unquarantine"),
+						ghttp.RespondWith(http.StatusConflict, nil),
+					),
+				)
+			})
+
+			It("returns ErrWorkerNotQuarantined", func() {
+				err := client.UnquarantineWorker("some-worker")
+				Expect(err).To(Equal(concourse.ErrWorkerNotQuarantined))
+			})
+		})
+
+		Context("failing to unquarantine worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/unquarantine"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.UnquarantineWorker("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("WorkerMaintenance", func() {
+		Context("when the worker has a maintenance window", func() {
+			var maintenance atc.WorkerMaintenance
+
+			BeforeEach(func() {
+				maintenance = atc.WorkerMaintenance{
+					Window: atc.MaintenanceWindow{
+						Start:     1542200000,
+						Duration:  3600,
+						Behaviour: "land",
+					},
+					BlockingBuilds: []atc.Build{{ID: 42}},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, maintenance),
+					),
+				)
+			})
+
+			It("returns the maintenance window", func() {
+				found, ok, err := client.WorkerMaintenance("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(found).To(Equal(maintenance))
+			})
+		})
+
+		Context("when the worker has no maintenance window", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := client.WorkerMaintenance("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ScheduleWorkerMaintenance", func() {
+		window := atc.MaintenanceWindow{
+			Start:     1542200000,
+			Duration:  3600,
+			Behaviour: "retire",
+		}
+
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.VerifyJSONRepresenting(window),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("schedules the maintenance window", func() {
+				err := client.ScheduleWorkerMaintenance("some-worker", window)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the window is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/maintenance"),
+						ghttp.RespondWith(http.StatusBadRequest, "maintenance duration must be positive"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				err := client.ScheduleWorkerMaintenance("some-worker", window)
+				Expect(err).To(MatchError("maintenance duration must be positive"))
+			})
+		})
+	})
+
+	Describe("CancelWorkerMaintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/workers/some-worker/maintenance"),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("cancels the maintenance window", func() {
+			err := client.CancelWorkerMaintenance("some-worker")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
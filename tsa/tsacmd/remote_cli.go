@@ -24,6 +24,8 @@ type reportVolumeRequest struct {
 	volumeHandles []string
 }
 
+type reportVolumeSizesRequest struct{}
+
 func (r reportContainerRequest) handles() []string {
 	return r.containerHandles
 }
@@ -93,6 +95,8 @@ func parseRequest(cli string) (request, error) {
 		return reportVolumeRequest{
 			volumeHandles: args,
 		}, nil
+	case tsa.ReportVolumeSizes:
+		return reportVolumeSizesRequest{}, nil
 	default:
 		return nil, fmt.Errorf("unknown command: %s", command)
 	}
@@ -255,6 +255,23 @@ func (server *registrarSSHServer) handleChannel(
 
 			channel.Close()
 
+		case reportVolumeSizesRequest:
+			logger = logger.Session("report-volume-sizes-worker")
+
+			req.Reply(true, nil)
+
+			err := server.reportVolumeSizes(logger, channel, sessionID)
+
+			if err != nil {
+				logger.Error("failed-to-report-volume-sizes", err)
+				channel.SendRequest("exit-status", false, ssh.Marshal(exitStatusRequest{1}))
+			} else {
+				logger.Info("finished-reporting-volume-sizes")
+				channel.SendRequest("exit-status", false, ssh.Marshal(exitStatusRequest{0}))
+			}
+
+			channel.Close()
+
 		case reportContainerRequest:
 			logger = logger.Session("report-containers-worker", lager.Data{"num-handles": len(r.handles())})
 
@@ -545,6 +562,41 @@ func (server *registrarSSHServer) reportVolumes(
 	}).WorkerStatus(logger, worker, tsa.ReportVolumes)
 }
 
+func (server *registrarSSHServer) reportVolumeSizes(
+	logger lager.Logger,
+	channel ssh.Channel,
+	sessionID string,
+) error {
+	// the sizes follow the worker, as there may be too many volumes to pass
+	// them on the command line
+	decoder := json.NewDecoder(channel)
+
+	var worker atc.Worker
+	err := decoder.Decode(&worker)
+	if err != nil {
+		return err
+	}
+
+	var sizes map[string]int64
+	err = decoder.Decode(&sizes)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("received-volume-sizes", lager.Data{"num-handles": len(sizes)})
+
+	err = server.validateWorkerTeam(logger, sessionID, worker)
+	if err != nil {
+		return err
+	}
+
+	return (&tsa.WorkerStatus{
+		ATCEndpoint:    server.atcEndpointPicker.Pick(),
+		TokenGenerator: server.tokenGenerator,
+		VolumeSizes:    sizes,
+	}).WorkerStatus(logger, worker, tsa.ReportVolumeSizes)
+}
+
 func (server *registrarSSHServer) sweepContainers(
 	logger lager.Logger,
 	channel ssh.Channel,
@@ -19,6 +19,7 @@ import (
 const (
 	ReportContainers      = "report-containers"
 	ReportVolumes         = "report-volumes"
+	ReportVolumeSizes     = "report-volume-sizes"
 	ResourceActionMissing = "resource-type-missing"
 )
 
@@ -27,6 +28,7 @@ type WorkerStatus struct {
 	TokenGenerator   TokenGenerator
 	ContainerHandles []string
 	VolumeHandles    []string
+	VolumeSizes      map[string]int64
 }
 
 func (l *WorkerStatus) WorkerStatus(logger lager.Logger, worker atc.Worker, resourceAction string) error {
@@ -63,6 +65,19 @@ func (l *WorkerStatus) WorkerStatus(logger lager.Logger, worker atc.Worker, reso
 
 		request, err = l.ATCEndpoint.CreateRequest(atc.ReportWorkerVolumes, nil, bytes.NewBuffer(handlesBytes))
 
+		if err != nil {
+			logger.Error("failed-to-construct-request", err)
+			return err
+		}
+	case ReportVolumeSizes:
+		handlesBytes, err = json.Marshal(l.VolumeSizes)
+		if err != nil {
+			logger.Error("failed-to-encode-request-body", err)
+			return err
+		}
+
+		request, err = l.ATCEndpoint.CreateRequest(atc.ReportWorkerVolumeSizes, nil, bytes.NewBuffer(handlesBytes))
+
 		if err != nil {
 			logger.Error("failed-to-construct-request", err)
 			return err
@@ -287,4 +287,38 @@ var _ = Describe("Worker Status Test", func() {
 			})
 		})
 	})
+
+	Context("Volume sizes", func() {
+		BeforeEach(func() {
+			workerStatus.VolumeSizes = map[string]int64{"handle1": 1024, "handle2": 2048}
+
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/volumes/sizes", "worker_name=some-worker"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer yo-team"),
+				ghttp.VerifyJSON(`{"handle1":1024,"handle2":2048}`),
+				ghttp.RespondWith(204, nil, nil),
+			))
+		})
+
+		It("tells the ATC the sizes of the worker's volumes", func() {
+			err := workerStatus.WorkerStatus(logger, worker, tsa.ReportVolumeSizes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the ATC responds with non 200", func() {
+			BeforeEach(func() {
+				fakeATC.Reset()
+				fakeATC.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/volumes/sizes"),
+					ghttp.RespondWith(500, nil, nil),
+				))
+			})
+
+			It("errors", func() {
+				err := workerStatus.WorkerStatus(logger, worker, tsa.ReportVolumeSizes)
+				Expect(err).To(MatchError(ContainSubstring("bad-response (500)")))
+			})
+		})
+	})
 })
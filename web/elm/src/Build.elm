@@ -510,7 +510,7 @@ handleBuildFetched browsingIndex build model =
             ( newModel, cmd ) =
                 if build.status == Concourse.BuildStatusPending then
                     ( withBuild, pollUntilStarted browsingIndex build.id )
-                else if build.reapTime == Nothing then
+                else if build.reapTime == Nothing || build.logsArchived then
                     case model.currentBuild |> RemoteData.toMaybe |> Maybe.andThen .prep of
                         Nothing ->
                             initBuildOutput build withBuild
@@ -646,8 +646,8 @@ view model =
                             maybeBirthDate =
                                 Maybe.Extra.or (build.duration.startedAt) (build.duration.finishedAt)
                            in
-                            case ( maybeBirthDate, build.reapTime ) of
-                                ( Just birthDate, Just reapTime ) ->
+                            case ( maybeBirthDate, build.reapTime, build.logsArchived ) of
+                                ( Just birthDate, Just reapTime, False ) ->
                                     [ Html.div
                                         [ class "tombstone" ]
                                         [ Html.div [ class "heading" ] [ Html.text "RIP" ]
@@ -249,6 +249,11 @@ handleEvent event model =
             , OutNoop
             )
 
+        Concourse.BuildEvents.Handoff _ ->
+            -- the build carries on from another ATC; its events continue in
+            -- the same stream
+            ( model, Cmd.none, OutNoop )
+
         Concourse.BuildEvents.End ->
             ( { model | state = StepsComplete, events = Sub.none }, Cmd.none, OutNoop )
 
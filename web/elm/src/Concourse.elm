@@ -1,5 +1,6 @@
 module Concourse exposing
-    ( AuthSession
+    ( AcrossPlan
+    , AuthSession
     , AuthToken
     , Build
     , BuildDuration
@@ -35,6 +36,7 @@ module Concourse exposing
     , Team
     , TeamName
     , User
+    , VarScopedPlan
     , Version
     , VersionedResource
     , VersionedResourceIdentifier
@@ -63,6 +65,7 @@ import Date exposing (Date)
 import Dict exposing (Dict)
 import Json.Decode
 import Json.Decode.Extra exposing ((|:))
+import Json.Encode
 
 
 
@@ -154,6 +157,7 @@ type alias Build =
     , status : BuildStatus
     , duration : BuildDuration
     , reapTime : Maybe Date
+    , logsArchived : Bool
     }
 
 
@@ -189,6 +193,7 @@ decodeBuild =
                 |: Json.Decode.maybe (Json.Decode.field "end_time" (Json.Decode.map dateFromSeconds Json.Decode.float))
            )
         |: Json.Decode.maybe (Json.Decode.field "reap_time" (Json.Decode.map dateFromSeconds Json.Decode.float))
+        |: (defaultTo False <| Json.Decode.field "logs_archived" Json.Decode.bool)
 
 
 decodeBuildStatus : Json.Decode.Decoder BuildStatus
@@ -338,6 +343,7 @@ type BuildStep
     | BuildStepPut StepName
     | BuildStepDependentGet StepName
     | BuildStepAggregate (Array BuildPlan)
+    | BuildStepInParallel (Array BuildPlan)
     | BuildStepDo (Array BuildPlan)
     | BuildStepOnSuccess HookedPlan
     | BuildStepOnFailure HookedPlan
@@ -346,6 +352,9 @@ type BuildStep
     | BuildStepTry BuildPlan
     | BuildStepRetry (Array BuildPlan)
     | BuildStepTimeout BuildPlan
+    | BuildStepSetPipeline StepName
+    | BuildStepLoadVar StepName
+    | BuildStepAcross AcrossPlan
 
 
 type alias HookedPlan =
@@ -354,6 +363,18 @@ type alias HookedPlan =
     }
 
 
+type alias AcrossPlan =
+    { var : String
+    , steps : Array VarScopedPlan
+    }
+
+
+type alias VarScopedPlan =
+    { value : String
+    , step : BuildPlan
+    }
+
+
 decodeBuildPlan : Json.Decode.Decoder BuildPlan
 decodeBuildPlan =
     Json.Decode.at [ "plan" ] <|
@@ -371,6 +392,7 @@ decodeBuildPlan_ =
             , Json.Decode.field "put" <| lazy (\_ -> decodeBuildStepPut)
             , Json.Decode.field "dependent_get" <| lazy (\_ -> decodeBuildStepDependentGet)
             , Json.Decode.field "aggregate" <| lazy (\_ -> decodeBuildStepAggregate)
+            , Json.Decode.field "in_parallel" <| lazy (\_ -> decodeBuildStepInParallel)
             , Json.Decode.field "do" <| lazy (\_ -> decodeBuildStepDo)
             , Json.Decode.field "on_success" <| lazy (\_ -> decodeBuildStepOnSuccess)
             , Json.Decode.field "on_failure" <| lazy (\_ -> decodeBuildStepOnFailure)
@@ -379,6 +401,9 @@ decodeBuildPlan_ =
             , Json.Decode.field "try" <| lazy (\_ -> decodeBuildStepTry)
             , Json.Decode.field "retry" <| lazy (\_ -> decodeBuildStepRetry)
             , Json.Decode.field "timeout" <| lazy (\_ -> decodeBuildStepTimeout)
+            , Json.Decode.field "set_pipeline" <| lazy (\_ -> decodeBuildStepSetPipeline)
+            , Json.Decode.field "load_var" <| lazy (\_ -> decodeBuildStepLoadVar)
+            , Json.Decode.field "across" <| lazy (\_ -> decodeBuildStepAcross)
             ]
 
 
@@ -401,6 +426,41 @@ decodeBuildStepPut =
         |: Json.Decode.field "name" Json.Decode.string
 
 
+decodeBuildStepSetPipeline : Json.Decode.Decoder BuildStep
+decodeBuildStepSetPipeline =
+    Json.Decode.succeed BuildStepSetPipeline
+        |: Json.Decode.field "name" Json.Decode.string
+
+
+decodeBuildStepLoadVar : Json.Decode.Decoder BuildStep
+decodeBuildStepLoadVar =
+    Json.Decode.succeed BuildStepLoadVar
+        |: Json.Decode.field "name" Json.Decode.string
+
+
+decodeBuildStepAcross : Json.Decode.Decoder BuildStep
+decodeBuildStepAcross =
+    Json.Decode.map BuildStepAcross <|
+        Json.Decode.succeed AcrossPlan
+            |: Json.Decode.field "var" Json.Decode.string
+            |: (Json.Decode.field "steps" <| Json.Decode.array (lazy (\_ -> decodeVarScopedPlan)))
+
+
+decodeVarScopedPlan : Json.Decode.Decoder VarScopedPlan
+decodeVarScopedPlan =
+    Json.Decode.succeed VarScopedPlan
+        |: Json.Decode.field "value" decodeAcrossValue
+        |: (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+
+
+decodeAcrossValue : Json.Decode.Decoder String
+decodeAcrossValue =
+    Json.Decode.oneOf
+        [ Json.Decode.string
+        , Json.Decode.map (Json.Encode.encode 0) Json.Decode.value
+        ]
+
+
 decodeBuildStepDependentGet : Json.Decode.Decoder BuildStep
 decodeBuildStepDependentGet =
     Json.Decode.succeed BuildStepDependentGet
@@ -413,6 +473,12 @@ decodeBuildStepAggregate =
         |: Json.Decode.array (lazy (\_ -> decodeBuildPlan_))
 
 
+decodeBuildStepInParallel : Json.Decode.Decoder BuildStep
+decodeBuildStepInParallel =
+    Json.Decode.succeed BuildStepInParallel
+        |: (Json.Decode.field "steps" <| Json.Decode.array (lazy (\_ -> decodeBuildPlan_)))
+
+
 decodeBuildStepDo : Json.Decode.Decoder BuildStep
 decodeBuildStepDo =
     Json.Decode.succeed BuildStepDo
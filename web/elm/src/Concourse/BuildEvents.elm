@@ -19,6 +19,7 @@ type BuildEvent
     | Log Origin String (Maybe Date)
     | Error Origin String
     | BuildError String
+    | Handoff String
     | End
 
 
@@ -91,6 +92,11 @@ decodeBuildEvent eventType =
         "finish-put" ->
             Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+        "handoff" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map Handoff (Json.Decode.field "from" Json.Decode.string))
+
         unknown ->
             Json.Decode.fail ("unknown event type: " ++ unknown)
 
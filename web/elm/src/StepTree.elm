@@ -42,6 +42,7 @@ type StepTree
     | Put Step
     | DependentGet Step
     | Aggregate (Array StepTree)
+    | InParallel (Array StepTree)
     | Do (Array StepTree)
     | OnSuccess HookedStep
     | OnFailure HookedStep
@@ -50,6 +51,9 @@ type StepTree
     | Try StepTree
     | Retry StepID (Array StepTree) Int TabFocus
     | Timeout StepTree
+    | SetPipeline Step
+    | LoadVar Step
+    | Across String (Array String) (Array StepTree)
 
 
 type TabFocus
@@ -163,6 +167,25 @@ init hl resources plan =
             in
                 Model (Aggregate trees) foci False hl
 
+        Concourse.BuildStepInParallel plans ->
+            let
+                inited =
+                    Array.map (init hl resources) plans
+
+                trees =
+                    Array.map .tree inited
+
+                subFoci =
+                    Array.map .foci inited
+
+                wrappedSubFoci =
+                    Array.indexedMap wrapMultiStep subFoci
+
+                foci =
+                    Array.foldr Dict.union Dict.empty wrappedSubFoci
+            in
+                Model (InParallel trees) foci False hl
+
         Concourse.BuildStepDo plans ->
             let
                 inited =
@@ -222,6 +245,34 @@ init hl resources plan =
         Concourse.BuildStepTimeout plan ->
             initWrappedStep hl resources Timeout plan
 
+        Concourse.BuildStepSetPipeline name ->
+            initBottom hl SetPipeline plan.id name
+
+        Concourse.BuildStepLoadVar name ->
+            initBottom hl LoadVar plan.id name
+
+        Concourse.BuildStepAcross { var, steps } ->
+            let
+                inited =
+                    Array.map (init hl resources << .step) steps
+
+                labels =
+                    Array.map .value steps
+
+                trees =
+                    Array.map .tree inited
+
+                subFoci =
+                    Array.map .foci inited
+
+                wrappedSubFoci =
+                    Array.indexedMap wrapMultiStep subFoci
+
+                foci =
+                    Array.foldr Dict.union Dict.empty wrappedSubFoci
+            in
+                Model (Across var labels trees) foci False hl
+
 
 treeIsActive : StepTree -> Bool
 treeIsActive tree =
@@ -229,9 +280,15 @@ treeIsActive tree =
         Aggregate trees ->
             List.any treeIsActive (Array.toList trees)
 
+        InParallel trees ->
+            List.any treeIsActive (Array.toList trees)
+
         Do trees ->
             List.any treeIsActive (Array.toList trees)
 
+        Across _ _ trees ->
+            List.any treeIsActive (Array.toList trees)
+
         OnSuccess { step } ->
             treeIsActive step
 
@@ -265,6 +322,12 @@ treeIsActive tree =
         DependentGet step ->
             stepIsActive step
 
+        SetPipeline step ->
+            stepIsActive step
+
+        LoadVar step ->
+            stepIsActive step
+
 
 stepIsActive : Step -> Bool
 stepIsActive =
@@ -379,6 +442,12 @@ map f tree =
         DependentGet step ->
             DependentGet (f step)
 
+        SetPipeline step ->
+            SetPipeline (f step)
+
+        LoadVar step ->
+            LoadVar (f step)
+
         _ ->
             tree
 
@@ -564,9 +633,15 @@ getMultiStepIndex idx tree =
                 Aggregate trees ->
                     trees
 
+                InParallel trees ->
+                    trees
+
                 Do trees ->
                     trees
 
+                Across _ _ trees ->
+                    trees
+
                 Retry _ trees _ _ ->
                     trees
 
@@ -587,9 +662,15 @@ setMultiStepIndex idx update tree =
         Aggregate trees ->
             Aggregate (Array.set idx (update (getMultiStepIndex idx tree)) trees)
 
+        InParallel trees ->
+            InParallel (Array.set idx (update (getMultiStepIndex idx tree)) trees)
+
         Do trees ->
             Do (Array.set idx (update (getMultiStepIndex idx tree)) trees)
 
+        Across var labels trees ->
+            Across var labels (Array.set idx (update (getMultiStepIndex idx tree)) trees)
+
         Retry id trees tab focus ->
             let
                 updatedSteps =
@@ -626,6 +707,12 @@ viewTree model tree =
         Put step ->
             viewStep model step "fa-arrow-up"
 
+        SetPipeline step ->
+            viewStep model step "fa-sliders"
+
+        LoadVar step ->
+            viewStep model step "fa-file-text-o"
+
         Try step ->
             viewTree model step
 
@@ -648,10 +735,18 @@ viewTree model tree =
             Html.div [ class "aggregate" ]
                 (Array.toList <| Array.map (viewSeq model) steps)
 
+        InParallel steps ->
+            Html.div [ class "in-parallel" ]
+                (Array.toList <| Array.map (viewSeq model) steps)
+
         Do steps ->
             Html.div [ class "do" ]
                 (Array.toList <| Array.map (viewSeq model) steps)
 
+        Across var labels steps ->
+            Html.div [ class "across" ]
+                (List.map2 (viewAcrossCombination model var) (Array.toList labels) (Array.toList steps))
+
         OnSuccess { step, hook } ->
             viewHooked "success" model step hook
 
@@ -681,6 +776,14 @@ viewSeq model tree =
     Html.div [ class "seq" ] [ viewTree model tree ]
 
 
+viewAcrossCombination : Model -> String -> String -> StepTree -> Html Msg
+viewAcrossCombination model var value tree =
+    Html.div [ class "seq" ]
+        [ Html.div [ class "across-combination" ] [ Html.text (var ++ ": " ++ value) ]
+        , viewTree model tree
+        ]
+
+
 viewHooked : String -> Model -> StepTree -> StepTree -> Html Msg
 viewHooked name model step hook =
     Html.div [ class "hooked" ]
@@ -47,6 +47,7 @@ all =
                                                     , status = Concourse.BuildStatusSucceeded
                                                     , duration = { startedAt = Nothing, finishedAt = Nothing }
                                                     , reapTime = Nothing
+                                                    , logsArchived = False
                                                     }
                                           , transitionBuild = Nothing
                                           , paused = False
@@ -35,6 +35,7 @@ all =
                             , finishedAt = Just (Date.fromTime 0)
                             }
                         , reapTime = Just (Date.fromTime 0)
+                        , logsArchived = False
                         }
                 in
                     let
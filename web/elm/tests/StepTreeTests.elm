@@ -26,6 +26,10 @@ all =
         , initEnsure
         , initTry
         , initTimeout
+        , initSetPipeline
+        , initLoadVar
+        , initAcross
+        , initInParallel
         ]
 
 
@@ -134,6 +138,106 @@ initPut =
             ]
 
 
+initSetPipeline : Test
+initSetPipeline =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepSetPipeline "some-name"
+                }
+    in
+        describe "init with SetPipeline"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.SetPipeline (someStep "some-id" "some-name" StepTree.StepStatePending))
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "some-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.SetPipeline (someStep "some-id" "some-name" StepTree.StepStateSucceeded))
+            ]
+
+
+initLoadVar : Test
+initLoadVar =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepLoadVar "some-name"
+                }
+    in
+        describe "init with LoadVar"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.LoadVar (someStep "some-id" "some-name" StepTree.StepStatePending))
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "some-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.LoadVar (someStep "some-id" "some-name" StepTree.StepStateSucceeded))
+            ]
+
+
+initAcross : Test
+initAcross =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "across-id"
+                , step =
+                    BuildStepAcross
+                        { var = "platform"
+                        , steps =
+                            Array.fromList
+                                [ { value = "linux", step = { id = "task-a-id", step = BuildStepTask "task" } }
+                                , { value = "darwin", step = { id = "task-b-id", step = BuildStepTask "task" } }
+                                ]
+                        }
+                }
+    in
+        describe "init with Across"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.Across "platform"
+                            (Array.fromList [ "linux", "darwin" ])
+                            << Array.fromList
+                         <|
+                            [ StepTree.Task (someStep "task-a-id" "task" StepTree.StepStatePending)
+                            , StepTree.Task (someStep "task-b-id" "task" StepTree.StepStatePending)
+                            ]
+                        )
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "task-b-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.Across "platform"
+                            (Array.fromList [ "linux", "darwin" ])
+                            << Array.fromList
+                         <|
+                            [ StepTree.Task (someStep "task-a-id" "task" StepTree.StepStatePending)
+                            , StepTree.Task (someStep "task-b-id" "task" StepTree.StepStateSucceeded)
+                            ]
+                        )
+            ]
+
+
 initDependentGet : Test
 initDependentGet =
     let
@@ -204,6 +308,50 @@ initAggregate =
             ]
 
 
+initInParallel : Test
+initInParallel =
+    let
+        { tree, foci, finished } =
+            StepTree.init StepTree.HighlightNothing
+                emptyResources
+                { id = "in-parallel-id"
+                , step =
+                    BuildStepInParallel
+                        << Array.fromList
+                    <|
+                        [ { id = "task-a-id", step = BuildStepTask "task-a" }
+                        , { id = "task-b-id", step = BuildStepTask "task-b" }
+                        ]
+                }
+    in
+        describe "init with InParallel"
+            [ test "the tree" <|
+                \_ ->
+                    Expect.equal
+                        (StepTree.InParallel
+                            << Array.fromList
+                         <|
+                            [ StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStatePending)
+                            , StepTree.Task (someStep "task-b-id" "task-b" StepTree.StepStatePending)
+                            ]
+                        )
+                        tree
+            , test "using the focus" <|
+                \_ ->
+                    assertFocus "task-b-id"
+                        foci
+                        tree
+                        (\s -> { s | state = StepTree.StepStateSucceeded })
+                        (StepTree.InParallel
+                            << Array.fromList
+                         <|
+                            [ StepTree.Task (someStep "task-a-id" "task-a" StepTree.StepStatePending)
+                            , StepTree.Task (someStep "task-b-id" "task-b" StepTree.StepStateSucceeded)
+                            ]
+                        )
+            ]
+
+
 initAggregateNested : Test
 initAggregateNested =
     let
@@ -493,6 +641,12 @@ updateStep f tree =
         StepTree.DependentGet step ->
             StepTree.DependentGet (f step)
 
+        StepTree.SetPipeline step ->
+            StepTree.SetPipeline (f step)
+
+        StepTree.LoadVar step ->
+            StepTree.LoadVar (f step)
+
         _ ->
             tree
 
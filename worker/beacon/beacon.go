@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -60,6 +61,7 @@ type BeaconClient interface {
 
 	SweepVolumes() error
 	ReportVolumes() error
+	ReportVolumeSizes() error
 
 	LandWorker(signals <-chan os.Signal, ready chan<- struct{}) error
 	DeleteWorker(signals <-chan os.Signal, ready chan<- struct{}) error
@@ -365,6 +367,80 @@ func (beacon *Beacon) ReportVolumes() error {
 	return nil
 }
 
+// ReportVolumeSizes measures how much disk space each of the worker's volumes
+// takes up and reports it to the ATC, so that it can be accounted for in the
+// usage of the team which owns the volume.
+//
+// Baggageclaim has no API for the size of a volume, so the volumes are
+// measured on disk, and only those whose path is visible to the beacon are
+// reported. Copy-on-write volumes share most of their data with their parent;
+// the ATC ignores their sizes rather than counting that data twice.
+func (beacon *Beacon) ReportVolumeSizes() error {
+	command := tsa.ReportVolumeSizes
+
+	var beaconBaggageclaimAddress = beacon.BaggageclaimAddr
+
+	if beaconBaggageclaimAddress == "" {
+		beaconBaggageclaimAddress = fmt.Sprint("http://", baggageclaimForwardAddr)
+	}
+
+	baggageclaimClient := client.NewWithHTTPClient(
+		beaconBaggageclaimAddress, &http.Client{
+			Transport: &http.Transport{
+				DisableKeepAlives:     true,
+				ResponseHeaderTimeout: 1 * time.Minute,
+			},
+		})
+
+	volumes, err := baggageclaimClient.ListVolumes(beacon.Logger, nil)
+	if err != nil {
+		return beacon.logFailure(command, err)
+	}
+
+	sizes := map[string]int64{}
+	for _, volume := range volumes {
+		size, err := diskUsage(volume.Path())
+		if err != nil {
+			// the volume may have been destroyed since it was listed
+			beacon.Logger.Info("failed-to-measure-volume", lager.Data{"handle": volume.Handle(), "error": err.Error()})
+			continue
+		}
+
+		sizes[volume.Handle()] = size
+	}
+
+	err = beacon.executeCommandWithBody(sizes, func(sess Session) error {
+		_, err = sess.Output(command)
+		return err
+	})
+
+	if err != nil {
+		beacon.Logger.Error("failed-to-execute-cmd", err)
+		return beacon.logFailure(command, err)
+	}
+
+	beacon.Logger.Debug("sucessfully-reported-volume-sizes", lager.Data{"num-handles": len(sizes)})
+	return nil
+}
+
+// diskUsage is the total size of the regular files under path.
+func diskUsage(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
+
 func (beacon *Beacon) LandWorker(signals <-chan os.Signal, ready chan<- struct{}) error {
 	beacon.Logger.Debug("land-worker")
 
@@ -527,6 +603,12 @@ func (beacon *Beacon) run(command string, ctx context.Context, disableKeepAliveC
 }
 
 func (beacon *Beacon) executeCommand(command func(Session) error) error {
+	return beacon.executeCommandWithBody(nil, command)
+}
+
+// executeCommandWithBody sends body to the command after the worker, for
+// arguments which could be too many to pass on the command line.
+func (beacon *Beacon) executeCommandWithBody(body interface{}, command func(Session) error) error {
 	conn, err := beacon.Client.Dial()
 	if err != nil {
 		return err
@@ -538,8 +620,19 @@ func (beacon *Beacon) executeCommand(command func(Session) error) error {
 		return err
 	}
 
+	payload := bytes.NewBuffer(workerPayload)
+
+	if body != nil {
+		bodyPayload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		payload.Write(bodyPayload)
+	}
+
 	sess, err := beacon.Client.NewSession(
-		bytes.NewBuffer(workerPayload),
+		payload,
 		nil,
 		os.Stderr,
 	)
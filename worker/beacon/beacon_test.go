@@ -3,8 +3,10 @@ package beacon_test
 import (
 	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -614,6 +616,105 @@ var _ = Describe("Beacon", func() {
 		})
 	})
 
+	var _ = Describe("ReportVolumeSizes", func() {
+		var (
+			err                error
+			baggageclaimServer *ghttp.Server
+			volumeDir          string
+		)
+
+		BeforeEach(func() {
+			baggageclaimServer = ghttp.NewServer()
+			beacon.BaggageclaimAddr = baggageclaimServer.URL()
+			baggageclaimServer.Reset()
+
+			volumeDir, err = ioutil.TempDir("", "beacon-volumes")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			baggageclaimServer.Close()
+			os.RemoveAll(volumeDir)
+		})
+
+		JustBeforeEach(func() {
+			err = beacon.ReportVolumeSizes()
+		})
+
+		Context("when listing the volumes returns error", func() {
+			BeforeEach(func() {
+				baggageclaimServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/volumes"),
+						ghttp.RespondWith(http.StatusFailedDependency, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not connect to the TSA", func() {
+				Expect(fakeClient.DialCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when volumes are listed", func() {
+			BeforeEach(func() {
+				path1 := filepath.Join(volumeDir, "handle1")
+				Expect(os.MkdirAll(filepath.Join(path1, "sub"), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(path1, "a"), make([]byte, 100), 0644)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(path1, "sub", "b"), make([]byte, 23), 0644)).To(Succeed())
+
+				path2 := filepath.Join(volumeDir, "handle2")
+				Expect(os.MkdirAll(path2, 0755)).To(Succeed())
+
+				baggageclaimServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/volumes"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []volume.Volume{
+							{
+								Handle: "handle1",
+								Path:   path1,
+							},
+							{
+								Handle: "handle2",
+								Path:   path2,
+							},
+							{
+								Handle: "handle3",
+								Path:   filepath.Join(volumeDir, "handle3"),
+							},
+						}),
+					),
+				)
+			})
+
+			It("reports the size of every volume which still exists via the TSA", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeSession.OutputCallCount()).To(Equal(1))
+				command := fakeSession.OutputArgsForCall(0)
+				Expect(command).To(Equal("report-volume-sizes"))
+			})
+
+			It("sends the sizes after the worker", func() {
+				Expect(fakeClient.NewSessionCallCount()).To(Equal(1))
+				stdin, _, _ := fakeClient.NewSessionArgsForCall(0)
+
+				decoder := json.NewDecoder(stdin)
+
+				var worker atc.Worker
+				Expect(decoder.Decode(&worker)).To(Succeed())
+				Expect(worker).To(Equal(beacon.Worker))
+
+				var sizes map[string]int64
+				Expect(decoder.Decode(&sizes)).To(Succeed())
+				Expect(sizes).To(Equal(map[string]int64{"handle1": 123, "handle2": 0}))
+			})
+		})
+	})
+
 	var _ = Describe("ReportContainers", func() {
 		var (
 			err          error
@@ -61,6 +61,16 @@ type FakeBeaconClient struct {
 	reportContainersReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReportVolumeSizesStub        func() error
+	reportVolumeSizesMutex       sync.RWMutex
+	reportVolumeSizesArgsForCall []struct {
+	}
+	reportVolumeSizesReturns struct {
+		result1 error
+	}
+	reportVolumeSizesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReportVolumesStub        func() error
 	reportVolumesMutex       sync.RWMutex
 	reportVolumesArgsForCall []struct {
@@ -328,6 +338,48 @@ func (fake *FakeBeaconClient) ReportContainersReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeBeaconClient) ReportVolumeSizes() error {
+	fake.reportVolumeSizesMutex.Lock()
+	ret, specificReturn := fake.reportVolumeSizesReturnsOnCall[len(fake.reportVolumeSizesArgsForCall)]
+	fake.reportVolumeSizesArgsForCall = append(fake.reportVolumeSizesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReportVolumeSizes", []interface{}{})
+	fake.reportVolumeSizesMutex.Unlock()
+	if fake.ReportVolumeSizesStub != nil {
+		return fake.ReportVolumeSizesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.reportVolumeSizesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBeaconClient) ReportVolumeSizesCallCount() int {
+	fake.reportVolumeSizesMutex.RLock()
+	defer fake.reportVolumeSizesMutex.RUnlock()
+	return len(fake.reportVolumeSizesArgsForCall)
+}
+
+func (fake *FakeBeaconClient) ReportVolumeSizesReturns(result1 error) {
+	fake.ReportVolumeSizesStub = nil
+	fake.reportVolumeSizesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBeaconClient) ReportVolumeSizesReturnsOnCall(i int, result1 error) {
+	fake.ReportVolumeSizesStub = nil
+	if fake.reportVolumeSizesReturnsOnCall == nil {
+		fake.reportVolumeSizesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reportVolumeSizesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBeaconClient) ReportVolumes() error {
 	fake.reportVolumesMutex.Lock()
 	ret, specificReturn := fake.reportVolumesReturnsOnCall[len(fake.reportVolumesArgsForCall)]
@@ -526,6 +578,8 @@ func (fake *FakeBeaconClient) Invocations() map[string][][]interface{} {
 	defer fake.registerMutex.RUnlock()
 	fake.reportContainersMutex.RLock()
 	defer fake.reportContainersMutex.RUnlock()
+	fake.reportVolumeSizesMutex.RLock()
+	defer fake.reportVolumeSizesMutex.RUnlock()
 	fake.reportVolumesMutex.RLock()
 	defer fake.reportVolumesMutex.RUnlock()
 	fake.retireWorkerMutex.RLock()
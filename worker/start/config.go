@@ -7,9 +7,10 @@ import (
 )
 
 type Config struct {
-	Name     string   `long:"name"  description:"The name to set for the worker during registration. If not specified, the hostname will be used."`
-	Tags     []string `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
-	TeamName string   `long:"team"  description:"The name of the team that this worker will be assigned to."`
+	Name     string            `long:"name"  description:"The name to set for the worker during registration. If not specified, the hostname will be used."`
+	Tags     []string          `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
+	Labels   map[string]string `long:"label" description:"A label to set during registration, as key:value. Steps can be constrained to workers by their labels. Can be specified multiple times."`
+	TeamName string            `long:"team"  description:"The name of the team that this worker will be assigned to."`
 
 	HTTPProxy  string `long:"http-proxy"  env:"http_proxy"                  description:"HTTP proxy endpoint to use for containers."`
 	HTTPSProxy string `long:"https-proxy" env:"https_proxy"                 description:"HTTPS proxy endpoint to use for containers."`
@@ -25,6 +26,7 @@ type Config struct {
 func (c Config) Worker() atc.Worker {
 	return atc.Worker{
 		Tags:          c.Tags,
+		Labels:        c.Labels,
 		Team:          c.TeamName,
 		Name:          c.Name,
 		StartTime:     time.Now().Unix(),
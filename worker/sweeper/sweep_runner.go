@@ -20,6 +20,11 @@ type Command struct {
 	BeaconClient beacon.BeaconClient
 	GCInterval   time.Duration
 	GardenClient garden.Client
+
+	// VolumeSizeInterval is how often the size of every volume is measured
+	// and reported. Measuring walks the whole volume, so it's done less often
+	// than garbage collection.
+	VolumeSizeInterval time.Duration
 }
 
 // NewSweeperRunner provides the ifrit runner that marks and sweeps the containers
@@ -43,6 +48,8 @@ func NewSweeperRunner(logger lager.Logger,
 		Logger:       logger.Session("sweeper"),
 		GCInterval:   30 * time.Second,
 		GardenClient: gdnClient.New(connection.New("tcp", atcWorker.GardenAddr)),
+
+		VolumeSizeInterval: 5 * time.Minute,
 	}
 	return scmd
 }
@@ -53,6 +60,11 @@ func NewSweeperRunner(logger lager.Logger,
 // atc to remove containers in DB. This cycle is triggered every GCInterval sec
 func (cmd *Command) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	timer := time.NewTicker(cmd.GCInterval)
+	defer timer.Stop()
+
+	sizeTimer := time.NewTicker(cmd.VolumeSizeInterval)
+	defer sizeTimer.Stop()
+
 	close(ready)
 
 	for {
@@ -79,6 +91,12 @@ func (cmd *Command) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 				cmd.Logger.Error("failed-to-sweep-volumes", err)
 			}
 
+		case <-sizeTimer.C:
+			err := cmd.BeaconClient.ReportVolumeSizes()
+			if err != nil {
+				cmd.Logger.Error("failed-to-report-volume-sizes", err)
+			}
+
 		case <-signals:
 			cmd.Logger.Info("exiting-from-mark-and-sweep")
 			return nil